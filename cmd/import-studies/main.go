@@ -0,0 +1,68 @@
+// Bulk import legacy studies, assets and contracts from an XLSX workbook or CSV files.
+// Prints the import report as JSON and exits non-zero if any row was invalid e.g.
+//
+//	import-studies -workbook legacy.xlsx -dry-run
+//	import-studies -studies studies.csv -assets assets.csv -contracts contracts.csv
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/agreements"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
+)
+
+func main() {
+	workbookPath := flag.String("workbook", "", "XLSX workbook with studies, assets and/or contracts sheets")
+	csvPaths := map[string]*string{
+		studies.BulkImportStudiesSheet:   flag.String("studies", "", "CSV of studies"),
+		studies.BulkImportAssetsSheet:    flag.String("assets", "", "CSV of assets"),
+		studies.BulkImportContractsSheet: flag.String("contracts", "", "CSV of contracts"),
+	}
+	dryRun := flag.Bool("dry-run", false, "Validate and report on the import without committing it")
+	flag.Parse()
+
+	sheets := []spreadsheet.Sheet{}
+	if *workbookPath != "" {
+		sheets = must(spreadsheet.ReadXLSX(must(os.ReadFile(*workbookPath))))
+	}
+	for name, path := range csvPaths {
+		if *path != "" {
+			sheets = append(sheets, must(spreadsheet.ReadCSV(name, must(os.ReadFile(*path)))))
+		}
+	}
+	if len(sheets) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	config.Init()
+	graceful.InitDB()
+	rbac.Init()
+	agreements.Init()
+
+	report := must(studies.New().BulkImport(context.Background(), sheets, *dryRun))
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal().Err(err).Msg("Failed to write report")
+	}
+	if len(report.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to import studies")
+	}
+	return value
+}
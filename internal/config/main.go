@@ -35,7 +35,17 @@ const (
 
 	ServerShutdownGraceDuration = 10 * time.Second
 
-	StudySignoffValidity = 3 * Month
+	StudySignoffValidity = 3 * Month // For studies without assets
+
+	StudyOwnerChangeRequestValidity = 1 * Month // Pending owner changes expire after this
+
+	defaultStudySignoffEnforcementGracePeriodDays = 14
+	defaultAssetExpiryEnforcementGracePeriodDays  = 0
+	defaultAssetRegisterExportIntervalDays        = 30
+	defaultAssetRegisterExportFormat              = "csv"
+	defaultMalwareScanningNetwork                 = "tcp"
+	defaultMalwareScanningTimeoutSeconds          = 60
+	defaultS3VerificationIntervalDays             = 7
 )
 
 var k = koanf.New(".")
@@ -87,6 +97,25 @@ func S3DevHost() string {
 	return k.String("s3.dev.host")
 }
 
+// Envelope encryption of stored objects. Keys are base64 encoded key encryption keys
+// by their id, all of which are retained so objects encrypted with retired keys can be read
+func S3Encryption() S3EncryptionBundle {
+	return S3EncryptionBundle{
+		Enabled: k.Bool("s3.encryption.enabled"),
+		KeyID:   k.String("s3.encryption.key_id"),
+		Keys:    k.StringMap("s3.encryption.keys"),
+	}
+}
+
+// How often all stored objects are re-verified against the digests recorded at upload
+func S3VerificationInterval() time.Duration {
+	intervalDays := defaultS3VerificationIntervalDays
+	if k.Exists("s3.verification.interval_days") {
+		intervalDays = k.Int("s3.verification.interval_days")
+	}
+	return time.Duration(intervalDays) * Day
+}
+
 func JWTIssuer() string {
 	return PortalUrl()
 }
@@ -157,6 +186,10 @@ func DSHOpsStaffUsernames() []types.Username {
 	return usernames("dsh_ops_staff_usernames")
 }
 
+func DPOUsernames() []types.Username {
+	return usernames("dpo_usernames")
+}
+
 func NotificationsEnabled() bool {
 	return k.Bool("entra.notifications_enabled")
 }
@@ -174,6 +207,73 @@ func Myservices() MyservicesCredentialBundle {
 	}
 }
 
+// Policy for suspending the TRE projects of a study once its signoff or a
+// regulatory approval has lapsed
+func StudySignoffEnforcement() EnforcementPolicy {
+	graceDays := defaultStudySignoffEnforcementGracePeriodDays
+	if k.Exists("enforcement.study_signoff.grace_period_days") {
+		graceDays = k.Int("enforcement.study_signoff.grace_period_days")
+	}
+	return EnforcementPolicy{
+		Enabled:     k.Bool("enforcement.study_signoff.enabled"),
+		GracePeriod: time.Duration(graceDays) * Day,
+	}
+}
+
+// Policy for acting on assets once they have passed their expiry
+func AssetExpiryEnforcement() AssetExpiryPolicy {
+	graceDays := defaultAssetExpiryEnforcementGracePeriodDays
+	if k.Exists("enforcement.asset_expiry.grace_period_days") {
+		graceDays = k.Int("enforcement.asset_expiry.grace_period_days")
+	}
+	action := AssetExpiryActionDetach
+	if k.Exists("enforcement.asset_expiry.action") {
+		action = AssetExpiryAction(k.String("enforcement.asset_expiry.action"))
+	}
+	return AssetExpiryPolicy{
+		EnforcementPolicy: EnforcementPolicy{
+			Enabled:     k.Bool("enforcement.asset_expiry.enabled"),
+			GracePeriod: time.Duration(graceDays) * Day,
+		},
+		Action: action,
+	}
+}
+
+// Schedule for storing exports of the information asset register in S3
+func AssetRegisterExport() ScheduledExportPolicy {
+	intervalDays := defaultAssetRegisterExportIntervalDays
+	if k.Exists("asset_register_export.interval_days") {
+		intervalDays = k.Int("asset_register_export.interval_days")
+	}
+	format := defaultAssetRegisterExportFormat
+	if k.Exists("asset_register_export.format") {
+		format = k.String("asset_register_export.format")
+	}
+	return ScheduledExportPolicy{
+		Enabled:  k.Bool("asset_register_export.enabled"),
+		Format:   format,
+		Interval: time.Duration(intervalDays) * Day,
+	}
+}
+
+// Scanning of uploaded files with a ClamAV daemon
+func MalwareScanning() MalwareScanningBundle {
+	network := defaultMalwareScanningNetwork
+	if k.Exists("malware_scanning.clamd.network") {
+		network = k.String("malware_scanning.clamd.network")
+	}
+	timeoutSeconds := defaultMalwareScanningTimeoutSeconds
+	if k.Exists("malware_scanning.timeout_seconds") {
+		timeoutSeconds = k.Int("malware_scanning.timeout_seconds")
+	}
+	return MalwareScanningBundle{
+		Enabled: k.Bool("malware_scanning.enabled"),
+		Network: network,
+		Address: k.String("malware_scanning.clamd.address"),
+		Timeout: time.Duration(timeoutSeconds) * time.Second,
+	}
+}
+
 // Map of paths to strictly rate limit, so they are 'slow'
 func RateLimitSlowPaths() map[string]bool {
 	return map[string]bool{
@@ -228,22 +328,112 @@ func ShouldNotifyTrainingExpiry(trainingRecord types.UserTrainingRecord) bool {
 	return shouldNotifyExpiry(daysUntilExpiry)
 }
 
+// Period a study signoff is valid for, given the highest tier of its assets.
+// Higher tier studies must be signed off more often
+func StudySignoffValidityOf(study types.Study) time.Duration {
+	if study.HighestAssetTier == nil {
+		return StudySignoffValidity
+	}
+	switch tier := *study.HighestAssetTier; {
+	case tier >= 4:
+		return 1 * Month
+	case tier == 3:
+		return 3 * Month
+	case tier == 2:
+		return 6 * Month
+	default:
+		return 365 * Day
+	}
+}
+
+// Time the latest signoff of a study expires. Nil if never signed off
+func StudySignoffExpiresAt(study types.Study) *time.Time {
+	if study.LastSignoff == nil {
+		return nil
+	}
+	return new(study.LastSignoff.Add(StudySignoffValidityOf(study)))
+}
+
 func DaysUntilStudySignoffExpiry(study *types.Study) Days {
 	if study == nil || study.LastSignoff == nil {
 		log.Warn().Msg("nil study or lastSignoff - no days until expiry")
 		return 0
 	}
-	return daysUntil(study.LastSignoff.Add(StudySignoffValidity))
+	return daysUntil(*StudySignoffExpiresAt(*study))
 }
 
 func ShouldNotifyStudySignoffExpiry(study *types.Study) bool {
-	if study == nil || study.LastSignoff == nil {
+	if study == nil || study.LastSignoff == nil || study.IsClosed() {
 		return false
 	}
 	daysUntilExpiry := DaysUntilStudySignoffExpiry(study)
 	return shouldNotifyExpiry(daysUntilExpiry)
 }
 
+// Should the TRE projects of a study be suspended given the enforcement policy,
+// i.e. has its signoff or a regulatory approval lapsed for longer than the grace
+// period. Requires the approvals of the study to be loaded
+func ShouldSuspendStudyProjects(study types.Study, policy EnforcementPolicy) bool {
+	if !policy.Enabled || study.ApprovalStatus != types.StudyApprovalStatusApproved {
+		return false
+	}
+	return IsStudySignoffLapsed(study, policy) || LapsedRegulatoryApproval(study, policy) != nil
+}
+
+// Has the signoff of a study expired for longer than the grace period
+func IsStudySignoffLapsed(study types.Study, policy EnforcementPolicy) bool {
+	return study.LastSignoff != nil && time.Now().After(StudySignoffExpiresAt(study).Add(policy.GracePeriod))
+}
+
+// Approval of a study which expired longer than the grace period ago and has not
+// been replaced by a later approval of the same type. Returns nil if there is none
+func LapsedRegulatoryApproval(study types.Study, policy EnforcementPolicy) *types.RegulatoryApproval {
+	latestByType := map[types.RegulatoryApprovalType]*types.RegulatoryApproval{}
+	for _, approval := range study.Approvals {
+		latest, exists := latestByType[approval.Type]
+		if !exists || (latest.ExpiryDate != nil && (approval.ExpiryDate == nil || approval.ExpiryDate.After(*latest.ExpiryDate))) {
+			latestByType[approval.Type] = &approval
+		}
+	}
+	var lapsed *types.RegulatoryApproval
+	for _, approval := range latestByType {
+		if approval.ExpiryDate == nil || !time.Now().After(approval.ExpiryDate.Add(policy.GracePeriod)) {
+			continue
+		}
+		if lapsed == nil || approval.ExpiryDate.Before(*lapsed.ExpiryDate) {
+			lapsed = approval
+		}
+	}
+	return lapsed
+}
+
+// Should the expiry of an asset be enforced given the enforcement policy, i.e.
+// has it been expired for longer than the grace period and not yet enforced
+func ShouldEnforceAssetExpiry(asset types.Asset, policy EnforcementPolicy) bool {
+	if !policy.Enabled || asset.ExpiresAt == nil || asset.IsExpired() || asset.Status != types.AssetStatusActive {
+		return false
+	}
+	return time.Now().After(asset.ExpiresAt.Add(policy.GracePeriod))
+}
+
+func DaysUntilDpiaReview(dpia types.Dpia) *int {
+	if dpia.ReviewDate == nil {
+		return nil
+	}
+	return new(daysUntil(*dpia.ReviewDate))
+}
+
+func ShouldNotifyDpiaReview(dpia types.Dpia) bool {
+	if !dpia.IsSignedOff() || dpia.Study.IsClosed() {
+		return false
+	}
+	daysUntilReview := DaysUntilDpiaReview(dpia)
+	if daysUntilReview == nil {
+		return false
+	}
+	return shouldNotifyExpiry(*daysUntilReview)
+}
+
 func DaysUntilContractExpiry(contract types.Contract) *int {
 	if contract.ExpiryDate == nil {
 		return nil
@@ -251,8 +441,9 @@ func DaysUntilContractExpiry(contract types.Contract) *int {
 	return new(daysUntil(*contract.ExpiryDate))
 }
 
+// Contracts which are closed, not yet in force or being renewed are not notified
 func ShouldNotifyContractExpiry(contract types.Contract) bool {
-	if contract.Status == types.ContractStatusClosed {
+	if contract.Status == types.ContractStatusClosed || contract.Status == types.ContractStatusPending || contract.IsRenewed() {
 		return false
 	}
 	daysUntilExpiry := DaysUntilContractExpiry(contract)
@@ -262,6 +453,21 @@ func ShouldNotifyContractExpiry(contract types.Contract) bool {
 	return shouldNotifyExpiry(*daysUntilExpiry)
 }
 
+func DaysUntilRegulatoryApprovalExpiry(approval types.RegulatoryApproval) *int {
+	if approval.ExpiryDate == nil {
+		return nil
+	}
+	return new(daysUntil(*approval.ExpiryDate))
+}
+
+func ShouldNotifyRegulatoryApprovalExpiry(approval types.RegulatoryApproval) bool {
+	daysUntilExpiry := DaysUntilRegulatoryApprovalExpiry(approval)
+	if daysUntilExpiry == nil {
+		return false
+	}
+	return shouldNotifyExpiry(*daysUntilExpiry)
+}
+
 func DaysUntilAssetExpiry(asset types.Asset) *int {
 	if asset.ExpiresAt == nil {
 		return nil
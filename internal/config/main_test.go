@@ -38,6 +38,96 @@ func TestDaysUntilStudySignoffExpiry(t *testing.T) {
 	assert.Equal(t, 89, DaysUntilStudySignoffExpiry(&study))
 }
 
+func TestStudySignoffValidityOf(t *testing.T) {
+	assert.Equal(t, StudySignoffValidity, StudySignoffValidityOf(types.Study{}))
+	assert.Equal(t, 365*Day, StudySignoffValidityOf(types.Study{HighestAssetTier: new(0)}))
+	assert.Equal(t, 365*Day, StudySignoffValidityOf(types.Study{HighestAssetTier: new(1)}))
+	assert.Equal(t, 6*Month, StudySignoffValidityOf(types.Study{HighestAssetTier: new(2)}))
+	assert.Equal(t, 3*Month, StudySignoffValidityOf(types.Study{HighestAssetTier: new(3)}))
+	assert.Equal(t, 1*Month, StudySignoffValidityOf(types.Study{HighestAssetTier: new(4)}))
+
+	now := time.Now()
+	study := types.Study{LastSignoff: &now, HighestAssetTier: new(4)}
+	assert.Equal(t, 29, DaysUntilStudySignoffExpiry(&study))
+	assert.Equal(t, now.Add(Month), *StudySignoffExpiresAt(study))
+	assert.Nil(t, StudySignoffExpiresAt(types.Study{}))
+}
+
+func TestStudyShouldNotifySignoffExpiry(t *testing.T) {
+	expired := time.Now().Add(-StudySignoffValidity)
+	study := types.Study{LastSignoff: &expired, ApprovalStatus: types.StudyApprovalStatusApproved}
+	assert.True(t, ShouldNotifyStudySignoffExpiry(&study))
+
+	study.ApprovalStatus = types.StudyApprovalStatusClosed
+	assert.False(t, ShouldNotifyStudySignoffExpiry(&study))
+}
+
+func TestShouldSuspendStudyProjects(t *testing.T) {
+	policy := EnforcementPolicy{Enabled: true, GracePeriod: 14 * Day}
+	lapsed := time.Now().Add(-(StudySignoffValidity + 15*Day))
+	study := types.Study{LastSignoff: &lapsed, ApprovalStatus: types.StudyApprovalStatusApproved}
+	assert.True(t, ShouldSuspendStudyProjects(study, policy))
+	assert.False(t, ShouldSuspendStudyProjects(study, EnforcementPolicy{GracePeriod: 14 * Day}))
+
+	withinGrace := time.Now().Add(-(StudySignoffValidity + 13*Day))
+	study.LastSignoff = &withinGrace
+	assert.False(t, ShouldSuspendStudyProjects(study, policy))
+
+	study.LastSignoff = &lapsed
+	study.ApprovalStatus = types.StudyApprovalStatusPending
+	assert.False(t, ShouldSuspendStudyProjects(study, policy))
+	assert.False(t, ShouldSuspendStudyProjects(types.Study{ApprovalStatus: types.StudyApprovalStatusApproved}, policy))
+}
+
+func TestShouldSuspendStudyProjectsApprovalLapsed(t *testing.T) {
+	policy := EnforcementPolicy{Enabled: true, GracePeriod: 14 * Day}
+	signedOff := time.Now()
+	study := types.Study{LastSignoff: &signedOff, ApprovalStatus: types.StudyApprovalStatusApproved}
+	assert.False(t, ShouldSuspendStudyProjects(study, policy))
+
+	expired := types.RegulatoryApproval{Type: "hra", Reference: "expired", ExpiryDate: new(time.Now().Add(-15 * Day))}
+	study.Approvals = []types.RegulatoryApproval{expired}
+	assert.True(t, ShouldSuspendStudyProjects(study, policy))
+	assert.Equal(t, "expired", LapsedRegulatoryApproval(study, policy).Reference)
+
+	withinGrace := types.RegulatoryApproval{Type: "hra", ExpiryDate: new(time.Now().Add(-13 * Day))}
+	study.Approvals = []types.RegulatoryApproval{withinGrace}
+	assert.False(t, ShouldSuspendStudyProjects(study, policy))
+
+	// Replaced by a later approval of the same type
+	renewed := types.RegulatoryApproval{Type: "hra", ExpiryDate: new(time.Now().Add(365 * Day))}
+	study.Approvals = []types.RegulatoryApproval{expired, renewed}
+	assert.False(t, ShouldSuspendStudyProjects(study, policy))
+	study.Approvals = []types.RegulatoryApproval{expired, {Type: "hra"}}
+	assert.False(t, ShouldSuspendStudyProjects(study, policy)) // does not expire
+
+	study.Approvals = []types.RegulatoryApproval{renewed, {Type: "cag", ExpiryDate: expired.ExpiryDate}}
+	assert.True(t, ShouldSuspendStudyProjects(study, policy))
+	study.LastSignoff = nil
+	assert.True(t, ShouldSuspendStudyProjects(study, policy))
+}
+
+func TestShouldEnforceAssetExpiry(t *testing.T) {
+	policy := EnforcementPolicy{Enabled: true, GracePeriod: 7 * Day}
+	expired := time.Now().Add(-8 * Day)
+	asset := types.Asset{ExpiresAt: &expired, Status: types.AssetStatusActive}
+	assert.True(t, ShouldEnforceAssetExpiry(asset, policy))
+	assert.False(t, ShouldEnforceAssetExpiry(asset, EnforcementPolicy{GracePeriod: 7 * Day}))
+
+	withinGrace := time.Now().Add(-6 * Day)
+	asset.ExpiresAt = &withinGrace
+	assert.False(t, ShouldEnforceAssetExpiry(asset, policy))
+
+	asset.ExpiresAt = &expired
+	asset.ExpiredAt = new(time.Now())
+	assert.False(t, ShouldEnforceAssetExpiry(asset, policy)) // already enforced
+
+	asset.ExpiredAt = nil
+	asset.Status = types.AssetStatusDestroyed
+	assert.False(t, ShouldEnforceAssetExpiry(asset, policy))
+	assert.False(t, ShouldEnforceAssetExpiry(types.Asset{Status: types.AssetStatusActive}, policy))
+}
+
 func TestContractShouldNotify(t *testing.T) {
 	c := types.Contract{}
 	assert.False(t, ShouldNotifyContractExpiry(c))
@@ -57,4 +147,44 @@ func TestContractShouldNotify(t *testing.T) {
 	yesterday := time.Now().Add(-1 * Day)
 	c.ExpiryDate = &yesterday
 	assert.True(t, ShouldNotifyContractExpiry(c))
+
+	c.Status = types.ContractStatusPending
+	assert.False(t, ShouldNotifyContractExpiry(c))
+
+	c.Status = types.ContractStatusActive
+	c.Successor = &types.Contract{Status: types.ContractStatusPending}
+	assert.False(t, ShouldNotifyContractExpiry(c), "renewal in progress")
+}
+
+func TestRegulatoryApprovalShouldNotify(t *testing.T) {
+	approval := types.RegulatoryApproval{}
+	assert.False(t, ShouldNotifyRegulatoryApprovalExpiry(approval))
+
+	twoMonthsFromNow := time.Now().Add(2 * Month)
+	approval.ExpiryDate = &twoMonthsFromNow
+	assert.False(t, ShouldNotifyRegulatoryApprovalExpiry(approval))
+
+	oneWeekFromNow := time.Now().Add(7 * Day).Add(1 * time.Second)
+	approval.ExpiryDate = &oneWeekFromNow
+	assert.True(t, ShouldNotifyRegulatoryApprovalExpiry(approval))
+}
+
+func TestDpiaShouldNotifyReview(t *testing.T) {
+	dpia := types.Dpia{Status: types.DpiaStatusSignedOff}
+	assert.False(t, ShouldNotifyDpiaReview(dpia))
+
+	twoMonthsFromNow := time.Now().Add(2 * Month)
+	dpia.ReviewDate = &twoMonthsFromNow
+	assert.False(t, ShouldNotifyDpiaReview(dpia))
+
+	oneWeekFromNow := time.Now().Add(7 * Day).Add(1 * time.Second)
+	dpia.ReviewDate = &oneWeekFromNow
+	assert.True(t, ShouldNotifyDpiaReview(dpia))
+
+	dpia.Status = types.DpiaStatusDraft
+	assert.False(t, ShouldNotifyDpiaReview(dpia))
+
+	dpia.Status = types.DpiaStatusSignedOff
+	dpia.Study.ApprovalStatus = types.StudyApprovalStatusArchived
+	assert.False(t, ShouldNotifyDpiaReview(dpia))
 }
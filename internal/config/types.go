@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type Days = int
 
 type EntraCredentialBundle struct {
@@ -8,6 +10,42 @@ type EntraCredentialBundle struct {
 	ClientSecret string // #nosec G117 -- Loaded from mounted config
 }
 
+type EnforcementPolicy struct {
+	Enabled     bool
+	GracePeriod time.Duration // Period after a lapse before enforcing
+}
+
+const (
+	AssetExpiryActionFlag   = AssetExpiryAction("flag")   // Flag the asset and raise a review for the owner
+	AssetExpiryActionDetach = AssetExpiryAction("detach") // Also detach the asset from TRE projects
+)
+
+type AssetExpiryAction string
+
+type AssetExpiryPolicy struct {
+	EnforcementPolicy
+	Action AssetExpiryAction
+}
+
+type ScheduledExportPolicy struct {
+	Enabled  bool
+	Format   string        // e.g. csv
+	Interval time.Duration // Period between exports
+}
+
+type MalwareScanningBundle struct {
+	Enabled bool
+	Network string // tcp or unix
+	Address string // host:port or the path of the socket
+	Timeout time.Duration
+}
+
+type S3EncryptionBundle struct {
+	Enabled bool
+	KeyID   string            // Id of the key encryption key used for new objects
+	Keys    map[string]string // Base64 encoded 32 byte key encryption keys by id
+}
+
 type S3CredentialBundle struct {
 	AccessKeyId     string
 	SecretAccessKey string
@@ -0,0 +1,257 @@
+package s3
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ucl-arc-tre/portal/internal/config"
+)
+
+// Objects are encrypted with a random per-object data key in fixed size segments,
+// each sealed with AES-256-GCM. The nonce of a segment is its index with a flag
+// set on the last one, so reordered, dropped or truncated segments fail to open.
+// The data key is wrapped with a key encryption key (KEK) and stored, with the
+// KEK id, in the object's user metadata.
+const (
+	encryptionScheme = "aes-256-gcm-segmented-v1"
+
+	metadataEncryptionKey = "portal-encryption"
+	metadataKeyIdKey      = "portal-key-id"
+	metadataWrappedKeyKey = "portal-wrapped-key"
+
+	encryptionKeyBytes   = 32
+	encryptionSegmentLen = 64 * 1024
+	encryptionTagLen     = 16
+)
+
+// Content of an encrypted object failed authentication e.g. as it was modified
+var ErrDecryption = errors.New("failed to decrypt object")
+
+type encryptionKeys struct {
+	keyId string            // Used for new objects. Empty if encryption is disabled
+	keks  map[string][]byte // By id
+}
+
+func newEncryptionKeys(bundle config.S3EncryptionBundle) (encryptionKeys, error) {
+	keys := encryptionKeys{keks: map[string][]byte{}}
+	for id, encoded := range bundle.Keys {
+		kek, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return keys, fmt.Errorf("key encryption key [%v] is not valid base64: %w", id, err)
+		}
+		if len(kek) != encryptionKeyBytes {
+			return keys, fmt.Errorf("key encryption key [%v] must be %d bytes", id, encryptionKeyBytes)
+		}
+		keys.keks[id] = kek
+	}
+	if !bundle.Enabled {
+		return keys, nil
+	}
+	if _, exists := keys.keks[bundle.KeyID]; !exists {
+		return keys, fmt.Errorf("key encryption key [%v] is not configured", bundle.KeyID)
+	}
+	keys.keyId = bundle.KeyID
+	return keys, nil
+}
+
+func (e encryptionKeys) enabled() bool {
+	return e.keyId != ""
+}
+
+// Encrypt content for the object with a new data key, returning the ciphertext
+// and the user metadata to store alongside it
+func (e encryptionKeys) encrypt(key string, content io.Reader) (io.Reader, map[string]string, error) {
+	dataKey := make([]byte, encryptionKeyBytes)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+	wrapped, err := wrapDataKey(e.keks[e.keyId], dataKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata := map[string]string{
+		metadataEncryptionKey: encryptionScheme,
+		metadataKeyIdKey:      e.keyId,
+		metadataWrappedKeyKey: base64.StdEncoding.EncodeToString(wrapped),
+	}
+	reader := &encryptingReader{
+		source: bufio.NewReaderSize(content, encryptionSegmentLen),
+		aead:   aead,
+		plain:  make([]byte, encryptionSegmentLen),
+	}
+	return reader, metadata, nil
+}
+
+// Decrypt the content of an object given its user metadata. Objects stored
+// without encryption are returned as they are
+func (e encryptionKeys) decrypt(key string, content io.Reader, metadata map[string]string) (io.Reader, error) {
+	scheme, isEncrypted := metadata[metadataEncryptionKey]
+	if !isEncrypted {
+		return content, nil
+	}
+	if scheme != encryptionScheme {
+		return nil, fmt.Errorf("unsupported encryption scheme [%v]", scheme)
+	}
+	keyId := metadata[metadataKeyIdKey]
+	kek, exists := e.keks[keyId]
+	if !exists {
+		return nil, fmt.Errorf("key encryption key [%v] is not configured", keyId)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(metadata[metadataWrappedKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid wrapped key: %w", ErrDecryption, err)
+	}
+	dataKey, err := unwrapDataKey(kek, wrapped, key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	reader := &decryptingReader{
+		source: bufio.NewReaderSize(content, encryptionSegmentLen+encryptionTagLen),
+		aead:   aead,
+		sealed: make([]byte, encryptionSegmentLen+encryptionTagLen),
+	}
+	return reader, nil
+}
+
+// Number of plaintext bytes in an encrypted object of the given size
+func plaintextNumBytes(ciphertextNumBytes int64) int64 {
+	sealedSegmentLen := int64(encryptionSegmentLen + encryptionTagLen)
+	numSegments := max(1, (ciphertextNumBytes+sealedSegmentLen-1)/sealedSegmentLen)
+	return max(0, ciphertextNumBytes-numSegments*encryptionTagLen)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Wrap a data key with the KEK, binding it to the object key so it cannot be
+// reused for another object
+func wrapDataKey(kek []byte, dataKey []byte, key string) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(key)), nil
+}
+
+func unwrapDataKey(kek []byte, wrapped []byte, key string) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: wrapped key too short", ErrDecryption)
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to unwrap key: %w", ErrDecryption, err)
+	}
+	return dataKey, nil
+}
+
+func segmentNonce(index uint64, isFinal bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[3:11], index)
+	if isFinal {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// Read a full segment from the source and whether it is the last one
+func readSegment(source *bufio.Reader, buffer []byte) (int, bool, error) {
+	n, err := io.ReadFull(source, buffer)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, true, nil
+	} else if err != nil {
+		return n, false, err
+	}
+	if _, err := source.Peek(1); errors.Is(err, io.EOF) {
+		return n, true, nil
+	} else if err != nil {
+		return n, false, err
+	}
+	return n, false, nil
+}
+
+type encryptingReader struct {
+	source  *bufio.Reader
+	aead    cipher.AEAD
+	plain   []byte
+	pending []byte // Sealed bytes not yet read
+	index   uint64
+	done    bool
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, isFinal, err := readSegment(r.source, r.plain)
+		if err != nil {
+			return 0, err
+		}
+		r.pending = r.aead.Seal(nil, segmentNonce(r.index, isFinal), r.plain[:n], nil)
+		r.index++
+		r.done = isFinal
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+type decryptingReader struct {
+	source  *bufio.Reader
+	aead    cipher.AEAD
+	sealed  []byte
+	pending []byte // Opened bytes not yet read
+	index   uint64
+	done    bool
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, isFinal, err := readSegment(r.source, r.sealed)
+		if err != nil {
+			return 0, err
+		}
+		plain, err := r.aead.Open(nil, segmentNonce(r.index, isFinal), r.sealed[:n], nil)
+		if err != nil {
+			return 0, fmt.Errorf("%w: segment [%d]: %w", ErrDecryption, r.index, err)
+		}
+		r.pending = plain
+		r.index++
+		r.done = isFinal
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
@@ -0,0 +1,139 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/config"
+)
+
+func testEncryptionKeys(t *testing.T) encryptionKeys {
+	t.Helper()
+	kek := make([]byte, encryptionKeyBytes)
+	_, err := rand.Read(kek)
+	require.NoError(t, err)
+	keys, err := newEncryptionKeys(config.S3EncryptionBundle{
+		Enabled: true,
+		KeyID:   "test",
+		Keys:    map[string]string{"test": base64.StdEncoding.EncodeToString(kek)},
+	})
+	require.NoError(t, err)
+	return keys
+}
+
+func encryptBytes(t *testing.T, keys encryptionKeys, key string, plain []byte) ([]byte, map[string]string) {
+	t.Helper()
+	reader, metadata, err := keys.encrypt(key, bytes.NewReader(plain))
+	require.NoError(t, err)
+	ciphertext, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return ciphertext, metadata
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	keys := testEncryptionKeys(t)
+	for _, numBytes := range []int{0, 1, encryptionSegmentLen - 1, encryptionSegmentLen, encryptionSegmentLen + 1, 3 * encryptionSegmentLen} {
+		plain := make([]byte, numBytes)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+
+		ciphertext, metadata := encryptBytes(t, keys, "contract/a", plain)
+		assert.Equal(t, encryptionScheme, metadata[metadataEncryptionKey])
+		assert.Equal(t, "test", metadata[metadataKeyIdKey])
+		assert.Equal(t, int64(numBytes), plaintextNumBytes(int64(len(ciphertext))))
+		if numBytes > 0 {
+			assert.False(t, bytes.Contains(ciphertext, plain))
+		}
+
+		reader, err := keys.decrypt("contract/a", bytes.NewReader(ciphertext), metadata)
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, plain, decrypted, "size %d", numBytes)
+	}
+}
+
+func TestDecryptDetectsTampering(t *testing.T) {
+	keys := testEncryptionKeys(t)
+	plain := bytes.Repeat([]byte("a"), 2*encryptionSegmentLen+10)
+	ciphertext, metadata := encryptBytes(t, keys, "contract/a", plain)
+
+	readAll := func(ciphertext []byte, key string) error {
+		reader, err := keys.decrypt(key, bytes.NewReader(ciphertext), metadata)
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(reader)
+		return err
+	}
+
+	modified := bytes.Clone(ciphertext)
+	modified[encryptionSegmentLen+100] ^= 1
+	assert.ErrorIs(t, readAll(modified, "contract/a"), ErrDecryption)
+
+	truncated := ciphertext[:2*(encryptionSegmentLen+encryptionTagLen)]
+	assert.ErrorIs(t, readAll(truncated, "contract/a"), ErrDecryption)
+
+	assert.ErrorIs(t, readAll(ciphertext, "contract/b"), ErrDecryption, "data key is bound to the object")
+	assert.NoError(t, readAll(ciphertext, "contract/a"))
+}
+
+func TestDecryptUnencryptedObject(t *testing.T) {
+	keys := testEncryptionKeys(t)
+	reader, err := keys.decrypt("contract/a", strings.NewReader("plain"), map[string]string{})
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "plain", string(content))
+}
+
+func TestDecryptWithRetiredKey(t *testing.T) {
+	retired := testEncryptionKeys(t)
+	ciphertext, metadata := encryptBytes(t, retired, "contract/a", []byte("data"))
+
+	keys, err := newEncryptionKeys(config.S3EncryptionBundle{
+		Enabled: true,
+		KeyID:   "new",
+		Keys: map[string]string{
+			"new":  base64.StdEncoding.EncodeToString(make([]byte, encryptionKeyBytes)),
+			"test": base64.StdEncoding.EncodeToString(retired.keks["test"]),
+		},
+	})
+	require.NoError(t, err)
+	reader, err := keys.decrypt("contract/a", bytes.NewReader(ciphertext), metadata)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(content))
+
+	delete(keys.keks, "test")
+	_, err = keys.decrypt("contract/a", bytes.NewReader(ciphertext), metadata)
+	assert.Error(t, err)
+}
+
+func TestNewEncryptionKeys(t *testing.T) {
+	validKey := base64.StdEncoding.EncodeToString(make([]byte, encryptionKeyBytes))
+
+	keys, err := newEncryptionKeys(config.S3EncryptionBundle{})
+	assert.NoError(t, err)
+	assert.False(t, keys.enabled())
+
+	_, err = newEncryptionKeys(config.S3EncryptionBundle{Enabled: true, KeyID: "a"})
+	assert.Error(t, err, "enabled key must be configured")
+
+	_, err = newEncryptionKeys(config.S3EncryptionBundle{Keys: map[string]string{"a": "c2hvcnQ="}})
+	assert.Error(t, err, "keys must be 32 bytes")
+
+	_, err = newEncryptionKeys(config.S3EncryptionBundle{Keys: map[string]string{"a": "%%%"}})
+	assert.Error(t, err, "keys must be base64")
+
+	keys, err = newEncryptionKeys(config.S3EncryptionBundle{Enabled: true, KeyID: "a", Keys: map[string]string{"a": validKey}})
+	assert.NoError(t, err)
+	assert.True(t, keys.enabled())
+}
@@ -2,12 +2,16 @@ package s3
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	awsCredentials "github.com/aws/aws-sdk-go-v2/credentials"
 	awsS3Manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	awsS3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awsS3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/rs/zerolog/log"
 
 	"github.com/ucl-arc-tre/portal/internal/config"
@@ -30,11 +34,16 @@ type ClientInterface interface {
 type Controller struct {
 	client   ClientInterface
 	uploader *awsS3Manager.Uploader
+	keys     encryptionKeys
 }
 
 func New() *Controller {
 	credentials := config.S3Credentials()
 	log.Debug().Any("accessKeyId", credentials.AccessKeyId).Msg("Creating S3 controller")
+	keys, err := newEncryptionKeys(config.S3Encryption())
+	if err != nil {
+		panic(fmt.Errorf("invalid s3 encryption config: %w", err))
+	}
 	config, err := awsConfig.LoadDefaultConfig(
 		context.Background(),
 		awsConfig.WithCredentialsProvider(awsCredentials.StaticCredentialsProvider{
@@ -57,17 +66,27 @@ func New() *Controller {
 	controller := Controller{
 		client:   client,
 		uploader: awsS3Manager.NewUploader(client),
+		keys:     keys,
 	}
 	return &controller
 }
 
 func (c *Controller) StoreObject(ctx context.Context, metadata ObjectMetadata, obj types.S3Object) error {
 	log.Debug().Any("metadata", metadata).Msg("Uploading S3 object")
-	_, err := c.uploader.Upload(ctx, &awsS3.PutObjectInput{
+	input := &awsS3.PutObjectInput{
 		Bucket: aws.String(config.S3BucketName()),
 		Key:    aws.String(metadata.Key()),
 		Body:   obj.Content,
-	})
+	}
+	if c.keys.enabled() {
+		content, userMetadata, err := c.keys.encrypt(metadata.Key(), obj.Content)
+		if err != nil {
+			return types.NewErrServerErrorF("failed to encrypt object: %v", err)
+		}
+		input.Body = content
+		input.Metadata = userMetadata
+	}
+	_, err := c.uploader.Upload(ctx, input)
 	return types.NewErrServerError(err)
 }
 
@@ -77,13 +96,23 @@ func (c *Controller) GetObject(ctx context.Context, metadata ObjectMetadata) (ty
 		Bucket: aws.String(config.S3BucketName()),
 		Key:    aws.String(metadata.Key()),
 	})
-	if err != nil {
+	if _, isMissing := errors.AsType[*awsS3Types.NoSuchKey](err); isMissing {
+		return types.S3Object{}, types.NewNotFoundError(fmt.Sprintf("object [%v] does not exist", metadata.Key()))
+	} else if err != nil {
 		return types.S3Object{}, types.NewErrServerError(err)
 	}
+	content, err := c.keys.decrypt(metadata.Key(), output.Body, output.Metadata)
+	if err != nil {
+		_ = output.Body.Close()
+		return types.S3Object{}, fmt.Errorf("%w: object [%v]: %w", types.ErrServerError, metadata.Key(), err)
+	}
 	object := types.S3Object{
-		Content:  output.Body,
+		Content:  readCloser{Reader: content, Closer: output.Body},
 		NumBytes: output.ContentLength,
 	}
+	if _, isEncrypted := output.Metadata[metadataEncryptionKey]; isEncrypted && object.NumBytes != nil {
+		object.NumBytes = new(plaintextNumBytes(*object.NumBytes))
+	}
 	return object, nil
 }
 
@@ -99,6 +128,11 @@ func (c *Controller) DeleteObject(metadata ObjectMetadata) error {
 	return nil
 }
 
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 func makeResolver() awsS3.EndpointResolverV2 {
 	s3DevHostIsSet := config.S3DevHost() != ""
 	if s3DevHostIsSet && (!config.IsDevDeploy() && !config.IsTesting()) {
@@ -7,7 +7,12 @@ import (
 )
 
 const (
-	ContractKind = ObjectKind("contract")
+	ContractKind               = ObjectKind("contract")
+	ContractQuarantineKind     = ObjectKind("contract-quarantine") // Until scanned clean
+	ApprovalLetterKind         = ObjectKind("approval-letter")
+	StudyDocumentKind          = ObjectKind("study-document")
+	DestructionCertificateKind = ObjectKind("destruction-certificate")
+	AssetRegisterKind          = ObjectKind("asset-register")
 )
 
 type ObjectKind string
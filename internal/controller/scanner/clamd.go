@@ -0,0 +1,108 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const (
+	clamdChunkBytes = 64 * 1024 // Below the default StreamMaxLength of clamd
+
+	clamdOKReply       = "OK"
+	clamdFoundSuffix   = " FOUND"
+	clamdErrorSuffix   = " ERROR"
+	clamdStreamPrefix  = "stream: "
+	clamdInstreamCmd   = "zINSTREAM\x00"
+	clamdReplyDelim    = '\x00'
+	clamdMaxReplyBytes = 4096
+)
+
+// Scanner using a ClamAV daemon over TCP or a unix socket with the INSTREAM command
+type Clamd struct {
+	network string // tcp or unix
+	address string // host:port or the path of the socket
+	timeout time.Duration
+}
+
+func NewClamd(network string, address string, timeout time.Duration) *Clamd {
+	return &Clamd{network: network, address: address, timeout: timeout}
+}
+
+func (c *Clamd) Scan(ctx context.Context, content io.Reader) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return Result{}, types.NewErrServerErrorF("failed to connect to clamd: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Err(err).Msg("Failed to close clamd connection")
+		}
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return Result{}, types.NewErrServerError(err)
+		}
+	}
+
+	if err := writeInstream(conn, content); err != nil {
+		return Result{}, types.NewErrServerErrorF("failed to stream to clamd: %w", err)
+	}
+
+	reply, err := bufio.NewReader(io.LimitReader(conn, clamdMaxReplyBytes)).ReadString(clamdReplyDelim)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Result{}, types.NewErrServerErrorF("failed to read clamd reply: %w", err)
+	}
+	return parseClamdReply(reply)
+}
+
+// Send content as length prefixed chunks terminated by a zero length chunk
+func writeInstream(w io.Writer, content io.Reader) error {
+	if _, err := io.WriteString(w, clamdInstreamCmd); err != nil {
+		return err
+	}
+	chunk := make([]byte, clamdChunkBytes)
+	for {
+		n, err := content.Read(chunk)
+		if n > 0 {
+			if err := binary.Write(w, binary.BigEndian, uint32(n)); err != nil {
+				return err
+			} else if _, err := w.Write(chunk[:n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return binary.Write(w, binary.BigEndian, uint32(0))
+}
+
+// Result of a reply e.g. "stream: OK" or "stream: Eicar-Signature FOUND"
+func parseClamdReply(reply string) (Result, error) {
+	reply = strings.TrimPrefix(strings.TrimRight(reply, "\x00\n"), clamdStreamPrefix)
+	switch {
+	case reply == clamdOKReply:
+		return Result{}, nil
+	case strings.HasSuffix(reply, clamdFoundSuffix):
+		return Result{Infected: true, Signature: strings.TrimSuffix(reply, clamdFoundSuffix)}, nil
+	case strings.HasSuffix(reply, clamdErrorSuffix):
+		return Result{}, types.NewErrServerErrorF("clamd failed to scan: %s", strings.TrimSuffix(reply, clamdErrorSuffix))
+	default:
+		return Result{}, types.NewErrServerErrorF("unexpected clamd reply [%v]", reply)
+	}
+}
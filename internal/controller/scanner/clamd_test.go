@@ -0,0 +1,101 @@
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// Stand-in for clamd which finds the EICAR test file in a stream
+func serveClamd(t *testing.T, listener net.Listener) {
+	t.Helper()
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				reader := bufio.NewReader(conn)
+				if command, err := reader.ReadString('\x00'); err != nil || command != clamdInstreamCmd {
+					_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+					return
+				}
+				content := bytes.Buffer{}
+				for {
+					var size uint32
+					if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+						return
+					} else if size == 0 {
+						break
+					} else if _, err := io.CopyN(&content, reader, int64(size)); err != nil {
+						return
+					}
+				}
+				if strings.Contains(content.String(), eicar) {
+					_, _ = io.WriteString(conn, "stream: Eicar-Signature FOUND\x00")
+				} else {
+					_, _ = io.WriteString(conn, "stream: OK\x00")
+				}
+			}()
+		}
+	}()
+}
+
+func TestClamdScan(t *testing.T) {
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	serveClamd(t, tcpListener)
+	socketPath := filepath.Join(t.TempDir(), "clamd.sock")
+	unixListener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	serveClamd(t, unixListener)
+
+	large := strings.Repeat("a", 3*clamdChunkBytes+1) // spans multiple chunks
+	for _, clamd := range []*Clamd{
+		NewClamd("tcp", tcpListener.Addr().String(), time.Second),
+		NewClamd("unix", socketPath, time.Second),
+	} {
+		result, err := clamd.Scan(context.Background(), strings.NewReader("a clean contract"))
+		require.NoError(t, err)
+		assert.False(t, result.Infected)
+
+		result, err = clamd.Scan(context.Background(), strings.NewReader(large+eicar))
+		require.NoError(t, err)
+		assert.True(t, result.Infected)
+		assert.Equal(t, "Eicar-Signature", result.Signature)
+	}
+
+	_, err = NewClamd("unix", filepath.Join(t.TempDir(), "missing.sock"), time.Second).Scan(context.Background(), strings.NewReader(""))
+	assert.ErrorIs(t, err, types.ErrServerError)
+}
+
+func TestParseClamdReply(t *testing.T) {
+	result, err := parseClamdReply("stream: OK\x00")
+	require.NoError(t, err)
+	assert.False(t, result.Infected)
+
+	result, err = parseClamdReply("stream: Win.Test.EICAR_HDB-1 FOUND\x00")
+	require.NoError(t, err)
+	assert.Equal(t, Result{Infected: true, Signature: "Win.Test.EICAR_HDB-1"}, result)
+
+	for _, reply := range []string{"INSTREAM size limit exceeded. ERROR\x00", "", "stream: ???"} {
+		_, err := parseClamdReply(reply)
+		assert.ErrorIs(t, err, types.ErrServerError)
+	}
+}
@@ -0,0 +1,11 @@
+package scanner
+
+import (
+	"context"
+	"io"
+)
+
+// Scans content for malware
+type Interface interface {
+	Scan(ctx context.Context, content io.Reader) (Result, error)
+}
@@ -0,0 +1,16 @@
+package scanner
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+)
+
+// Scanner configured for the deployment. Nil if malware scanning is disabled
+func New() Interface {
+	cfg := config.MalwareScanning()
+	if !cfg.Enabled {
+		log.Warn().Msg("Malware scanning disabled - nil scanner")
+		return nil
+	}
+	return NewClamd(cfg.Network, cfg.Address, cfg.Timeout)
+}
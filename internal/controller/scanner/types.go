@@ -0,0 +1,6 @@
+package scanner
+
+type Result struct {
+	Infected  bool
+	Signature string // Name of the malware found, if infected
+}
@@ -1,6 +1,7 @@
 package graceful
 
 import (
+	"fmt"
 	"slices"
 	"sync"
 	"time"
@@ -34,12 +35,30 @@ func InitDB() {
 		&types.Study{},
 		&types.StudyAdmin{},
 		&types.StudyOwnerChangelog{},
+		&types.StudySignoff{},
+		&types.StudyRevision{},
+		&types.StudyRevisionChange{},
+		&types.StudyReviewThread{},
+		&types.StudyReviewComment{},
+		&types.Dpia{},
+		&types.DpiaAnswer{},
+		&types.DpiaRisk{},
 		&types.StudyAgreementSignature{},
 		&types.Asset{},
 		&types.AssetLocation{},
 		&types.AssetDataType{},
+		&types.AssetLink{},
+		&types.AssetDestruction{},
+		&types.AssetTransfer{},
+		&types.AssetRegisterExport{},
+		&types.AssetExpiryReview{},
 		&types.Contract{},
 		&types.ContractObjectMetadata{},
+		&types.ContractObjectDownload{},
+		&types.ContractVersion{},
+		&types.RegulatoryApproval{},
+		&types.StudyDocument{},
+		&types.StudyDocumentVersion{},
 		&types.UserSponsorship{},
 		&types.Environment{},
 		&types.Project{},
@@ -47,12 +66,15 @@ func InitDB() {
 		&types.ProjectTRERoleBinding{},
 		&types.ProjectTREVMImage{},
 		&types.ProjectTREUserConfig{},
+		&types.ProjectTRESuspensionChangelog{},
 		&types.ProjectDSH{},
 		&types.ProjectDSHRoleBinding{},
 		&types.ProjectAsset{},
 		&types.TokenVerificationKey{},
 		&types.Token{},
 		&types.Notification{},
+		&types.Erasure{},
+		&types.StoredObject{},
 	}
 	db := NewDB()
 	mustExec(db, `CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`)
@@ -69,6 +91,8 @@ func InitDB() {
 		panic(err)
 	}
 
+	createSearchIndexes(db)
+
 	log.Debug().Msg("Initialised database")
 }
 
@@ -157,3 +181,21 @@ func migrateProjectStatus(db *gorm.DB) {
 		}
 	}
 }
+
+// Trigram indexes on the columns matched by the institution wide search
+func createSearchIndexes(db *gorm.DB) {
+	columns := [][2]string{
+		{"studies", "title"},
+		{"studies", "description"},
+		{"assets", "title"},
+		{"assets", "description"},
+		{"asset_locations", "location"},
+		{"contracts", "title"},
+		{"contracts", "third_party_name"},
+		{"projects", "name"},
+	}
+	for _, column := range columns {
+		table, name := column[0], column[1]
+		mustExec(db, fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_%s_%s_trgm ON %s USING gin (%s gin_trgm_ops)`, table, name, table, name))
+	}
+}
@@ -125,6 +125,7 @@ func toApiProjectResponse(projectTRE types.ProjectTRE) openapi.Project {
 			SshWhitelist:      &projectTRE.AirlockSSHWhitelist,
 		},
 		RequestedVersionUpdatedAt: requestedVersionUpdatedAt(projectTRE),
+		Suspended:                 projectTRE.Status == types.ProjectTREStatusSuspended,
 	}
 
 	// Populate user configs
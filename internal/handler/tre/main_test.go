@@ -147,6 +147,7 @@ func TestToApiProjectResponse(t *testing.T) {
 	assert.Equal(t, float32(200), response.MonthlyBudget)
 	assert.Equal(t, 1, response.EgressNumberRequiredApprovals)
 	assert.True(t, response.EncryptionKeyEnabled)
+	assert.False(t, response.Suspended)
 
 	// Airlock
 	assert.True(t, response.Airlock.HttpEnabled)
@@ -219,3 +220,8 @@ func TestToApiProjectResponse(t *testing.T) {
 	// Requested revision
 	assert.Equal(t, "2026-07-16T14:04:32Z", response.RequestedVersionUpdatedAt)
 }
+
+func TestToApiProjectResponseSuspended(t *testing.T) {
+	response := toApiProjectResponse(types.ProjectTRE{Status: types.ProjectTREStatusSuspended})
+	assert.True(t, response.Suspended)
+}
@@ -20,6 +20,8 @@ func (h *Handler) GetAgreementsAgreementType(ctx *gin.Context, agreementType ope
 		agreement, err = h.agreements.LatestStudyOwner()
 	case openapi.AgreementTypeStudyAdministrator:
 		agreement, err = h.agreements.LatestStudyAdministrator()
+	case openapi.AgreementTypeStudySignoff:
+		agreement, err = h.agreements.LatestStudySignoff()
 	default:
 		err = types.NewNotFoundError("agreement type not found")
 	}
@@ -0,0 +1,151 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdContractsContractIdVersions(ctx *gin.Context, studyId string, contractId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId)
+	if err != nil {
+		return
+	}
+
+	versions, err := h.studies.ContractVersions(uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve contract versions")
+		return
+	}
+
+	response := []openapi.ContractVersion{}
+	for _, version := range versions {
+		response = append(response, contractVersionToOpenApiContractVersion(version))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostStudiesStudyIdContractsContractIdVersions(ctx *gin.Context, studyId string, contractId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.ContractAmendmentRequest{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	version, err := h.studies.RequestContractAmendment(ctx, middleware.GetUser(ctx), uuids[0], uuids[1], data)
+	if err != nil {
+		setError(ctx, err, "Failed to request contract amendment")
+		return
+	}
+	ctx.JSON(http.StatusOK, contractVersionToOpenApiContractVersion(*version))
+}
+
+func (h *Handler) PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects(
+	ctx *gin.Context,
+	studyId string,
+	contractId string,
+	contractVersionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId, contractVersionId)
+	if err != nil {
+		return
+	}
+	h.storeContractObject(ctx, uuids[0], uuids[1], &uuids[2])
+}
+
+func (h *Handler) PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove(
+	ctx *gin.Context,
+	studyId string,
+	contractId string,
+	contractVersionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId, contractVersionId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.ContractAmendmentReview{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	version, err := h.studies.ApproveContractAmendment(middleware.GetUser(ctx), uuids[0], uuids[1], uuids[2], data)
+	if err != nil {
+		setError(ctx, err, "Failed to approve contract amendment")
+		return
+	}
+	ctx.JSON(http.StatusOK, contractVersionToOpenApiContractVersion(*version))
+}
+
+func (h *Handler) PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject(
+	ctx *gin.Context,
+	studyId string,
+	contractId string,
+	contractVersionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId, contractVersionId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.ContractAmendmentReview{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	version, err := h.studies.RejectContractAmendment(middleware.GetUser(ctx), uuids[0], uuids[1], uuids[2], data)
+	if err != nil {
+		setError(ctx, err, "Failed to reject contract amendment")
+		return
+	}
+	ctx.JSON(http.StatusOK, contractVersionToOpenApiContractVersion(*version))
+}
+
+func contractVersionToOpenApiContractVersion(version types.ContractVersion) openapi.ContractVersion {
+	data := openapi.ContractVersion{
+		Id:                version.ID.String(),
+		ContractId:        version.ContractID.String(),
+		Version:           version.Version,
+		Status:            openapi.ContractVersionStatus(version.Status),
+		EffectiveDate:     version.EffectiveDate.Format(config.DateFormat),
+		Reason:            version.Reason,
+		ChangedFields:     []string{},
+		ObjectsMetadata:   []openapi.ContractObjectMetadata{},
+		RequesterUsername: string(version.RequesterUser.Username),
+		ReviewerComments:  version.ReviewerComments,
+		ReviewedAt:        openapi.FormatOptionalTime(version.ReviewedAt),
+		AppliedAt:         openapi.FormatOptionalTime(version.AppliedAt),
+		CreatedAt:         openapi.FormatTime(version.CreatedAt),
+		Terms: openapi.ContractBase{
+			Title:            version.Title,
+			ThirdPartyName:   version.ThirdPartyName,
+			OtherSignatories: version.OtherSignatories,
+			Status:           openapi.ContractBaseStatus(version.ContractStatus),
+			StartDate:        openapi.FormatOptionalDate(version.StartDate),
+			ExpiryDate:       openapi.FormatOptionalDate(version.ExpiryDate),
+			AssetIds:         []string{},
+		},
+	}
+	data.ChangedFields = append(data.ChangedFields, version.ChangedFields...)
+	for _, assetID := range version.AssetIDs {
+		data.Terms.AssetIds = append(data.Terms.AssetIds, assetID.String())
+	}
+	for _, object := range version.Objects {
+		data.ObjectsMetadata = append(data.ObjectsMetadata, contractObjectToOpenApiContractObject(object))
+	}
+	if version.SignatoryUser != nil {
+		data.Terms.OrganisationSignatory = new(string(version.SignatoryUser.Username))
+	}
+	if version.ReviewerUser != nil {
+		data.ReviewerUsername = new(string(version.ReviewerUser.Username))
+	}
+	return data
+}
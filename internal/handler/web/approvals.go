@@ -0,0 +1,156 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdApprovals(ctx *gin.Context, studyId string) {
+	studyUuid, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	approvals, err := h.studies.StudyApprovals(studyUuid)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve approvals")
+		return
+	}
+
+	response := []openapi.RegulatoryApproval{}
+	for _, approval := range approvals {
+		response = append(response, approvalToOpenApiApproval(approval))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostStudiesStudyIdApprovals(ctx *gin.Context, studyId string) {
+	studyUuid, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	var data openapi.RegulatoryApprovalBase
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	approval, err := h.studies.CreateApproval(ctx, studyUuid, data, middleware.GetUser(ctx))
+	if err != nil {
+		setError(ctx, err, "Failed to create approval")
+		return
+	}
+	ctx.JSON(http.StatusOK, approvalToOpenApiApproval(*approval))
+}
+
+func (h *Handler) PutStudiesStudyIdApprovalsApprovalId(ctx *gin.Context, studyId string, approvalId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, approvalId)
+	if err != nil {
+		return
+	}
+
+	var data openapi.RegulatoryApprovalBase
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	approval, err := h.studies.UpdateApproval(ctx, uuids[0], uuids[1], data)
+	if err != nil {
+		setError(ctx, err, "Failed to update approval")
+		return
+	}
+	ctx.JSON(http.StatusOK, approvalToOpenApiApproval(*approval))
+}
+
+func (h *Handler) DeleteStudiesStudyIdApprovalsApprovalId(ctx *gin.Context, studyId string, approvalId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, approvalId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.DeleteApproval(uuids[0], uuids[1]); err != nil {
+		setError(ctx, err, "Failed to delete approval")
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) PostStudiesStudyIdApprovalsApprovalIdLetter(ctx *gin.Context, studyId string, approvalId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, approvalId)
+	if err != nil {
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		setError(ctx, types.NewErrInvalidObject(err), "Failed to get uploaded file")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		setError(ctx, types.NewErrServerError(err), "Failed to open uploaded file")
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Err(err).Msg("Failed to close uploaded file")
+		}
+	}()
+
+	if err := validateDocumentMimeType(ctx, fileHeader.Filename, file); err != nil {
+		return
+	}
+
+	approval, err := h.studies.StoreApprovalLetter(ctx, uuids[0], uuids[1], fileHeader.Filename, types.S3Object{Content: file})
+	if err != nil {
+		setError(ctx, err, "Failed to store approval letter")
+		return
+	}
+	ctx.JSON(http.StatusOK, approvalToOpenApiApproval(*approval))
+}
+
+func (h *Handler) GetStudiesStudyIdApprovalsApprovalIdLetter(ctx *gin.Context, studyId string, approvalId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, approvalId)
+	if err != nil {
+		return
+	}
+
+	object, err := h.studies.GetApprovalLetter(ctx, uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to get approval letter")
+		return
+	} else if object.NumBytes == nil {
+		setError(ctx, types.NewErrServerError("approval letter missing content length"), "Failed to get approval letter")
+		return
+	}
+	ctx.DataFromReader(
+		http.StatusOK,
+		*object.NumBytes,
+		"application/octet-stream",
+		object.Content,
+		attachmentHeaders,
+	)
+}
+
+func approvalToOpenApiApproval(approval types.RegulatoryApproval) openapi.RegulatoryApproval {
+	return openapi.RegulatoryApproval{
+		Id:             approval.ID.String(),
+		StudyId:        approval.StudyID.String(),
+		Type:           openapi.RegulatoryApprovalType(approval.Type),
+		Reference:      approval.Reference,
+		IssuingBody:    approval.IssuingBody,
+		GrantedDate:    approval.GrantedDate.Format(config.DateFormat),
+		ExpiryDate:     openapi.FormatOptionalDate(approval.ExpiryDate),
+		Conditions:     approval.Conditions,
+		LetterFilename: approval.LetterFilename,
+		CreatedAt:      openapi.FormatTime(approval.CreatedAt),
+		UpdatedAt:      openapi.FormatTime(approval.UpdatedAt),
+	}
+}
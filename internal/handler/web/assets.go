@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/ucl-arc-tre/portal/internal/middleware"
 	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
 	"github.com/ucl-arc-tre/portal/internal/types"
 )
 
@@ -104,6 +105,111 @@ func (h *Handler) DeleteStudiesStudyIdAssetsAssetId(
 	ctx.Status(http.StatusNoContent)
 }
 
+func (h *Handler) PostStudiesStudyIdAssetsAssetIdParents(ctx *gin.Context, studyId string, assetId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.AssetLinkRequest{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	link, err := h.studies.LinkAsset(middleware.GetUser(ctx), uuids[0], uuids[1], data)
+	if err != nil {
+		setError(ctx, err, "Failed to link asset")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, assetLinkToOpenApiAssetLink(*link))
+}
+
+func (h *Handler) DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId(
+	ctx *gin.Context,
+	studyId string,
+	assetId string,
+	parentAssetId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId, parentAssetId)
+	if err != nil {
+		return
+	}
+
+	err = h.studies.UnlinkAsset(uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		setError(ctx, err, "Failed to unlink asset")
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) GetStudiesStudyIdLineage(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	assets, links, err := h.studies.AssetLineage(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset lineage")
+		return
+	}
+
+	response := openapi.AssetLineage{Assets: []openapi.AssetLineageNode{}, Links: []openapi.AssetLink{}}
+	for _, asset := range assets {
+		response.Assets = append(response.Assets, openapi.AssetLineageNode{
+			Id:     asset.ID.String(),
+			Title:  asset.Title,
+			Tier:   asset.Tier,
+			Status: asset.Status,
+		})
+	}
+	for _, link := range links {
+		response.Links = append(response.Links, assetLinkToOpenApiAssetLink(link))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetStudiesAdminAssetLocations(ctx *gin.Context, params openapi.GetStudiesAdminAssetLocationsParams) {
+	var kind *types.AssetLocationKind
+	if params.Kind != nil {
+		if !params.Kind.Valid() {
+			setError(ctx, types.NewErrClientInvalidObjectF("invalid location kind [%v]", *params.Kind), "Invalid location kind")
+			return
+		}
+		kind = new(types.AssetLocationKind(*params.Kind))
+	}
+
+	report, err := h.studies.AssetLocationReport(kind)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset locations")
+		return
+	}
+
+	response := []openapi.AssetLocationReport{}
+	for _, location := range report {
+		item := openapi.AssetLocationReport{
+			Location: assetLocationToOpenApiAssetLocationReference(location.Location),
+			Assets:   []openapi.AssetLocationReportAsset{},
+		}
+		for _, asset := range location.Assets {
+			item.Assets = append(item.Assets, openapi.AssetLocationReportAsset{
+				Id:         asset.ID.String(),
+				Title:      asset.Title,
+				Tier:       asset.Tier,
+				Status:     asset.Status,
+				StudyId:    asset.StudyID.String(),
+				StudyTitle: asset.Study.Title,
+			})
+		}
+		response = append(response, item)
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
 // Helper functions
 
 func assetToOpenApiAsset(data types.Asset) openapi.Asset {
@@ -118,6 +224,7 @@ func assetToOpenApiAsset(data types.Asset) openapi.Asset {
 		Tier:                          data.Tier,
 		Format:                        openapi.AssetFormat(data.Format),
 		ExpiresAt:                     openapi.FormatOptionalTime(data.ExpiresAt),
+		ExpiredAt:                     openapi.FormatOptionalTime(data.ExpiredAt),
 		Locations:                     data.LocationStrings(),
 		RequiresContract:              data.RequiresContract,
 		HasDspt:                       data.HasDspt,
@@ -126,6 +233,7 @@ func assetToOpenApiAsset(data types.Asset) openapi.Asset {
 		CreatedAt:                     openapi.FormatTime(data.CreatedAt),
 		UpdatedAt:                     openapi.FormatTime(data.UpdatedAt),
 		ContractIds:                   []string{},
+		InheritedContractIds:          []string{},
 		DataTypes:                     []openapi.AssetDataTypes{},
 		IsLeakMajorDisruption:         data.IsLeakMajorDisruption,
 		IsLeakMajorFinancialLoss:      data.IsLeakMajorFinancialLoss,
@@ -139,15 +247,51 @@ func assetToOpenApiAsset(data types.Asset) openapi.Asset {
 	for _, contract := range data.Contracts {
 		asset.ContractIds = append(asset.ContractIds, contract.ID.String())
 	}
+	for _, contract := range data.InheritedContracts {
+		asset.InheritedContractIds = append(asset.InheritedContractIds, contract.ID.String())
+	}
 	for _, dataType := range data.DataTypes {
 		asset.DataTypes = append(asset.DataTypes, openapi.AssetDataTypes(dataType.Name))
 	}
+	locationReferences := []openapi.AssetLocationReference{}
+	for _, location := range data.Locations {
+		if location.Kind != types.AssetLocationKindOther {
+			locationReferences = append(locationReferences, assetLocationToOpenApiAssetLocationReference(location))
+		}
+	}
+	asset.LocationReferences = &locationReferences
+	risk := studies.DeriveAssetRisk(data)
+	asset.MinimumTier = risk.MinimumTier
+	asset.RiskScore = risk.Score
 	return asset
 }
 
+func assetLinkToOpenApiAssetLink(link types.AssetLink) openapi.AssetLink {
+	return openapi.AssetLink{
+		ParentAssetId:  link.ParentAssetID.String(),
+		ChildAssetId:   link.ChildAssetID.String(),
+		DerivationType: openapi.AssetDerivationType(link.DerivationType),
+		CreatedAt:      openapi.FormatTime(link.CreatedAt),
+	}
+}
+
 func optionalStr[A ~string, B ~string](a *A) *B {
 	if a == nil {
 		return nil
 	}
 	return new(B(*a))
 }
+
+func assetLocationToOpenApiAssetLocationReference(location types.AssetLocation) openapi.AssetLocationReference {
+	reference := openapi.AssetLocationReference{
+		Kind: openapi.AssetLocationKind(location.Kind),
+		Name: new(location.Name()),
+	}
+	if location.EnvironmentID != nil {
+		reference.EnvironmentId = new(location.EnvironmentID.String())
+	}
+	if location.ProjectID != nil {
+		reference.ProjectId = new(location.ProjectID.String())
+	}
+	return reference
+}
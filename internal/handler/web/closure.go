@@ -0,0 +1,95 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+)
+
+func (h *Handler) GetStudiesStudyIdClosure(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	closure, err := h.studies.StudyClosure(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get study closure")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, studyClosureToOpenApiStudyClosure(*closure))
+}
+
+// Called by the study owner once the research has finished
+func (h *Handler) PostStudiesStudyIdClosure(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.StartStudyClosure(studyUUID); err != nil {
+		setError(ctx, err, "Failed to start study closure")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) PostStudiesStudyIdClosureComplete(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.CompleteStudyClosure(studyUUID); err != nil {
+		setError(ctx, err, "Failed to close study")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) PostStudiesAdminStudyIdArchive(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.ArchiveStudy(studyUUID); err != nil {
+		setError(ctx, err, "Failed to archive study")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func studyClosureToOpenApiStudyClosure(closure studies.StudyClosure) openapi.StudyClosure {
+	response := openapi.StudyClosure{
+		CanComplete:          closure.CanComplete(),
+		OutstandingAssets:    []openapi.StudyClosureItem{},
+		OutstandingContracts: []openapi.StudyClosureItem{},
+		OutstandingProjects:  []openapi.StudyClosureItem{},
+	}
+	for _, asset := range closure.OutstandingAssets {
+		response.OutstandingAssets = append(response.OutstandingAssets, openapi.StudyClosureItem{
+			Id:    asset.ID.String(),
+			Title: asset.Title,
+		})
+	}
+	for _, contract := range closure.OutstandingContracts {
+		response.OutstandingContracts = append(response.OutstandingContracts, openapi.StudyClosureItem{
+			Id:    contract.ID.String(),
+			Title: contract.Title,
+		})
+	}
+	for _, project := range closure.OutstandingProjects {
+		response.OutstandingProjects = append(response.OutstandingProjects, openapi.StudyClosureItem{
+			Id:    project.ID.String(),
+			Title: project.Name,
+		})
+	}
+	return response
+}
@@ -1,11 +1,13 @@
 package web
 
 import (
+	"io"
 	"net/http"
 	"path/filepath"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/ucl-arc-tre/portal/internal/middleware"
 	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
@@ -59,59 +61,7 @@ func (h *Handler) PostStudiesStudyIdContractsContractIdObjects(ctx *gin.Context,
 	if err != nil {
 		return
 	}
-
-	fileHeader, err := ctx.FormFile("file")
-	if err != nil {
-		setError(ctx, types.NewErrInvalidObject(err), "Failed to get uploaded file")
-		return
-	}
-
-	// Open the uploaded file
-	file, err := fileHeader.Open()
-	log.Debug().Msgf("Opened uploaded file [%v]", file)
-	if err != nil {
-		setError(ctx, types.NewErrServerError(err), "Failed to open uploaded file")
-		return
-	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.Err(err).Msg("Failed to close uploaded file")
-		}
-	}()
-	fileExtension := strings.ToLower(filepath.Ext(fileHeader.Filename))
-
-	if mimeType, err := validation.MimeType(file); err != nil {
-		setError(ctx, err, "Failed to determine MIME type of file")
-		return
-	} else if fileExtension == ".doc" &&
-		(mimeType == types.MimeTypeOctetStream || mimeType == types.MimeTypeDoc) {
-		// doc files can have generic MIME type
-	} else if fileExtension == ".docx" &&
-		(mimeType == types.MimeTypeZip || mimeType == types.MimeTypeDocx) {
-		// docx files can have a ZIP file type
-	} else if !validation.IsValidContractMimeType(mimeType) {
-		setError(ctx, types.NewErrInvalidObjectF("mime type was [%v] not valid", mimeType), "Invalid MIME type")
-		return
-	}
-
-	contractObject := studies.ContractObject{
-		Meta: types.ContractObjectMetadata{
-			Filename:   fileHeader.Filename,
-			ContractID: uuids[1],
-		},
-		Object: types.S3Object{Content: file},
-	}
-	metadata, err := h.studies.CreateContractObject(ctx, uuids[0], contractObject)
-	if err != nil {
-		setError(ctx, err, "Failed to store contract")
-		return
-	}
-
-	ctx.JSON(http.StatusOK, openapi.ContractObjectMetadata{
-		Filename:  metadata.Filename,
-		Id:        metadata.ID.String(),
-		CreatedAt: openapi.FormatTime(metadata.CreatedAt),
-	})
+	h.storeContractObject(ctx, uuids[0], uuids[1], nil)
 }
 
 func (h *Handler) DeleteStudiesStudyIdContractsContractId(ctx *gin.Context, studyId string, contractId string) {
@@ -148,6 +98,21 @@ func (h *Handler) PutStudiesStudyIdContractsContractId(ctx *gin.Context, studyId
 	ctx.JSON(http.StatusOK, contractToOpenApiContract(*contract))
 }
 
+func (h *Handler) PostStudiesStudyIdContractsContractIdRenew(ctx *gin.Context, studyId string, contractId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId)
+	if err != nil {
+		return
+	}
+
+	contract, err := h.studies.RenewContract(uuids[0], uuids[1], middleware.GetUser(ctx))
+	if err != nil {
+		setError(ctx, err, "Failed to renew contract")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, contractToOpenApiContract(*contract))
+}
+
 func (h *Handler) GetStudiesStudyIdContracts(ctx *gin.Context, studyId string) {
 	uuids, err := parseUUIDsOrSetError(ctx, studyId)
 	if err != nil {
@@ -196,7 +161,7 @@ func (h *Handler) GetStudiesStudyIdContractsContractIdObjectsContractObjectId(
 	if err != nil {
 		return
 	}
-	object, err := h.studies.GetContractObject(ctx, uuids[0], uuids[1], uuids[2])
+	object, err := h.studies.GetContractObject(ctx, middleware.GetUser(ctx), uuids[0], uuids[1], uuids[2])
 	if err != nil {
 		setError(ctx, err, "Failed get contract object")
 		return
@@ -213,6 +178,32 @@ func (h *Handler) GetStudiesStudyIdContractsContractIdObjectsContractObjectId(
 	)
 }
 
+func (h *Handler) GetStudiesAdminStudyIdContractsContractIdDownloads(ctx *gin.Context, studyId string, contractId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, contractId)
+	if err != nil {
+		return
+	}
+
+	downloads, err := h.studies.ContractObjectDownloads(uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to get contract object downloads")
+		return
+	}
+
+	response := []openapi.ContractObjectDownload{}
+	for _, download := range downloads {
+		response = append(response, openapi.ContractObjectDownload{
+			Id:               download.ID.String(),
+			ContractObjectId: download.ContractObjectID.String(),
+			Filename:         download.ContractObject.Filename,
+			Username:         string(download.User.Username),
+			Watermarked:      download.Watermarked,
+			CreatedAt:        openapi.FormatTime(download.CreatedAt),
+		})
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (h *Handler) DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId(
 	ctx *gin.Context,
 	studyId string,
@@ -234,6 +225,69 @@ func (h *Handler) DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId
 
 // Helper functions
 
+// Check an uploaded file is a document type accepted for contracts and letters
+func validateDocumentMimeType(ctx *gin.Context, filename string, file io.ReadSeeker) error {
+	fileExtension := strings.ToLower(filepath.Ext(filename))
+
+	if mimeType, err := validation.MimeType(file); err != nil {
+		setError(ctx, err, "Failed to determine MIME type of file")
+		return err
+	} else if fileExtension == ".doc" &&
+		(mimeType == types.MimeTypeOctetStream || mimeType == types.MimeTypeDoc) {
+		// doc files can have generic MIME type
+	} else if fileExtension == ".docx" &&
+		(mimeType == types.MimeTypeZip || mimeType == types.MimeTypeDocx) {
+		// docx files can have a ZIP file type
+	} else if !validation.IsValidContractMimeType(mimeType) {
+		err := types.NewErrInvalidObjectF("mime type was [%v] not valid", mimeType)
+		setError(ctx, err, "Invalid MIME type")
+		return err
+	}
+	return nil
+}
+
+// Store an uploaded contract object, optionally of an amendment
+func (h *Handler) storeContractObject(ctx *gin.Context, studyID uuid.UUID, contractID uuid.UUID, contractVersionID *uuid.UUID) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		setError(ctx, types.NewErrInvalidObject(err), "Failed to get uploaded file")
+		return
+	}
+
+	// Open the uploaded file
+	file, err := fileHeader.Open()
+	log.Debug().Msgf("Opened uploaded file [%v]", file)
+	if err != nil {
+		setError(ctx, types.NewErrServerError(err), "Failed to open uploaded file")
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Err(err).Msg("Failed to close uploaded file")
+		}
+	}()
+
+	if err := validateDocumentMimeType(ctx, fileHeader.Filename, file); err != nil {
+		return
+	}
+
+	contractObject := studies.ContractObject{
+		Meta: types.ContractObjectMetadata{
+			Filename:          fileHeader.Filename,
+			ContractID:        contractID,
+			ContractVersionID: contractVersionID,
+		},
+		Object: types.S3Object{Content: file},
+	}
+	metadata, err := h.studies.CreateContractObject(ctx, studyID, contractObject)
+	if err != nil {
+		setError(ctx, err, "Failed to store contract")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, contractObjectToOpenApiContractObject(*metadata))
+}
+
 func contractToOpenApiContract(contract types.Contract) openapi.Contract {
 	data := openapi.Contract{
 		Id:                    contract.ID.String(),
@@ -250,16 +304,33 @@ func contractToOpenApiContract(contract types.Contract) openapi.Contract {
 		StartDate:             openapi.FormatOptionalDate(contract.StartDate),
 		ExpiryDate:            openapi.FormatOptionalDate(contract.ExpiryDate),
 		RetentionEndDate:      openapi.FormatOptionalDate(contract.RetentionEndDate),
+		Version:               contract.Version,
 	}
 	for _, asset := range contract.Assets {
 		data.AssetIds = append(data.AssetIds, asset.ID.String())
 	}
 	for _, object := range contract.Objects {
-		data.ObjectsMetadata = append(data.ObjectsMetadata, openapi.ContractObjectMetadata{
-			Filename:  object.Filename,
-			Id:        object.ID.String(),
-			CreatedAt: openapi.FormatTime(object.CreatedAt),
-		})
+		data.ObjectsMetadata = append(data.ObjectsMetadata, contractObjectToOpenApiContractObject(object))
+	}
+	if contract.PredecessorContractID != nil {
+		data.PredecessorContractId = new(contract.PredecessorContractID.String())
+	}
+	if contract.Successor != nil {
+		data.SuccessorContractId = new(contract.Successor.ID.String())
+	}
+	return data
+}
+
+func contractObjectToOpenApiContractObject(object types.ContractObjectMetadata) openapi.ContractObjectMetadata {
+	data := openapi.ContractObjectMetadata{
+		Filename:   object.Filename,
+		Id:         object.ID.String(),
+		ScanStatus: openapi.MalwareScanStatus(object.ScanStatus),
+		ScannedAt:  openapi.FormatOptionalTime(object.ScannedAt),
+		CreatedAt:  openapi.FormatTime(object.CreatedAt),
+	}
+	if object.ContractVersionID != nil {
+		data.ContractVersionId = new(object.ContractVersionID.String())
 	}
 	return data
 }
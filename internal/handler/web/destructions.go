@@ -0,0 +1,168 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesAdminAssetDestructions(ctx *gin.Context) {
+	destructions, err := h.studies.PendingAssetDestructions()
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset destructions")
+		return
+	}
+
+	response := []openapi.AssetDestruction{}
+	for _, destruction := range destructions {
+		response = append(response, assetDestructionToOpenApiAssetDestruction(destruction))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetStudiesStudyIdAssetsAssetIdDestructions(ctx *gin.Context, studyId string, assetId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId)
+	if err != nil {
+		return
+	}
+
+	destructions, err := h.studies.AssetDestructions(uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset destructions")
+		return
+	}
+
+	response := []openapi.AssetDestruction{}
+	for _, destruction := range destructions {
+		response = append(response, assetDestructionToOpenApiAssetDestruction(destruction))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostStudiesStudyIdAssetsAssetIdDestructions(ctx *gin.Context, studyId string, assetId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId)
+	if err != nil {
+		return
+	}
+
+	upload, closeUpload, err := studyDocumentUploadOrSetError(ctx)
+	if err != nil {
+		return
+	}
+	defer closeUpload()
+
+	destruction, err := h.studies.RequestAssetDestruction(
+		ctx,
+		uuids[0],
+		uuids[1],
+		middleware.GetUser(ctx),
+		openapi.AssetDestructionMethod(ctx.PostForm("method")),
+		ctx.PostForm("destroyed_at"),
+		*upload,
+	)
+	if err != nil {
+		setError(ctx, err, "Failed to request asset destruction")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetDestructionToOpenApiAssetDestruction(*destruction))
+}
+
+func (h *Handler) GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate(
+	ctx *gin.Context,
+	studyId string,
+	assetId string,
+	destructionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId, destructionId)
+	if err != nil {
+		return
+	}
+
+	object, err := h.studies.GetDestructionCertificate(ctx, uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		setError(ctx, err, "Failed to get destruction certificate")
+		return
+	} else if object.NumBytes == nil {
+		setError(ctx, types.NewErrServerError("destruction certificate missing content length"), "Failed to get destruction certificate")
+		return
+	}
+	ctx.DataFromReader(
+		http.StatusOK,
+		*object.NumBytes,
+		"application/octet-stream",
+		object.Content,
+		attachmentHeaders,
+	)
+}
+
+func (h *Handler) PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove(
+	ctx *gin.Context,
+	studyId string,
+	assetId string,
+	destructionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId, destructionId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.AssetDestructionReview{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	destruction, err := h.studies.ApproveAssetDestruction(middleware.GetUser(ctx), uuids[0], uuids[1], uuids[2], data)
+	if err != nil {
+		setError(ctx, err, "Failed to approve asset destruction")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetDestructionToOpenApiAssetDestruction(*destruction))
+}
+
+func (h *Handler) PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject(
+	ctx *gin.Context,
+	studyId string,
+	assetId string,
+	destructionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId, destructionId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.AssetDestructionReview{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	destruction, err := h.studies.RejectAssetDestruction(middleware.GetUser(ctx), uuids[0], uuids[1], uuids[2], data)
+	if err != nil {
+		setError(ctx, err, "Failed to reject asset destruction")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetDestructionToOpenApiAssetDestruction(*destruction))
+}
+
+func assetDestructionToOpenApiAssetDestruction(destruction types.AssetDestruction) openapi.AssetDestruction {
+	data := openapi.AssetDestruction{
+		Id:                  destruction.ID.String(),
+		AssetId:             destruction.AssetID.String(),
+		StudyId:             destruction.Asset.StudyID.String(),
+		Method:              openapi.AssetDestructionMethod(destruction.Method),
+		DestroyedAt:         destruction.DestroyedAt.Format(config.DateFormat),
+		CertificateFilename: destruction.CertificateFilename,
+		Status:              openapi.AssetDestructionStatus(destruction.Status),
+		RequesterUsername:   string(destruction.RequesterUser.Username),
+		ReviewerComments:    destruction.ReviewerComments,
+		ReviewedAt:          openapi.FormatOptionalTime(destruction.ReviewedAt),
+		CreatedAt:           openapi.FormatTime(destruction.CreatedAt),
+	}
+	if destruction.ReviewerUser != nil {
+		data.ReviewerUsername = new(string(destruction.ReviewerUser.Username))
+	}
+	return data
+}
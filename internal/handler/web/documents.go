@@ -0,0 +1,206 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdDocuments(ctx *gin.Context, studyId string) {
+	studyUuid, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	} else if !h.canAccessStudyDocumentsOrSetError(ctx, studyUuid) {
+		return
+	}
+
+	documents, err := h.studies.StudyDocuments(studyUuid)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve documents")
+		return
+	}
+
+	response := []openapi.StudyDocument{}
+	for _, document := range documents {
+		response = append(response, studyDocumentToOpenApiStudyDocument(document))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostStudiesStudyIdDocuments(ctx *gin.Context, studyId string) {
+	studyUuid, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	} else if !h.canAccessStudyDocumentsOrSetError(ctx, studyUuid) {
+		return
+	}
+
+	upload, closeUpload, err := studyDocumentUploadOrSetError(ctx)
+	if err != nil {
+		return
+	}
+	defer closeUpload()
+
+	document, err := h.studies.CreateStudyDocument(
+		ctx,
+		studyUuid,
+		middleware.GetUser(ctx),
+		openapi.StudyDocumentCategory(ctx.PostForm("category")),
+		ctx.PostForm("title"),
+		*upload,
+	)
+	if err != nil {
+		setError(ctx, err, "Failed to create document")
+		return
+	}
+	ctx.JSON(http.StatusOK, studyDocumentToOpenApiStudyDocument(*document))
+}
+
+func (h *Handler) DeleteStudiesStudyIdDocumentsDocumentId(ctx *gin.Context, studyId string, documentId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, documentId)
+	if err != nil {
+		return
+	} else if !h.canAccessStudyDocumentsOrSetError(ctx, uuids[0]) {
+		return
+	}
+
+	if err := h.studies.DeleteStudyDocument(uuids[0], uuids[1]); err != nil {
+		setError(ctx, err, "Failed to delete document")
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) PostStudiesStudyIdDocumentsDocumentIdVersions(ctx *gin.Context, studyId string, documentId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, documentId)
+	if err != nil {
+		return
+	} else if !h.canAccessStudyDocumentsOrSetError(ctx, uuids[0]) {
+		return
+	}
+
+	upload, closeUpload, err := studyDocumentUploadOrSetError(ctx)
+	if err != nil {
+		return
+	}
+	defer closeUpload()
+
+	document, err := h.studies.AddStudyDocumentVersion(ctx, uuids[0], uuids[1], middleware.GetUser(ctx), *upload)
+	if err != nil {
+		setError(ctx, err, "Failed to add document version")
+		return
+	}
+	ctx.JSON(http.StatusOK, studyDocumentToOpenApiStudyDocument(*document))
+}
+
+func (h *Handler) GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId(
+	ctx *gin.Context,
+	studyId string,
+	documentId string,
+	documentVersionId string,
+) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, documentId, documentVersionId)
+	if err != nil {
+		return
+	} else if !h.canAccessStudyDocumentsOrSetError(ctx, uuids[0]) {
+		return
+	}
+
+	object, err := h.studies.GetStudyDocumentVersion(ctx, uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		setError(ctx, err, "Failed to get document")
+		return
+	} else if object.NumBytes == nil {
+		setError(ctx, types.NewErrServerError("document missing content length"), "Failed to get document")
+		return
+	}
+	ctx.DataFromReader(
+		http.StatusOK,
+		*object.NumBytes,
+		"application/octet-stream",
+		object.Content,
+		attachmentHeaders,
+	)
+}
+
+// Study documents may hold sensitive evidence so are only available to the
+// study owner, its administrators and IG staff
+func (h *Handler) canAccessStudyDocumentsOrSetError(ctx *gin.Context, studyID uuid.UUID) bool {
+	user := middleware.GetUser(ctx)
+	if isIgStaff, err := rbac.HasAnyListedRole(user, rbac.Admin, rbac.IGOpsStaff, rbac.IGAdmin); err != nil {
+		setError(ctx, err, "Failed to check user roles")
+		return false
+	} else if isIgStaff {
+		return true
+	}
+
+	if isMember, err := h.studies.IsStudyOwnerOrAdmin(user, studyID); err != nil {
+		setError(ctx, err, "Failed to check study access")
+		return false
+	} else if !isMember {
+		ctx.Status(http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// Open and validate the uploaded file of a multipart request. The returned
+// function closes the file
+func studyDocumentUploadOrSetError(ctx *gin.Context) (*studies.StudyDocumentUpload, func(), error) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		setError(ctx, types.NewErrInvalidObject(err), "Failed to get uploaded file")
+		return nil, nil, err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		setError(ctx, types.NewErrServerError(err), "Failed to open uploaded file")
+		return nil, nil, err
+	}
+	closeFile := func() {
+		if err := file.Close(); err != nil {
+			log.Err(err).Msg("Failed to close uploaded file")
+		}
+	}
+
+	if err := validateDocumentMimeType(ctx, fileHeader.Filename, file); err != nil {
+		closeFile()
+		return nil, nil, err
+	}
+
+	upload := studies.StudyDocumentUpload{
+		Filename: fileHeader.Filename,
+		Object:   types.S3Object{Content: file},
+	}
+	return &upload, closeFile, nil
+}
+
+func studyDocumentToOpenApiStudyDocument(document types.StudyDocument) openapi.StudyDocument {
+	data := openapi.StudyDocument{
+		Id:        document.ID.String(),
+		StudyId:   document.StudyID.String(),
+		Category:  openapi.StudyDocumentCategory(document.Category),
+		Title:     document.Title,
+		Versions:  []openapi.StudyDocumentVersion{},
+		CreatedAt: openapi.FormatTime(document.CreatedAt),
+		UpdatedAt: openapi.FormatTime(document.UpdatedAt),
+	}
+	for _, version := range document.Versions {
+		data.Versions = append(data.Versions, openapi.StudyDocumentVersion{
+			Id:              version.ID.String(),
+			Version:         version.Version,
+			Filename:        version.Filename,
+			CreatorUsername: string(version.CreatorUser.Username),
+			CreatedAt:       openapi.FormatTime(version.CreatedAt),
+		})
+	}
+	return data
+}
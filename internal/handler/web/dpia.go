@@ -0,0 +1,125 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdDpia(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	dpia, err := h.studies.StudyDpia(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get DPIA")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dpiaToOpenApiDpia(*dpia))
+}
+
+func (h *Handler) PutStudiesStudyIdDpia(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.DpiaUpdate{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	dpia, err := h.studies.UpdateDpia(studyUUID, data)
+	if err != nil {
+		setError(ctx, err, "Failed to update DPIA")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dpiaToOpenApiDpia(*dpia))
+}
+
+func (h *Handler) PostStudiesStudyIdDpiaSubmit(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.SubmitDpia(studyUUID); err != nil {
+		setError(ctx, err, "Failed to submit DPIA")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// Called by the DPO. IG staff and admins can access admin study routes but cannot sign off a DPIA
+func (h *Handler) PostStudiesAdminStudyIdDpiaSignoff(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	isDpo, err := rbac.HasAnyListedRole(user, rbac.DPO)
+	if err != nil {
+		setError(ctx, err, "Failed to check user roles")
+		return
+	} else if !isDpo {
+		ctx.Status(http.StatusForbidden)
+		return
+	}
+
+	data := openapi.DpiaSignoff{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	if err := h.studies.SignOffDpia(user, studyUUID, data); err != nil {
+		setError(ctx, err, "Failed to sign off DPIA")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func dpiaToOpenApiDpia(dpia types.Dpia) openapi.Dpia {
+	response := openapi.Dpia{
+		Status:        openapi.DpiaStatus(dpia.Status),
+		TriggerReason: dpia.TriggerReason,
+		Answers:       []openapi.DpiaAnswer{},
+		Risks:         []openapi.DpiaRisk{},
+		SignedOffAt:   openapi.FormatOptionalTime(dpia.SignedOffAt),
+		ReviewDate:    openapi.FormatOptionalDate(dpia.ReviewDate),
+		UpdatedAt:     openapi.FormatTime(dpia.UpdatedAt),
+	}
+	for _, question := range studies.DpiaQuestions {
+		answer := openapi.DpiaAnswer{Question: question.Key, Prompt: question.Prompt}
+		for _, dpiaAnswer := range dpia.Answers {
+			if dpiaAnswer.Question == question.Key {
+				answer.Answer = dpiaAnswer.Answer
+			}
+		}
+		response.Answers = append(response.Answers, answer)
+	}
+	for _, risk := range dpia.Risks {
+		response.Risks = append(response.Risks, openapi.DpiaRisk{
+			Description:  risk.Description,
+			Likelihood:   openapi.DpiaRiskLevel(risk.Likelihood),
+			Severity:     openapi.DpiaRiskLevel(risk.Severity),
+			Mitigation:   risk.Mitigation,
+			ResidualRisk: openapi.DpiaRiskLevel(risk.ResidualRisk),
+		})
+	}
+	if dpia.SignedOffByUser != nil {
+		response.SignedOffByUsername = new(string(dpia.SignedOffByUser.Username))
+	}
+	return response
+}
@@ -0,0 +1,101 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetErasures(ctx *gin.Context) {
+	erasures, err := h.erasures.Erasures()
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve erasures")
+		return
+	}
+
+	response := []openapi.Erasure{}
+	for _, erasure := range erasures {
+		response = append(response, erasureToOpenApiErasure(erasure))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostErasures(ctx *gin.Context) {
+	var data openapi.ErasureRequest
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	erasure, err := h.erasures.RequestErasure(middleware.GetUser(ctx), data)
+	if err != nil {
+		setError(ctx, err, "Failed to request erasure")
+		return
+	}
+	ctx.JSON(http.StatusOK, erasureToOpenApiErasure(*erasure))
+}
+
+func (h *Handler) GetErasuresErasureId(ctx *gin.Context, erasureId string) {
+	erasureUuid, err := parseUUIDOrSetError(ctx, erasureId)
+	if err != nil {
+		return
+	}
+
+	erasure, err := h.erasures.GetErasure(erasureUuid)
+	if err != nil {
+		setError(ctx, err, "Failed to get erasure")
+		return
+	}
+	ctx.JSON(http.StatusOK, erasureToOpenApiErasure(*erasure))
+}
+
+func (h *Handler) PostErasuresErasureIdApprove(ctx *gin.Context, erasureId string) {
+	erasureUuid, err := parseUUIDOrSetError(ctx, erasureId)
+	if err != nil {
+		return
+	}
+
+	erasure, err := h.erasures.ApproveErasure(middleware.GetUser(ctx), erasureUuid)
+	if err != nil {
+		setError(ctx, err, "Failed to approve erasure")
+		return
+	}
+	ctx.JSON(http.StatusOK, erasureToOpenApiErasure(*erasure))
+}
+
+func (h *Handler) PostErasuresErasureIdReject(ctx *gin.Context, erasureId string) {
+	erasureUuid, err := parseUUIDOrSetError(ctx, erasureId)
+	if err != nil {
+		return
+	}
+
+	erasure, err := h.erasures.RejectErasure(middleware.GetUser(ctx), erasureUuid)
+	if err != nil {
+		setError(ctx, err, "Failed to reject erasure")
+		return
+	}
+	ctx.JSON(http.StatusOK, erasureToOpenApiErasure(*erasure))
+}
+
+func erasureToOpenApiErasure(erasure types.Erasure) openapi.Erasure {
+	data := openapi.Erasure{
+		Id:                erasure.ID.String(),
+		SubjectKind:       openapi.ErasureSubjectKind(erasure.SubjectKind),
+		SubjectId:         erasure.SubjectID.String(),
+		Reason:            erasure.Reason,
+		Status:            openapi.ErasureStatus(erasure.Status),
+		RequesterUsername: string(erasure.RequesterUser.Username),
+		Manifest: openapi.ErasureManifest{
+			Rows:    erasure.Manifest.Rows,
+			Objects: erasure.Manifest.Objects,
+		},
+		CreatedAt:   openapi.FormatTime(erasure.CreatedAt),
+		CompletedAt: openapi.FormatOptionalTime(erasure.CompletedAt),
+	}
+	if erasure.ReviewerUser != nil {
+		data.ReviewerUsername = new(string(erasure.ReviewerUser.Username))
+	}
+	return data
+}
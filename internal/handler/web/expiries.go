@@ -0,0 +1,70 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdAssetExpiryReviews(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	reviews, err := h.studies.AssetExpiryReviews(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset expiry reviews")
+		return
+	}
+
+	response := []openapi.AssetExpiryReview{}
+	for _, review := range reviews {
+		response = append(response, assetExpiryReviewToOpenApiAssetExpiryReview(review))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve(ctx *gin.Context, studyId string, expiryReviewId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, expiryReviewId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.AssetExpiryResolution{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	review, err := h.studies.ResolveAssetExpiryReview(middleware.GetUser(ctx), uuids[0], uuids[1], data)
+	if err != nil {
+		setError(ctx, err, "Failed to resolve asset expiry review")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetExpiryReviewToOpenApiAssetExpiryReview(*review))
+}
+
+func assetExpiryReviewToOpenApiAssetExpiryReview(review types.AssetExpiryReview) openapi.AssetExpiryReview {
+	data := openapi.AssetExpiryReview{
+		Id:                 review.ID.String(),
+		AssetId:            review.AssetID.String(),
+		AssetTitle:         review.Asset.Title,
+		ExpiresAt:          openapi.FormatTime(review.ExpiresAt),
+		DetachedProjectIds: []string{},
+		Status:             openapi.AssetExpiryReviewStatus(review.Status),
+		Justification:      review.Justification,
+		ExtendedExpiresAt:  openapi.FormatOptionalTime(review.ExtendedExpiresAt),
+		ResolvedAt:         openapi.FormatOptionalTime(review.ResolvedAt),
+		CreatedAt:          openapi.FormatTime(review.CreatedAt),
+	}
+	for _, projectID := range review.DetachedProjectIDs {
+		data.DetachedProjectIds = append(data.DetachedProjectIds, projectID.String())
+	}
+	if review.ResolverUser != nil {
+		data.ResolverUsername = new(string(review.ResolverUser.Username))
+	}
+	return data
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/ucl-arc-tre/portal/internal/service/agreements"
 	"github.com/ucl-arc-tre/portal/internal/service/auth"
 	"github.com/ucl-arc-tre/portal/internal/service/environments"
+	"github.com/ucl-arc-tre/portal/internal/service/erasures"
 	"github.com/ucl-arc-tre/portal/internal/service/notifications"
 	"github.com/ucl-arc-tre/portal/internal/service/projects"
+	"github.com/ucl-arc-tre/portal/internal/service/search"
 	"github.com/ucl-arc-tre/portal/internal/service/studies"
 	"github.com/ucl-arc-tre/portal/internal/service/tokens/sign"
 	"github.com/ucl-arc-tre/portal/internal/service/users"
@@ -26,7 +28,9 @@ type Handler struct {
 	studies       *studies.Service
 	auth          *auth.Service
 	environments  *environments.Service
+	erasures      *erasures.Service
 	projects      *projects.Service
+	search        *search.Service
 	tokens        *sign.Service
 	notifications *notifications.Service
 	myservices    *myservices.Controller
@@ -40,7 +44,9 @@ func New() *Handler {
 		studies:       studies.New(),
 		auth:          auth.New(),
 		environments:  environments.New(),
+		erasures:      erasures.New(),
 		projects:      projects.New(),
+		search:        search.New(),
 		tokens:        sign.New(),
 		notifications: notifications.New(),
 		myservices:    myservices.New(),
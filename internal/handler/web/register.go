@@ -0,0 +1,82 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesAdminAssetRegister(ctx *gin.Context, params openapi.GetStudiesAdminAssetRegisterParams) {
+	format := types.AssetRegisterFormatCSV
+	if params.Format != nil {
+		if !params.Format.Valid() {
+			setError(ctx, types.NewErrClientInvalidObjectF("invalid format [%v]", *params.Format), "Invalid format")
+			return
+		}
+		format = types.AssetRegisterFormat(*params.Format)
+	}
+
+	filter := studies.AssetRegisterFilter{Tier: params.Tier}
+	if params.Status != nil {
+		if !params.Status.Valid() {
+			setError(ctx, types.NewErrClientInvalidObjectF("invalid status [%v]", *params.Status), "Invalid status")
+			return
+		}
+		filter.Status = new(types.AssetStatus(*params.Status))
+	}
+
+	file, err := h.studies.ExportAssetRegister(filter, format)
+	if err != nil {
+		setError(ctx, err, "Failed to export asset register")
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+	ctx.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (h *Handler) GetStudiesAdminAssetRegisterExports(ctx *gin.Context) {
+	exports, err := h.studies.AssetRegisterExports()
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset register exports")
+		return
+	}
+
+	response := []openapi.AssetRegisterExport{}
+	for _, export := range exports {
+		response = append(response, openapi.AssetRegisterExport{
+			Id:        export.ID.String(),
+			Format:    openapi.AssetRegisterFormat(export.Format),
+			Filename:  export.Filename,
+			NumAssets: export.NumAssets,
+			CreatedAt: openapi.FormatTime(export.CreatedAt),
+		})
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetStudiesAdminAssetRegisterExportsExportIdFile(ctx *gin.Context, exportId string) {
+	exportUUID, err := parseUUIDOrSetError(ctx, exportId)
+	if err != nil {
+		return
+	}
+
+	export, object, err := h.studies.GetAssetRegisterExport(ctx, exportUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get asset register export")
+		return
+	} else if object.NumBytes == nil {
+		setError(ctx, types.NewErrServerError("asset register export missing content length"), "Failed to get asset register export")
+		return
+	}
+	ctx.DataFromReader(
+		http.StatusOK,
+		*object.NumBytes,
+		"application/octet-stream",
+		object.Content,
+		map[string]string{"Content-Disposition": fmt.Sprintf("attachment; filename=%q", export.Filename)},
+	)
+}
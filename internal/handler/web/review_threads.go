@@ -0,0 +1,120 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesStudyIdReviewThreads(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	threads, err := h.studies.StudyReviewThreads(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get review threads")
+		return
+	}
+
+	response := []openapi.StudyReviewThread{}
+	for _, thread := range threads {
+		response = append(response, reviewThreadToOpenApiStudyReviewThread(thread))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Called by IG Ops staff to comment on a field of a study under review
+func (h *Handler) PostStudiesAdminStudyIdReviewThreads(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.StudyReviewThreadRequest{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	thread, err := h.studies.CreateReviewThread(user, studyUUID, data)
+	if err != nil {
+		setError(ctx, err, "Failed to create review thread")
+		return
+	}
+
+	thread.CreatorUser = user
+	for i := range thread.Comments {
+		thread.Comments[i].User = user
+	}
+	ctx.JSON(http.StatusCreated, reviewThreadToOpenApiStudyReviewThread(*thread))
+}
+
+func (h *Handler) PostStudiesStudyIdReviewThreadsReviewThreadIdComments(ctx *gin.Context, studyId string, reviewThreadId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, reviewThreadId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.StudyReviewCommentRequest{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	if err := h.studies.CreateReviewComment(user, uuids[0], uuids[1], data); err != nil {
+		setError(ctx, err, "Failed to create review comment")
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (h *Handler) PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments(ctx *gin.Context, studyId string, reviewThreadId string) {
+	h.PostStudiesStudyIdReviewThreadsReviewThreadIdComments(ctx, studyId, reviewThreadId)
+}
+
+func (h *Handler) PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve(ctx *gin.Context, studyId string, reviewThreadId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, reviewThreadId)
+	if err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	if err := h.studies.ResolveReviewThread(user, uuids[0], uuids[1]); err != nil {
+		setError(ctx, err, "Failed to resolve review thread")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func reviewThreadToOpenApiStudyReviewThread(thread types.StudyReviewThread) openapi.StudyReviewThread {
+	apiThread := openapi.StudyReviewThread{
+		Id:              thread.ID.String(),
+		CreatedAt:       openapi.FormatTime(thread.CreatedAt),
+		CreatorUsername: string(thread.CreatorUser.Username),
+		Field:           thread.Field,
+		ResolvedAt:      openapi.FormatOptionalTime(thread.ResolvedAt),
+		Comments:        []openapi.StudyReviewComment{},
+	}
+	if thread.AssetID != nil {
+		apiThread.AssetId = new(thread.AssetID.String())
+	}
+	if thread.ResolvedByUser != nil {
+		apiThread.ResolvedByUsername = new(string(thread.ResolvedByUser.Username))
+	}
+	for _, comment := range thread.Comments {
+		apiThread.Comments = append(apiThread.Comments, openapi.StudyReviewComment{
+			Id:        comment.ID.String(),
+			CreatedAt: openapi.FormatTime(comment.CreatedAt),
+			Username:  string(comment.User.Username),
+			Comment:   comment.Body,
+		})
+	}
+	return apiThread
+}
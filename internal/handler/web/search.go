@@ -0,0 +1,98 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/search"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const (
+	defaultSearchLimit = 12
+	maxSearchLimit     = 50
+)
+
+func (h *Handler) GetSearch(ctx *gin.Context, params openapi.GetSearchParams) {
+	if params.Limit != nil && (*params.Limit < 1 || *params.Limit > maxSearchLimit) {
+		setError(ctx, types.NewErrClientInvalidObjectF("limit must be between 1 and %d", maxSearchLimit), "Invalid search")
+		return
+	} else if params.Offset != nil && *params.Offset < 0 {
+		setError(ctx, types.NewErrClientInvalidObjectF("offset cannot be negative"), "Invalid search")
+		return
+	}
+
+	query := search.Query{
+		Text:       params.Query,
+		EntityType: params.EntityType,
+		Status:     params.Status,
+		Tier:       params.Tier,
+		Limit:      defaultSearchLimit,
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		query.Offset = *params.Offset
+	}
+
+	user := middleware.GetUser(ctx)
+	// Only roles which can read every study may search across all of them
+	canSearchAllStudies, err := rbac.HasAnyListedRole(user, rbac.Admin, rbac.IGOpsStaff, rbac.IGAdmin)
+	if err != nil {
+		setError(ctx, err, "Failed to check user roles")
+		return
+	}
+	if !canSearchAllStudies {
+		studyIDs, err := rbac.StudyIDsWithRole(user, rbac.StudyOwner)
+		if err != nil {
+			setError(ctx, err, "Failed to get user studies")
+			return
+		}
+		query.StudyIDs = studyIDs
+	}
+
+	results, err := h.search.Search(query)
+	if err != nil {
+		setError(ctx, err, "Failed to search")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, searchResultsToOpenApiSearchResults(*results))
+}
+
+func searchResultsToOpenApiSearchResults(results search.Results) openapi.SearchResults {
+	response := openapi.SearchResults{
+		Items: []openapi.SearchResult{},
+		Total: results.Total,
+		Facets: openapi.SearchFacets{
+			EntityTypes: facetCountsToOpenApiFacetCounts(results.Facets.EntityTypes),
+			Statuses:    facetCountsToOpenApiFacetCounts(results.Facets.Statuses),
+			Tiers:       facetCountsToOpenApiFacetCounts(results.Facets.Tiers),
+		},
+	}
+	for _, result := range results.Items {
+		response.Items = append(response.Items, openapi.SearchResult{
+			EntityType:   result.EntityType,
+			Id:           result.ID.String(),
+			Title:        result.Title,
+			Status:       result.Status,
+			Tier:         result.Tier,
+			StudyId:      result.StudyID.String(),
+			StudyTitle:   result.StudyTitle,
+			StudyCaseref: result.StudyCaseref,
+		})
+	}
+	return response
+}
+
+func facetCountsToOpenApiFacetCounts(counts []search.FacetCount) []openapi.SearchFacetCount {
+	response := []openapi.SearchFacetCount{}
+	for _, count := range counts {
+		response = append(response, openapi.SearchFacetCount{Value: count.Value, Count: count.Count})
+	}
+	return response
+}
@@ -1,20 +1,28 @@
 package web
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
 	"github.com/ucl-arc-tre/portal/internal/middleware"
 	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
 	"github.com/ucl-arc-tre/portal/internal/rbac"
 	"github.com/ucl-arc-tre/portal/internal/service/agreements"
 	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
 	"github.com/ucl-arc-tre/portal/internal/types"
 )
 
-func (h *Handler) studiesAll(params openapi.GetStudiesParams) ([]types.Study, error) {
+const maxBulkImportFileSize = 32 << 20
+
+func (h *Handler) studiesAll(user types.User, params openapi.GetStudiesParams) ([]types.Study, error) {
 	if !params.Valid() {
 		return []types.Study{}, types.NewErrInvalidObject("invalid query param")
 	}
@@ -30,6 +38,7 @@ func (h *Handler) studiesAll(params openapi.GetStudiesParams) ([]types.Study, er
 		FuzzyTitle:     params.FuzzyTitle,
 		Owner:          params.Owner,
 		Administrator:  params.Administrator,
+		RiskRating:     params.RiskRating,
 		Limit:          12,
 		Offset:         0,
 	}
@@ -44,6 +53,14 @@ func (h *Handler) studiesAll(params openapi.GetStudiesParams) ([]types.Study, er
 	} else if params.Query != nil {
 		queryParams.FuzzyTitle = params.Query
 	}
+	if params.Reviewer != nil {
+		switch *params.Reviewer {
+		case openapi.Me:
+			queryParams.ReviewerUserID = &user.ID
+		case openapi.Unassigned:
+			queryParams.Unassigned = true
+		}
+	}
 	if params.Limit != nil {
 		queryParams.Limit = *params.Limit
 	}
@@ -80,7 +97,7 @@ func (h *Handler) GetStudies(ctx *gin.Context, params openapi.GetStudiesParams)
 
 	var studies []types.Study
 	if canSeeAllStudies {
-		studies, err = h.studiesAll(params)
+		studies, err = h.studiesAll(user, params)
 	} else {
 		studies, err = h.studiesStudyOwner(user)
 	}
@@ -217,7 +234,8 @@ func (h *Handler) PostStudiesAdminStudyIdReview(ctx *gin.Context, studyId string
 		return
 	}
 
-	err = h.studies.UpdateStudyReview(ctx, studyUUID, review)
+	user := middleware.GetUser(ctx)
+	err = h.studies.UpdateStudyReview(ctx, user, studyUUID, review)
 	if err != nil {
 		setError(ctx, err, "Failed to update study feedback")
 		return
@@ -226,6 +244,54 @@ func (h *Handler) PostStudiesAdminStudyIdReview(ctx *gin.Context, studyId string
 	ctx.Status(http.StatusOK)
 }
 
+func (h *Handler) PutStudiesAdminStudyIdReviewer(ctx *gin.Context, studyId string) {
+	data := openapi.StudyReviewerUpdate{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.AssignStudyReviewer(ctx, middleware.GetUser(ctx), studyUUID, data); err != nil {
+		setError(ctx, err, "Failed to assign study reviewer")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) DeleteStudiesAdminStudyIdReviewer(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.UnassignStudyReviewer(studyUUID); err != nil {
+		setError(ctx, err, "Failed to unassign study reviewer")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// Called by an IG ops staff member to take on the review of a study
+func (h *Handler) PostStudiesAdminStudyIdReviewerClaim(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	if err := h.studies.ClaimStudyReview(middleware.GetUser(ctx), studyUUID); err != nil {
+		setError(ctx, err, "Failed to claim study review")
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
 // Called by the IAO to affirm that study details are up to date, resetting the signoff timestamp
 func (h *Handler) PostStudiesStudyIdSignoff(ctx *gin.Context, studyId string) {
 	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
@@ -233,7 +299,20 @@ func (h *Handler) PostStudiesStudyIdSignoff(ctx *gin.Context, studyId string) {
 		return
 	}
 
-	if err := h.studies.RecordStudySignoff(studyUUID); err != nil {
+	data := openapi.StudySignoffRequest{}
+	if ctx.Request.ContentLength != 0 { // body is optional
+		if err := bindJSONOrSetError(ctx, &data); err != nil {
+			return
+		}
+	}
+
+	agreementID, err := h.studySignoffAgreementID(ctx, data.AgreementId)
+	if err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	if err := h.studies.RecordStudySignoff(ctx, user, studyUUID, agreementID, data.Comments); err != nil {
 		setError(ctx, err, "Failed to record study signoff")
 		return
 	}
@@ -241,6 +320,58 @@ func (h *Handler) PostStudiesStudyIdSignoff(ctx *gin.Context, studyId string) {
 	ctx.Status(http.StatusOK)
 }
 
+// Resolve the study signoff agreement shown to the user, defaulting to the latest
+func (h *Handler) studySignoffAgreementID(ctx *gin.Context, agreementId *string) (uuid.UUID, error) {
+	if agreementId == nil {
+		agreement, err := h.agreements.LatestStudySignoff()
+		if err != nil {
+			setError(ctx, err, "Failed to get study signoff agreement")
+			return uuid.Nil, err
+		}
+		return agreement.ID, nil
+	}
+
+	agreementUUID, err := parseUUIDOrSetError(ctx, *agreementId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	agreementType, err := h.agreements.AgreementTypeById(agreementUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get agreement type")
+		return uuid.Nil, err
+	} else if *agreementType != agreements.StudySignoffType {
+		err := types.NewErrClientInvalidObjectF("agreement [%v] is not a study signoff agreement", agreementUUID)
+		setError(ctx, err, "Invalid study signoff agreement")
+		return uuid.Nil, err
+	}
+	return agreementUUID, nil
+}
+
+func (h *Handler) GetStudiesStudyIdSignoffs(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	signoffs, err := h.studies.StudySignoffs(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get study signoffs")
+		return
+	}
+
+	response := []openapi.StudySignoff{}
+	for _, signoff := range signoffs {
+		response = append(response, openapi.StudySignoff{
+			Id:          signoff.ID.String(),
+			CreatedAt:   openapi.FormatTime(signoff.CreatedAt),
+			Username:    string(signoff.User.Username),
+			AgreementId: signoff.AgreementID.String(),
+			Comments:    signoff.Comments,
+		})
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
 // Called by the study owner/researcher to submit their study for IG Ops review
 func (h *Handler) PatchStudiesStudyIdPending(ctx *gin.Context, studyId string) {
 	review := openapi.StudyReview{
@@ -252,7 +383,8 @@ func (h *Handler) PatchStudiesStudyIdPending(ctx *gin.Context, studyId string) {
 		return
 	}
 
-	err = h.studies.UpdateStudyReview(ctx, studyUUID, review)
+	user := middleware.GetUser(ctx)
+	err = h.studies.UpdateStudyReview(ctx, user, studyUUID, review)
 	if err != nil {
 		setError(ctx, err, "Failed to update study feedback")
 		return
@@ -272,7 +404,8 @@ func (h *Handler) PutStudiesStudyId(ctx *gin.Context, studyId string) {
 		return
 	}
 
-	err = h.studies.UpdateStudy(ctx, studyUUID, studyData)
+	user := middleware.GetUser(ctx)
+	err = h.studies.UpdateStudy(ctx, user, studyUUID, studyData)
 	if err != nil {
 		setError(ctx, err, "Failed to update study")
 		return
@@ -295,6 +428,97 @@ func (h *Handler) PostStudiesAdminImport(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, studyToOpenApiStudy(*study))
 }
 
+func (h *Handler) PostStudiesAdminImportBulk(ctx *gin.Context, params openapi.PostStudiesAdminImportBulkParams) {
+	sheets, err := bulkImportSheets(ctx)
+	if err != nil {
+		setError(ctx, err, "Failed to read bulk import")
+		return
+	}
+
+	report, err := h.studies.BulkImport(ctx, sheets, params.DryRun != nil && *params.DryRun)
+	if err != nil {
+		setError(ctx, err, "Failed to bulk import studies")
+		return
+	}
+
+	response := openapi.StudyBulkImportReport{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Studies:   report.Studies,
+		Assets:    report.Assets,
+		Contracts: report.Contracts,
+		Errors:    []openapi.StudyBulkImportError{},
+	}
+	for _, importErr := range report.Errors {
+		response.Errors = append(response.Errors, openapi.StudyBulkImportError{
+			Sheet:   importErr.Sheet,
+			Row:     importErr.Row,
+			Message: importErr.Message,
+		})
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Sheets of a bulk import from either an XLSX workbook or a CSV file per sheet
+func bulkImportSheets(ctx *gin.Context) ([]spreadsheet.Sheet, error) {
+	workbook, err := bulkImportFile(ctx, "workbook")
+	if err != nil {
+		return nil, err
+	}
+
+	sheets := []spreadsheet.Sheet{}
+	for _, name := range []string{studies.BulkImportStudiesSheet, studies.BulkImportAssetsSheet, studies.BulkImportContractsSheet} {
+		content, err := bulkImportFile(ctx, name)
+		if err != nil {
+			return nil, err
+		} else if content == nil {
+			continue
+		} else if workbook != nil {
+			return nil, types.NewErrClientInvalidObjectF("upload either a workbook or CSV files, not both")
+		}
+		sheet, err := spreadsheet.ReadCSV(name, content)
+		if err != nil {
+			return nil, types.NewErrClientInvalidObjectF("%v", err)
+		}
+		sheets = append(sheets, sheet)
+	}
+
+	if workbook != nil {
+		sheets, err = spreadsheet.ReadXLSX(workbook)
+		if err != nil {
+			return nil, types.NewErrClientInvalidObjectF("%v", err)
+		}
+	}
+	return sheets, nil
+}
+
+// Content of an uploaded file or nil if it was not uploaded
+func bulkImportFile(ctx *gin.Context, name string) ([]byte, error) {
+	fileHeader, err := ctx.FormFile(name)
+	if errors.Is(err, http.ErrMissingFile) {
+		return nil, nil
+	} else if err != nil {
+		return nil, types.NewErrInvalidObject(err)
+	} else if fileHeader.Size > maxBulkImportFileSize {
+		return nil, types.NewErrClientInvalidObjectF("%v must be at most %d MB", name, maxBulkImportFileSize>>20)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Err(err).Msg("Failed to close uploaded file")
+		}
+	}()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	return content, nil
+}
+
 func (h *Handler) PostStudiesAdminStudyIdAssetsImport(ctx *gin.Context, studyId string) {
 	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
 	if err != nil {
@@ -364,13 +588,105 @@ func (h *Handler) PostStudiesAdminStudyIdOwnerApprove(ctx *gin.Context, studyId
 	}
 
 	user := middleware.GetUser(ctx)
-	if err := h.studies.ApproveStudyOwner(studyUUID, user, data); err != nil {
+	if err := h.studies.ApproveStudyOwner(ctx, studyUUID, user, data); err != nil {
 		setError(ctx, err, "Failed to update study owner")
 		return
 	}
 	ctx.Status(http.StatusOK)
 }
 
+func (h *Handler) PostStudiesAdminStudyIdOwnerReject(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+	data := openapi.StudyOwnerChangeDecision{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	if err := h.studies.RejectStudyOwnerChange(ctx, studyUUID, user, data); err != nil {
+		setError(ctx, err, "Failed to reject study owner change")
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) PostStudiesStudyIdOwnerCancel(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+	data := openapi.StudyOwnerChangeDecision{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	user := middleware.GetUser(ctx)
+	if err := h.studies.CancelStudyOwnerChange(ctx, studyUUID, user, data); err != nil {
+		setError(ctx, err, "Failed to cancel study owner change")
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) PostStudiesAdminStudyIdOwnerCancel(ctx *gin.Context, studyId string) {
+	h.PostStudiesStudyIdOwnerCancel(ctx, studyId)
+}
+
+func (h *Handler) GetStudiesAdminOwnerChanges(ctx *gin.Context) {
+	changes, err := h.studies.PendingOwnerChanges()
+	if err != nil {
+		setError(ctx, err, "Failed to get pending owner changes")
+		return
+	}
+
+	response := []openapi.StudyOwnerChange{}
+	for _, change := range changes {
+		response = append(response, studyOwnerChangeToOpenApiStudyOwnerChange(change))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetStudiesStudyIdRevisions(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	revisions, err := h.studies.StudyRevisions(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to get study revisions")
+		return
+	}
+
+	response := []openapi.StudyRevision{}
+	for _, revision := range revisions {
+		response = append(response, studyRevisionToOpenApiStudyRevision(revision))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetStudiesStudyIdRevisionsDiff(ctx *gin.Context, studyId string, params openapi.GetStudiesStudyIdRevisionsDiffParams) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, params.From, params.To)
+	if err != nil {
+		return
+	}
+
+	changes, err := h.studies.StudyRevisionsDiff(uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		setError(ctx, err, "Failed to diff study revisions")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, openapi.StudyRevisionDiff{
+		FromRevisionId: params.From,
+		ToRevisionId:   params.To,
+		Changes:        studyRevisionChangesToOpenApiStudyFieldChanges(changes),
+	})
+}
+
 // Helper functions
 
 func studyToOpenApiStudy(data types.Study) openapi.Study {
@@ -406,11 +722,58 @@ func studyToOpenApiStudy(data types.Study) openapi.Study {
 		CreatedAt:                        openapi.FormatTime(data.CreatedAt),
 		UpdatedAt:                        openapi.FormatTime(data.UpdatedAt),
 		LastSignoff:                      openapi.FormatOptionalTime(data.LastSignoff),
+		SignoffValidityDays:              int(config.StudySignoffValidityOf(data) / config.Day),
+		SignoffExpiresAt:                 openapi.FormatOptionalTime(config.StudySignoffExpiresAt(data)),
 		Caseref:                          data.Caseref,
 	}
-	latestOwnerChange := data.LatestOwnerChange()
-	if latestOwnerChange != nil && latestOwnerChange.ToUser.Username != data.Owner.Username {
-		study.PendingNewOwnerUsername = new(string(latestOwnerChange.ToUser.Username))
+	if pendingOwnerChange := data.PendingOwnerChange(); pendingOwnerChange != nil {
+		study.PendingNewOwnerUsername = new(string(pendingOwnerChange.ToUser.Username))
+	}
+	if data.Reviewer != nil {
+		study.ReviewerUsername = new(string(data.Reviewer.Username))
+	}
+	if data.RiskRating != nil {
+		study.RiskScore = data.RiskScore
+		study.RiskRating = new(openapi.StudyRiskRating(*data.RiskRating))
+	}
+	if data.Dpia != nil {
+		study.DpiaStatus = new(openapi.DpiaStatus(data.Dpia.Status))
 	}
 	return study
 }
+
+func studyRevisionToOpenApiStudyRevision(revision types.StudyRevision) openapi.StudyRevision {
+	return openapi.StudyRevision{
+		Id:        revision.ID.String(),
+		CreatedAt: openapi.FormatTime(revision.CreatedAt),
+		Username:  string(revision.User.Username),
+		Changes:   studyRevisionChangesToOpenApiStudyFieldChanges(revision.Changes),
+	}
+}
+
+func studyOwnerChangeToOpenApiStudyOwnerChange(change types.StudyOwnerChangelog) openapi.StudyOwnerChange {
+	ownerChange := openapi.StudyOwnerChange{
+		StudyId:      change.StudyID.String(),
+		StudyTitle:   change.Study.Title,
+		FromUsername: string(change.FromUser.Username),
+		ToUsername:   string(change.ToUser.Username),
+		RequestedAt:  openapi.FormatTime(change.CreatedAt),
+		ExpiresAt:    openapi.FormatTime(change.CreatedAt.Add(config.StudyOwnerChangeRequestValidity)),
+	}
+	if change.User != nil {
+		ownerChange.RequestedByUsername = new(string(change.User.Username))
+	}
+	return ownerChange
+}
+
+func studyRevisionChangesToOpenApiStudyFieldChanges(changes []types.StudyRevisionChange) []openapi.StudyFieldChange {
+	fieldChanges := []openapi.StudyFieldChange{}
+	for _, change := range changes {
+		fieldChanges = append(fieldChanges, openapi.StudyFieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return fieldChanges
+}
@@ -0,0 +1,161 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ucl-arc-tre/portal/internal/middleware"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (h *Handler) GetStudiesAdminAssetTransfers(ctx *gin.Context) {
+	transfers, err := h.studies.PendingAssetTransfers()
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset transfers")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransfersToOpenApiAssetTransfers(transfers))
+}
+
+func (h *Handler) PostStudiesAdminAssetTransfersTransferIdApprove(ctx *gin.Context, transferId string) {
+	transferUUID, err := parseUUIDOrSetError(ctx, transferId)
+	if err != nil {
+		return
+	}
+
+	transfer, err := h.studies.ApproveAssetTransfer(middleware.GetUser(ctx), transferUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to approve asset transfer")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransferToOpenApiAssetTransfer(*transfer))
+}
+
+func (h *Handler) PostStudiesAdminAssetTransfersTransferIdReject(ctx *gin.Context, transferId string) {
+	transferUUID, err := parseUUIDOrSetError(ctx, transferId)
+	if err != nil {
+		return
+	}
+
+	transfer, err := h.studies.RejectAssetTransfer(middleware.GetUser(ctx), transferUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to reject asset transfer")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransferToOpenApiAssetTransfer(*transfer))
+}
+
+func (h *Handler) GetStudiesStudyIdAssetsAssetIdTransfers(ctx *gin.Context, studyId string, assetId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId)
+	if err != nil {
+		return
+	}
+
+	transfers, err := h.studies.AssetTransfers(uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve asset transfers")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransfersToOpenApiAssetTransfers(transfers))
+}
+
+func (h *Handler) PostStudiesStudyIdAssetsAssetIdTransfers(ctx *gin.Context, studyId string, assetId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, assetId)
+	if err != nil {
+		return
+	}
+
+	data := openapi.AssetTransferRequest{}
+	if err := bindJSONOrSetError(ctx, &data); err != nil {
+		return
+	}
+
+	transfer, err := h.studies.RequestAssetTransfer(middleware.GetUser(ctx), uuids[0], uuids[1], data)
+	if err != nil {
+		setError(ctx, err, "Failed to request asset transfer")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransferToOpenApiAssetTransfer(*transfer))
+}
+
+func (h *Handler) GetStudiesStudyIdTransfers(ctx *gin.Context, studyId string) {
+	studyUUID, err := parseUUIDOrSetError(ctx, studyId)
+	if err != nil {
+		return
+	}
+
+	transfers, err := h.studies.StudyAssetTransfers(studyUUID)
+	if err != nil {
+		setError(ctx, err, "Failed to retrieve study asset transfers")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransfersToOpenApiAssetTransfers(transfers))
+}
+
+func (h *Handler) PostStudiesStudyIdTransfersTransferIdApprove(ctx *gin.Context, studyId string, transferId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, transferId)
+	if err != nil {
+		return
+	}
+
+	transfer, err := h.studies.ApproveStudyAssetTransfer(middleware.GetUser(ctx), uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to approve asset transfer")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransferToOpenApiAssetTransfer(*transfer))
+}
+
+func (h *Handler) PostStudiesStudyIdTransfersTransferIdReject(ctx *gin.Context, studyId string, transferId string) {
+	uuids, err := parseUUIDsOrSetError(ctx, studyId, transferId)
+	if err != nil {
+		return
+	}
+
+	transfer, err := h.studies.RejectStudyAssetTransfer(middleware.GetUser(ctx), uuids[0], uuids[1])
+	if err != nil {
+		setError(ctx, err, "Failed to reject asset transfer")
+		return
+	}
+	ctx.JSON(http.StatusOK, assetTransferToOpenApiAssetTransfer(*transfer))
+}
+
+func assetTransfersToOpenApiAssetTransfers(transfers []types.AssetTransfer) []openapi.AssetTransfer {
+	response := []openapi.AssetTransfer{}
+	for _, transfer := range transfers {
+		response = append(response, assetTransferToOpenApiAssetTransfer(transfer))
+	}
+	return response
+}
+
+func assetTransferToOpenApiAssetTransfer(transfer types.AssetTransfer) openapi.AssetTransfer {
+	data := openapi.AssetTransfer{
+		Id:                    transfer.ID.String(),
+		AssetId:               transfer.AssetID.String(),
+		AssetTitle:            transfer.Asset.Title,
+		Reason:                transfer.Reason,
+		Status:                openapi.AssetTransferStatus(transfer.Status),
+		RequesterUsername:     string(transfer.RequesterUser.Username),
+		SourceOwnerApprovedAt: openapi.FormatOptionalTime(transfer.SourceOwnerApprovedAt),
+		TargetOwnerApprovedAt: openapi.FormatOptionalTime(transfer.TargetOwnerApprovedAt),
+		ReviewedAt:            openapi.FormatOptionalTime(transfer.ReviewedAt),
+		ContractIds:           []string{},
+		CreatedAt:             openapi.FormatTime(transfer.CreatedAt),
+	}
+	for _, contractID := range transfer.ContractIDs {
+		data.ContractIds = append(data.ContractIds, contractID.String())
+	}
+	if transfer.SourceStudy != nil {
+		data.SourceStudyId = new(transfer.SourceStudy.ID.String())
+		data.SourceStudyTitle = new(transfer.SourceStudy.Title)
+	}
+	if transfer.TargetStudy != nil {
+		data.TargetStudyId = new(transfer.TargetStudy.ID.String())
+		data.TargetStudyTitle = new(transfer.TargetStudy.Title)
+	}
+	if transfer.ReviewerUser != nil {
+		data.ReviewerUsername = new(string(transfer.ReviewerUser.Username))
+	}
+	return data
+}
@@ -143,6 +143,9 @@ type Project struct {
 	// RequestedVersionUpdatedAt Value of the updated_at field of requested version, in RFC3339 format
 	RequestedVersionUpdatedAt string `json:"requested_version_updated_at"`
 
+	// Suspended Whether the project is suspended e.g. as the study signoff lapsed. Users must not have desktop access to a suspended project
+	Suspended bool `json:"suspended"`
+
 	// TrustedDownloaders Email to CIDRs mappings of trusted downloaders
 	TrustedDownloaders *map[string][]string `json:"trusted_downloaders,omitempty"`
 
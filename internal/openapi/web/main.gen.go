@@ -17,6 +17,7 @@ const (
 	AgreementTypeApprovedResearcher AgreementType = "approved-researcher"
 	AgreementTypeStudyAdministrator AgreementType = "study-administrator"
 	AgreementTypeStudyOwner         AgreementType = "study-owner"
+	AgreementTypeStudySignoff       AgreementType = "study-signoff"
 )
 
 // Valid indicates whether the value is a known member of the AgreementType enum.
@@ -28,6 +29,8 @@ func (e AgreementType) Valid() bool {
 		return true
 	case AgreementTypeStudyOwner:
 		return true
+	case AgreementTypeStudySignoff:
+		return true
 	default:
 		return false
 	}
@@ -218,8 +221,9 @@ func (e AssetProtection) Valid() bool {
 
 // Defines values for AssetStatus.
 const (
-	AssetStatusActive    AssetStatus = "active"
-	AssetStatusDestroyed AssetStatus = "destroyed"
+	AssetStatusActive      AssetStatus = "active"
+	AssetStatusDestroyed   AssetStatus = "destroyed"
+	AssetStatusTransferred AssetStatus = "transferred"
 )
 
 // Valid indicates whether the value is a known member of the AssetStatus enum.
@@ -229,6 +233,8 @@ func (e AssetStatus) Valid() bool {
 		return true
 	case AssetStatusDestroyed:
 		return true
+	case AssetStatusTransferred:
+		return true
 	default:
 		return false
 	}
@@ -419,8 +425,9 @@ func (e AssetBaseProtection) Valid() bool {
 
 // Defines values for AssetBaseStatus.
 const (
-	AssetBaseStatusActive    AssetBaseStatus = "active"
-	AssetBaseStatusDestroyed AssetBaseStatus = "destroyed"
+	AssetBaseStatusActive      AssetBaseStatus = "active"
+	AssetBaseStatusDestroyed   AssetBaseStatus = "destroyed"
+	AssetBaseStatusTransferred AssetBaseStatus = "transferred"
 )
 
 // Valid indicates whether the value is a known member of the AssetBaseStatus enum.
@@ -430,6 +437,182 @@ func (e AssetBaseStatus) Valid() bool {
 		return true
 	case AssetBaseStatusDestroyed:
 		return true
+	case AssetBaseStatusTransferred:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetDerivationType.
+const (
+	Aggregated    AssetDerivationType = "aggregated"
+	Linked        AssetDerivationType = "linked"
+	Pseudonymised AssetDerivationType = "pseudonymised"
+	Subset        AssetDerivationType = "subset"
+)
+
+// Valid indicates whether the value is a known member of the AssetDerivationType enum.
+func (e AssetDerivationType) Valid() bool {
+	switch e {
+	case Aggregated:
+		return true
+	case Linked:
+		return true
+	case Pseudonymised:
+		return true
+	case Subset:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetDestructionMethod.
+const (
+	AssetDestructionMethodCryptographicErasure AssetDestructionMethod = "cryptographic_erasure"
+	AssetDestructionMethodOther                AssetDestructionMethod = "other"
+	AssetDestructionMethodPhysicalDestruction  AssetDestructionMethod = "physical_destruction"
+	AssetDestructionMethodSecureDeletion       AssetDestructionMethod = "secure_deletion"
+)
+
+// Valid indicates whether the value is a known member of the AssetDestructionMethod enum.
+func (e AssetDestructionMethod) Valid() bool {
+	switch e {
+	case AssetDestructionMethodCryptographicErasure:
+		return true
+	case AssetDestructionMethodOther:
+		return true
+	case AssetDestructionMethodPhysicalDestruction:
+		return true
+	case AssetDestructionMethodSecureDeletion:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetDestructionStatus.
+const (
+	AssetDestructionStatusApproved AssetDestructionStatus = "approved"
+	AssetDestructionStatusPending  AssetDestructionStatus = "pending"
+	AssetDestructionStatusRejected AssetDestructionStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the AssetDestructionStatus enum.
+func (e AssetDestructionStatus) Valid() bool {
+	switch e {
+	case AssetDestructionStatusApproved:
+		return true
+	case AssetDestructionStatusPending:
+		return true
+	case AssetDestructionStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetExpiryOutcome.
+const (
+	Destroy AssetExpiryOutcome = "destroy"
+	Extend  AssetExpiryOutcome = "extend"
+)
+
+// Valid indicates whether the value is a known member of the AssetExpiryOutcome enum.
+func (e AssetExpiryOutcome) Valid() bool {
+	switch e {
+	case Destroy:
+		return true
+	case Extend:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetExpiryReviewStatus.
+const (
+	AssetExpiryReviewStatusDestructionConfirmed AssetExpiryReviewStatus = "destruction_confirmed"
+	AssetExpiryReviewStatusExtended             AssetExpiryReviewStatus = "extended"
+	AssetExpiryReviewStatusPending              AssetExpiryReviewStatus = "pending"
+)
+
+// Valid indicates whether the value is a known member of the AssetExpiryReviewStatus enum.
+func (e AssetExpiryReviewStatus) Valid() bool {
+	switch e {
+	case AssetExpiryReviewStatusDestructionConfirmed:
+		return true
+	case AssetExpiryReviewStatusExtended:
+		return true
+	case AssetExpiryReviewStatusPending:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetLocationKind.
+const (
+	AssetLocationKindEnvironment    AssetLocationKind = "environment"
+	AssetLocationKindExternalSystem AssetLocationKind = "external_system"
+	AssetLocationKindOther          AssetLocationKind = "other"
+	AssetLocationKindProject        AssetLocationKind = "project"
+)
+
+// Valid indicates whether the value is a known member of the AssetLocationKind enum.
+func (e AssetLocationKind) Valid() bool {
+	switch e {
+	case AssetLocationKindEnvironment:
+		return true
+	case AssetLocationKindExternalSystem:
+		return true
+	case AssetLocationKindOther:
+		return true
+	case AssetLocationKindProject:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetRegisterFormat.
+const (
+	Csv  AssetRegisterFormat = "csv"
+	Json AssetRegisterFormat = "json"
+	Xlsx AssetRegisterFormat = "xlsx"
+)
+
+// Valid indicates whether the value is a known member of the AssetRegisterFormat enum.
+func (e AssetRegisterFormat) Valid() bool {
+	switch e {
+	case Csv:
+		return true
+	case Json:
+		return true
+	case Xlsx:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssetTransferStatus.
+const (
+	AssetTransferStatusCompleted AssetTransferStatus = "completed"
+	AssetTransferStatusPending   AssetTransferStatus = "pending"
+	AssetTransferStatusRejected  AssetTransferStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the AssetTransferStatus enum.
+func (e AssetTransferStatus) Valid() bool {
+	switch e {
+	case AssetTransferStatusCompleted:
+		return true
+	case AssetTransferStatusPending:
+		return true
+	case AssetTransferStatusRejected:
+		return true
 	default:
 		return false
 	}
@@ -441,6 +624,7 @@ const (
 	AuthRolesApprovedResearcher            AuthRoles = "approved-researcher"
 	AuthRolesApprovedStaffResearcher       AuthRoles = "approved-staff-researcher"
 	AuthRolesBase                          AuthRoles = "base"
+	AuthRolesDpo                           AuthRoles = "dpo"
 	AuthRolesDshOpsStaff                   AuthRoles = "dsh-ops-staff"
 	AuthRolesIgAdmin                       AuthRoles = "ig-admin"
 	AuthRolesIgOpsStaff                    AuthRoles = "ig-ops-staff"
@@ -461,6 +645,8 @@ func (e AuthRoles) Valid() bool {
 		return true
 	case AuthRolesBase:
 		return true
+	case AuthRolesDpo:
+		return true
 	case AuthRolesDshOpsStaff:
 		return true
 	case AuthRolesIgAdmin:
@@ -482,8 +668,9 @@ func (e AuthRoles) Valid() bool {
 
 // Defines values for ContractStatus.
 const (
-	ContractStatusActive ContractStatus = "active"
-	ContractStatusClosed ContractStatus = "closed"
+	ContractStatusActive  ContractStatus = "active"
+	ContractStatusClosed  ContractStatus = "closed"
+	ContractStatusPending ContractStatus = "pending"
 )
 
 // Valid indicates whether the value is a known member of the ContractStatus enum.
@@ -493,6 +680,8 @@ func (e ContractStatus) Valid() bool {
 		return true
 	case ContractStatusClosed:
 		return true
+	case ContractStatusPending:
+		return true
 	default:
 		return false
 	}
@@ -500,8 +689,9 @@ func (e ContractStatus) Valid() bool {
 
 // Defines values for ContractBaseStatus.
 const (
-	ContractBaseStatusActive ContractBaseStatus = "active"
-	ContractBaseStatusClosed ContractBaseStatus = "closed"
+	ContractBaseStatusActive  ContractBaseStatus = "active"
+	ContractBaseStatusClosed  ContractBaseStatus = "closed"
+	ContractBaseStatusPending ContractBaseStatus = "pending"
 )
 
 // Valid indicates whether the value is a known member of the ContractBaseStatus enum.
@@ -511,6 +701,71 @@ func (e ContractBaseStatus) Valid() bool {
 		return true
 	case ContractBaseStatusClosed:
 		return true
+	case ContractBaseStatusPending:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ContractVersionStatus.
+const (
+	ContractVersionStatusApproved ContractVersionStatus = "approved"
+	ContractVersionStatusPending  ContractVersionStatus = "pending"
+	ContractVersionStatusRejected ContractVersionStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the ContractVersionStatus enum.
+func (e ContractVersionStatus) Valid() bool {
+	switch e {
+	case ContractVersionStatusApproved:
+		return true
+	case ContractVersionStatusPending:
+		return true
+	case ContractVersionStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for DpiaRiskLevel.
+const (
+	High   DpiaRiskLevel = "high"
+	Low    DpiaRiskLevel = "low"
+	Medium DpiaRiskLevel = "medium"
+)
+
+// Valid indicates whether the value is a known member of the DpiaRiskLevel enum.
+func (e DpiaRiskLevel) Valid() bool {
+	switch e {
+	case High:
+		return true
+	case Low:
+		return true
+	case Medium:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for DpiaStatus.
+const (
+	Draft     DpiaStatus = "draft"
+	SignedOff DpiaStatus = "signed_off"
+	Submitted DpiaStatus = "submitted"
+)
+
+// Valid indicates whether the value is a known member of the DpiaStatus enum.
+func (e DpiaStatus) Valid() bool {
+	switch e {
+	case Draft:
+		return true
+	case SignedOff:
+		return true
+	case Submitted:
+		return true
 	default:
 		return false
 	}
@@ -534,33 +789,114 @@ func (e EnvironmentName) Valid() bool {
 	}
 }
 
+// Defines values for ErasureStatus.
+const (
+	ErasureStatusCompleted ErasureStatus = "completed"
+	ErasureStatusPending   ErasureStatus = "pending"
+	ErasureStatusRejected  ErasureStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the ErasureStatus enum.
+func (e ErasureStatus) Valid() bool {
+	switch e {
+	case ErasureStatusCompleted:
+		return true
+	case ErasureStatusPending:
+		return true
+	case ErasureStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ErasureSubjectKind.
+const (
+	ErasureSubjectKindStudy ErasureSubjectKind = "study"
+	ErasureSubjectKindUser  ErasureSubjectKind = "user"
+)
+
+// Valid indicates whether the value is a known member of the ErasureSubjectKind enum.
+func (e ErasureSubjectKind) Valid() bool {
+	switch e {
+	case ErasureSubjectKindStudy:
+		return true
+	case ErasureSubjectKindUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for MalwareScanStatus.
+const (
+	MalwareScanStatusClean    MalwareScanStatus = "clean"
+	MalwareScanStatusInfected MalwareScanStatus = "infected"
+	MalwareScanStatusPending  MalwareScanStatus = "pending"
+	MalwareScanStatusSkipped  MalwareScanStatus = "skipped"
+)
+
+// Valid indicates whether the value is a known member of the MalwareScanStatus enum.
+func (e MalwareScanStatus) Valid() bool {
+	switch e {
+	case MalwareScanStatusClean:
+		return true
+	case MalwareScanStatusInfected:
+		return true
+	case MalwareScanStatusPending:
+		return true
+	case MalwareScanStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for NotificationKind.
 const (
-	NotificationKindAssetExpiry      NotificationKind = "asset-expiry"
-	NotificationKindCompleteProfile  NotificationKind = "complete-profile"
-	NotificationKindContractExpiry   NotificationKind = "contract-expiry"
-	NotificationKindIaaAssignment    NotificationKind = "iaa-assignment"
-	NotificationKindProjectDeployed  NotificationKind = "project-deployed"
-	NotificationKindStudyAffirmation NotificationKind = "study-affirmation"
-	NotificationKindStudyOwnerChange NotificationKind = "study-owner-change"
-	NotificationKindStudyReview      NotificationKind = "study-review"
-	NotificationKindTrainingExpiry   NotificationKind = "training-expiry"
-	NotificationKindUserNameChange   NotificationKind = "user-name-change"
+	NotificationKindApprovalExpiry    NotificationKind = "approval-expiry"
+	NotificationKindAssetExpiry       NotificationKind = "asset-expiry"
+	NotificationKindAssetExpiryReview NotificationKind = "asset-expiry-review"
+	NotificationKindCompleteProfile   NotificationKind = "complete-profile"
+	NotificationKindContractExpiry    NotificationKind = "contract-expiry"
+	NotificationKindDpiaReview        NotificationKind = "dpia-review"
+	NotificationKindIaaAssignment     NotificationKind = "iaa-assignment"
+	NotificationKindMalwareDetected   NotificationKind = "malware-detected"
+	NotificationKindObjectIntegrity   NotificationKind = "object-integrity"
+	NotificationKindProjectDeployed   NotificationKind = "project-deployed"
+	NotificationKindProjectSuspended  NotificationKind = "project-suspended"
+	NotificationKindStudyAffirmation  NotificationKind = "study-affirmation"
+	NotificationKindStudyOwnerChange  NotificationKind = "study-owner-change"
+	NotificationKindStudyReview       NotificationKind = "study-review"
+	NotificationKindTrainingExpiry    NotificationKind = "training-expiry"
+	NotificationKindUserNameChange    NotificationKind = "user-name-change"
 )
 
 // Valid indicates whether the value is a known member of the NotificationKind enum.
 func (e NotificationKind) Valid() bool {
 	switch e {
+	case NotificationKindApprovalExpiry:
+		return true
 	case NotificationKindAssetExpiry:
 		return true
+	case NotificationKindAssetExpiryReview:
+		return true
 	case NotificationKindCompleteProfile:
 		return true
 	case NotificationKindContractExpiry:
 		return true
+	case NotificationKindDpiaReview:
+		return true
 	case NotificationKindIaaAssignment:
 		return true
+	case NotificationKindMalwareDetected:
+		return true
+	case NotificationKindObjectIntegrity:
+		return true
 	case NotificationKindProjectDeployed:
 		return true
+	case NotificationKindProjectSuspended:
+		return true
 	case NotificationKindStudyAffirmation:
 		return true
 	case NotificationKindStudyOwnerChange:
@@ -599,7 +935,8 @@ func (e ProjectDSHRole) Valid() bool {
 
 // Defines values for ProjectDSHStatus.
 const (
-	ProjectDSHStatusActive ProjectDSHStatus = "active"
+	ProjectDSHStatusActive  ProjectDSHStatus = "active"
+	ProjectDSHStatusRetired ProjectDSHStatus = "retired"
 )
 
 // Valid indicates whether the value is a known member of the ProjectDSHStatus enum.
@@ -607,6 +944,8 @@ func (e ProjectDSHStatus) Valid() bool {
 	switch e {
 	case ProjectDSHStatusActive:
 		return true
+	case ProjectDSHStatusRetired:
+		return true
 	default:
 		return false
 	}
@@ -654,6 +993,7 @@ const (
 	ProjectTREStatusPendingCreation ProjectTREStatus = "pending-creation"
 	ProjectTREStatusPendingDeletion ProjectTREStatus = "pending-deletion"
 	ProjectTREStatusPendingUpdate   ProjectTREStatus = "pending-update"
+	ProjectTREStatusSuspended       ProjectTREStatus = "suspended"
 )
 
 // Valid indicates whether the value is a known member of the ProjectTREStatus enum.
@@ -673,6 +1013,62 @@ func (e ProjectTREStatus) Valid() bool {
 		return true
 	case ProjectTREStatusPendingUpdate:
 		return true
+	case ProjectTREStatusSuspended:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RegulatoryApprovalType.
+const (
+	RegulatoryApprovalTypeCag        RegulatoryApprovalType = "cag"
+	RegulatoryApprovalTypeEthics     RegulatoryApprovalType = "ethics"
+	RegulatoryApprovalTypeHra        RegulatoryApprovalType = "hra"
+	RegulatoryApprovalTypeMnca       RegulatoryApprovalType = "mnca"
+	RegulatoryApprovalTypeNhsEngland RegulatoryApprovalType = "nhs-england"
+	RegulatoryApprovalTypeOther      RegulatoryApprovalType = "other"
+)
+
+// Valid indicates whether the value is a known member of the RegulatoryApprovalType enum.
+func (e RegulatoryApprovalType) Valid() bool {
+	switch e {
+	case RegulatoryApprovalTypeCag:
+		return true
+	case RegulatoryApprovalTypeEthics:
+		return true
+	case RegulatoryApprovalTypeHra:
+		return true
+	case RegulatoryApprovalTypeMnca:
+		return true
+	case RegulatoryApprovalTypeNhsEngland:
+		return true
+	case RegulatoryApprovalTypeOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SearchEntityType.
+const (
+	SearchEntityTypeAsset    SearchEntityType = "asset"
+	SearchEntityTypeContract SearchEntityType = "contract"
+	SearchEntityTypeProject  SearchEntityType = "project"
+	SearchEntityTypeStudy    SearchEntityType = "study"
+)
+
+// Valid indicates whether the value is a known member of the SearchEntityType enum.
+func (e SearchEntityType) Valid() bool {
+	switch e {
+	case SearchEntityTypeAsset:
+		return true
+	case SearchEntityTypeContract:
+		return true
+	case SearchEntityTypeProject:
+		return true
+	case SearchEntityTypeStudy:
+		return true
 	default:
 		return false
 	}
@@ -681,6 +1077,9 @@ func (e ProjectTREStatus) Valid() bool {
 // Defines values for StudyApprovalStatus.
 const (
 	StudyApprovalStatusApproved   StudyApprovalStatus = "Approved"
+	StudyApprovalStatusArchived   StudyApprovalStatus = "Archived"
+	StudyApprovalStatusClosed     StudyApprovalStatus = "Closed"
+	StudyApprovalStatusClosing    StudyApprovalStatus = "Closing"
 	StudyApprovalStatusIncomplete StudyApprovalStatus = "Incomplete"
 	StudyApprovalStatusPending    StudyApprovalStatus = "Pending"
 	StudyApprovalStatusRejected   StudyApprovalStatus = "Rejected"
@@ -691,6 +1090,12 @@ func (e StudyApprovalStatus) Valid() bool {
 	switch e {
 	case StudyApprovalStatusApproved:
 		return true
+	case StudyApprovalStatusArchived:
+		return true
+	case StudyApprovalStatusClosed:
+		return true
+	case StudyApprovalStatusClosing:
+		return true
 	case StudyApprovalStatusIncomplete:
 		return true
 	case StudyApprovalStatusPending:
@@ -702,6 +1107,57 @@ func (e StudyApprovalStatus) Valid() bool {
 	}
 }
 
+// Defines values for StudyDocumentCategory.
+const (
+	StudyDocumentCategoryDataManagementPlan     StudyDocumentCategory = "data-management-plan"
+	StudyDocumentCategoryDestructionCertificate StudyDocumentCategory = "destruction-certificate"
+	StudyDocumentCategoryDsptEvidence           StudyDocumentCategory = "dspt-evidence"
+	StudyDocumentCategoryEthicsLetter           StudyDocumentCategory = "ethics-letter"
+	StudyDocumentCategoryOther                  StudyDocumentCategory = "other"
+)
+
+// Valid indicates whether the value is a known member of the StudyDocumentCategory enum.
+func (e StudyDocumentCategory) Valid() bool {
+	switch e {
+	case StudyDocumentCategoryDataManagementPlan:
+		return true
+	case StudyDocumentCategoryDestructionCertificate:
+		return true
+	case StudyDocumentCategoryDsptEvidence:
+		return true
+	case StudyDocumentCategoryEthicsLetter:
+		return true
+	case StudyDocumentCategoryOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StudyRiskRating.
+const (
+	Critical      StudyRiskRating = "critical"
+	Manageable    StudyRiskRating = "manageable"
+	Uncomfortable StudyRiskRating = "uncomfortable"
+	Vulnerable    StudyRiskRating = "vulnerable"
+)
+
+// Valid indicates whether the value is a known member of the StudyRiskRating enum.
+func (e StudyRiskRating) Valid() bool {
+	switch e {
+	case Critical:
+		return true
+	case Manageable:
+		return true
+	case Uncomfortable:
+		return true
+	case Vulnerable:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for TrainingKind.
 const (
 	TrainingKindNhsd   TrainingKind = "training_kind_nhsd"
@@ -738,36 +1194,75 @@ func (e EnvironmentParam) Valid() bool {
 	}
 }
 
-// Defines values for GetTokensEnvironmentParamsEnvironment.
+// Defines values for GetStudiesParamsReviewer.
 const (
-	GetTokensEnvironmentParamsEnvironmentDsh GetTokensEnvironmentParamsEnvironment = "dsh"
-	GetTokensEnvironmentParamsEnvironmentTre GetTokensEnvironmentParamsEnvironment = "tre"
+	Me         GetStudiesParamsReviewer = "me"
+	Unassigned GetStudiesParamsReviewer = "unassigned"
 )
 
-// Valid indicates whether the value is a known member of the GetTokensEnvironmentParamsEnvironment enum.
-func (e GetTokensEnvironmentParamsEnvironment) Valid() bool {
+// Valid indicates whether the value is a known member of the GetStudiesParamsReviewer enum.
+func (e GetStudiesParamsReviewer) Valid() bool {
 	switch e {
-	case GetTokensEnvironmentParamsEnvironmentDsh:
+	case Me:
 		return true
-	case GetTokensEnvironmentParamsEnvironmentTre:
+	case Unassigned:
 		return true
 	default:
 		return false
 	}
 }
 
-// Defines values for PostTokensEnvironmentParamsEnvironment.
+// Defines values for GetStudiesAdminAssetRegisterParamsStatus.
 const (
-	PostTokensEnvironmentParamsEnvironmentDsh PostTokensEnvironmentParamsEnvironment = "dsh"
-	PostTokensEnvironmentParamsEnvironmentTre PostTokensEnvironmentParamsEnvironment = "tre"
+	GetStudiesAdminAssetRegisterParamsStatusActive      GetStudiesAdminAssetRegisterParamsStatus = "active"
+	GetStudiesAdminAssetRegisterParamsStatusDestroyed   GetStudiesAdminAssetRegisterParamsStatus = "destroyed"
+	GetStudiesAdminAssetRegisterParamsStatusTransferred GetStudiesAdminAssetRegisterParamsStatus = "transferred"
 )
 
-// Valid indicates whether the value is a known member of the PostTokensEnvironmentParamsEnvironment enum.
-func (e PostTokensEnvironmentParamsEnvironment) Valid() bool {
+// Valid indicates whether the value is a known member of the GetStudiesAdminAssetRegisterParamsStatus enum.
+func (e GetStudiesAdminAssetRegisterParamsStatus) Valid() bool {
 	switch e {
-	case PostTokensEnvironmentParamsEnvironmentDsh:
+	case GetStudiesAdminAssetRegisterParamsStatusActive:
 		return true
-	case PostTokensEnvironmentParamsEnvironmentTre:
+	case GetStudiesAdminAssetRegisterParamsStatusDestroyed:
+		return true
+	case GetStudiesAdminAssetRegisterParamsStatusTransferred:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetTokensEnvironmentParamsEnvironment.
+const (
+	GetTokensEnvironmentParamsEnvironmentDsh GetTokensEnvironmentParamsEnvironment = "dsh"
+	GetTokensEnvironmentParamsEnvironmentTre GetTokensEnvironmentParamsEnvironment = "tre"
+)
+
+// Valid indicates whether the value is a known member of the GetTokensEnvironmentParamsEnvironment enum.
+func (e GetTokensEnvironmentParamsEnvironment) Valid() bool {
+	switch e {
+	case GetTokensEnvironmentParamsEnvironmentDsh:
+		return true
+	case GetTokensEnvironmentParamsEnvironmentTre:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostTokensEnvironmentParamsEnvironment.
+const (
+	PostTokensEnvironmentParamsEnvironmentDsh PostTokensEnvironmentParamsEnvironment = "dsh"
+	PostTokensEnvironmentParamsEnvironmentTre PostTokensEnvironmentParamsEnvironment = "tre"
+)
+
+// Valid indicates whether the value is a known member of the PostTokensEnvironmentParamsEnvironment enum.
+func (e PostTokensEnvironmentParamsEnvironment) Valid() bool {
+	switch e {
+	case PostTokensEnvironmentParamsEnvironmentDsh:
+		return true
+	case PostTokensEnvironmentParamsEnvironmentTre:
 		return true
 	default:
 		return false
@@ -828,6 +1323,9 @@ type Asset struct {
 	// Description Description of the asset
 	Description string `json:"description"`
 
+	// ExpiredAt Time in RFC3339 format when the expiry of the asset was enforced. Unset once extended
+	ExpiredAt *string `json:"expired_at,omitempty"`
+
 	// ExpiresAt Retention expiry date of the asset
 	ExpiresAt *string `json:"expires_at,omitempty"`
 
@@ -843,6 +1341,9 @@ type Asset struct {
 	// Id Unique identifier for the asset
 	Id string `json:"id"`
 
+	// InheritedContractIds IDs of the contracts of the assets this asset was derived from, which also apply to it
+	InheritedContractIds []string `json:"inherited_contract_ids"`
+
 	// IsLeakMajorDisruption Whether disclosure of this asset result in major disruption to UCL
 	IsLeakMajorDisruption *bool `json:"is_leak_major_disruption,omitempty"`
 
@@ -858,9 +1359,17 @@ type Asset struct {
 	// LegalBasisSpecial Additional Condition for Special Category Data
 	LegalBasisSpecial *AssetLegalBasisSpecial `json:"legal_basis_special,omitempty"`
 
+	// LocationReferences Typed locations of the asset in addition to the free text `locations`. When set, replaces the existing
+	// environment, project and external system locations of the asset. Project locations are added when the
+	// asset is attached to a TRE project
+	LocationReferences *[]AssetLocationReference `json:"location_references,omitempty"`
+
 	// Locations Storage locations and touchpoints for the asset
 	Locations []string `json:"locations"`
 
+	// MinimumTier Minimum tier derived from the answers about the asset
+	MinimumTier int `json:"minimum_tier"`
+
 	// Protection Type of protection applied to the asset
 	Protection *AssetProtection `json:"protection,omitempty"`
 
@@ -870,6 +1379,9 @@ type Asset struct {
 	// RequiresTre Whether this asset is required to be held in a ISO27001 certified TRE
 	RequiresTre *bool `json:"requires_tre,omitempty"`
 
+	// RiskScore Risk score derived from the impact and likelihood of a leak of the asset
+	RiskScore int `json:"risk_score"`
+
 	// Source Source of the data. e.g. 'NHS Trust X'
 	Source *string `json:"source,omitempty"`
 
@@ -951,6 +1463,11 @@ type AssetBase struct {
 	// LegalBasisSpecial Additional Condition for Special Category Data
 	LegalBasisSpecial *AssetBaseLegalBasisSpecial `json:"legal_basis_special,omitempty"`
 
+	// LocationReferences Typed locations of the asset in addition to the free text `locations`. When set, replaces the existing
+	// environment, project and external system locations of the asset. Project locations are added when the
+	// asset is attached to a TRE project
+	LocationReferences *[]AssetLocationReference `json:"location_references,omitempty"`
+
 	// Locations Storage locations and touchpoints for the asset
 	Locations []string `json:"locations"`
 
@@ -1000,6 +1517,94 @@ type AssetBaseProtection string
 // AssetBaseStatus Status of the asset
 type AssetBaseStatus string
 
+// AssetDerivationType How a derived asset was created from its parent
+type AssetDerivationType string
+
+// AssetDestruction A request to mark an asset as destroyed
+type AssetDestruction struct {
+	AssetId             string `json:"asset_id"`
+	CertificateFilename string `json:"certificate_filename"`
+
+	// CreatedAt Time in RFC3339 format when the request was created
+	CreatedAt string `json:"created_at"`
+
+	// DestroyedAt Date the asset was destroyed in YYYY-MM-DD format
+	DestroyedAt string `json:"destroyed_at"`
+	Id          string `json:"id"`
+
+	// Method How an asset was destroyed
+	Method            AssetDestructionMethod `json:"method"`
+	RequesterUsername string                 `json:"requester_username"`
+
+	// ReviewedAt Time in RFC3339 format when the request was approved or rejected
+	ReviewedAt       *string                `json:"reviewed_at,omitempty"`
+	ReviewerComments *string                `json:"reviewer_comments,omitempty"`
+	ReviewerUsername *string                `json:"reviewer_username,omitempty"`
+	Status           AssetDestructionStatus `json:"status"`
+	StudyId          string                 `json:"study_id"`
+}
+
+// AssetDestructionMethod How an asset was destroyed
+type AssetDestructionMethod string
+
+// AssetDestructionRequest defines model for AssetDestructionRequest.
+type AssetDestructionRequest struct {
+	// DestroyedAt Date the asset was destroyed in YYYY-MM-DD format
+	DestroyedAt string `json:"destroyed_at"`
+
+	// File Certificate of destruction (e.g., PDF)
+	File openapi_types.File `json:"file"`
+
+	// Method How an asset was destroyed
+	Method AssetDestructionMethod `json:"method"`
+}
+
+// AssetDestructionReview defines model for AssetDestructionReview.
+type AssetDestructionReview struct {
+	// Comments Comments of the reviewer on the request
+	Comments *string `json:"comments,omitempty"`
+}
+
+// AssetDestructionStatus defines model for AssetDestructionStatus.
+type AssetDestructionStatus string
+
+// AssetExpiryOutcome defines model for AssetExpiryOutcome.
+type AssetExpiryOutcome string
+
+// AssetExpiryResolution defines model for AssetExpiryResolution.
+type AssetExpiryResolution struct {
+	// ExpiresAt New retention expiry date of the asset in YYYY-MM-DD format. Required to extend
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Justification Why the asset is being kept or how it will be destroyed. Required to extend
+	Justification string             `json:"justification"`
+	Outcome       AssetExpiryOutcome `json:"outcome"`
+}
+
+// AssetExpiryReview Review raised for the study owner when the expiry of an asset was enforced
+type AssetExpiryReview struct {
+	AssetId    string `json:"asset_id"`
+	AssetTitle string `json:"asset_title"`
+	CreatedAt  string `json:"created_at"`
+
+	// DetachedProjectIds Projects the asset was removed from when its expiry was enforced
+	DetachedProjectIds []string `json:"detached_project_ids"`
+
+	// ExpiresAt Time in RFC3339 format of the expiry which was enforced
+	ExpiresAt string `json:"expires_at"`
+
+	// ExtendedExpiresAt Time in RFC3339 format of the new expiry if it was extended
+	ExtendedExpiresAt *string                 `json:"extended_expires_at,omitempty"`
+	Id                string                  `json:"id"`
+	Justification     *string                 `json:"justification,omitempty"`
+	ResolvedAt        *string                 `json:"resolved_at,omitempty"`
+	ResolverUsername  *string                 `json:"resolver_username,omitempty"`
+	Status            AssetExpiryReviewStatus `json:"status"`
+}
+
+// AssetExpiryReviewStatus defines model for AssetExpiryReviewStatus.
+type AssetExpiryReviewStatus string
+
 // AssetImport defines model for AssetImport.
 type AssetImport struct {
 	CreatedAt          string   `json:"created_at"`
@@ -1017,6 +1622,182 @@ type AssetImport struct {
 	Title              string   `json:"title"`
 }
 
+// AssetLineage Graph of the assets of a study and the links between them
+type AssetLineage struct {
+	Assets []AssetLineageNode `json:"assets"`
+	Links  []AssetLink        `json:"links"`
+}
+
+// AssetLineageNode defines model for AssetLineageNode.
+type AssetLineageNode struct {
+	Id string `json:"id"`
+
+	// Status Status of the asset
+	Status string `json:"status"`
+	Tier   int    `json:"tier"`
+	Title  string `json:"title"`
+}
+
+// AssetLink A link from an asset to a parent asset it was derived from
+type AssetLink struct {
+	// ChildAssetId Derived asset
+	ChildAssetId string `json:"child_asset_id"`
+
+	// CreatedAt Time in RFC3339 format when the link was created
+	CreatedAt string `json:"created_at"`
+
+	// DerivationType How a derived asset was created from its parent
+	DerivationType AssetDerivationType `json:"derivation_type"`
+
+	// ParentAssetId Asset the child was derived from
+	ParentAssetId string `json:"parent_asset_id"`
+}
+
+// AssetLinkRequest defines model for AssetLinkRequest.
+type AssetLinkRequest struct {
+	// DerivationType How a derived asset was created from its parent
+	DerivationType AssetDerivationType `json:"derivation_type"`
+
+	// ParentAssetId Asset the asset was derived from
+	ParentAssetId string `json:"parent_asset_id"`
+}
+
+// AssetLocationKind Kind of place an asset is stored in
+type AssetLocationKind string
+
+// AssetLocationReference A location of an asset
+type AssetLocationReference struct {
+	// EnvironmentId Portal environment storing the asset. Required for environment locations
+	EnvironmentId *string `json:"environment_id,omitempty"`
+
+	// Kind Kind of place an asset is stored in
+	Kind AssetLocationKind `json:"kind"`
+
+	// Name Name of the external system or free text location. Required for external system and other locations and
+	// set by the portal for environments and projects
+	Name *string `json:"name,omitempty"`
+
+	// ProjectId Portal project of the study the asset is attached to. Required for project locations
+	ProjectId *string `json:"project_id,omitempty"`
+}
+
+// AssetLocationReport The active assets stored in a location
+type AssetLocationReport struct {
+	Assets []AssetLocationReportAsset `json:"assets"`
+
+	// Location A location of an asset
+	Location AssetLocationReference `json:"location"`
+}
+
+// AssetLocationReportAsset defines model for AssetLocationReportAsset.
+type AssetLocationReportAsset struct {
+	Id         string `json:"id"`
+	Status     string `json:"status"`
+	StudyId    string `json:"study_id"`
+	StudyTitle string `json:"study_title"`
+	Tier       int    `json:"tier"`
+	Title      string `json:"title"`
+}
+
+// AssetRegisterEntry An asset in the information asset register
+type AssetRegisterEntry struct {
+	AssetId    string `json:"asset_id"`
+	AssetTitle string `json:"asset_title"`
+
+	// Caseref Case reference of the study
+	Caseref              int    `json:"caseref"`
+	ClassificationImpact string `json:"classification_impact"`
+
+	// Contracts Titles of the contracts covering the asset
+	Contracts []string `json:"contracts"`
+	DataTypes []string `json:"data_types"`
+
+	// ExpiresAt Retention expiry date of the asset
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// LegalBasis Legal basis for holding the asset
+	LegalBasis *string `json:"legal_basis,omitempty"`
+
+	// LegalBasisSpecial Additional condition for special category data
+	LegalBasisSpecial *string `json:"legal_basis_special,omitempty"`
+
+	// Locations Names of the places the asset is stored
+	Locations []string `json:"locations"`
+
+	// OwnerUsername Username of the study owner
+	OwnerUsername string `json:"owner_username"`
+
+	// Status Status of the asset
+	Status     string `json:"status"`
+	StudyId    string `json:"study_id"`
+	StudyTitle string `json:"study_title"`
+	Tier       int    `json:"tier"`
+}
+
+// AssetRegisterExport An export of the asset register stored by the scheduled job
+type AssetRegisterExport struct {
+	CreatedAt string              `json:"created_at"`
+	Filename  string              `json:"filename"`
+	Format    AssetRegisterFormat `json:"format"`
+	Id        string              `json:"id"`
+	NumAssets int                 `json:"num_assets"`
+}
+
+// AssetRegisterFormat defines model for AssetRegisterFormat.
+type AssetRegisterFormat string
+
+// AssetTransfer A transfer of an asset from one study to another
+type AssetTransfer struct {
+	AssetId    string `json:"asset_id"`
+	AssetTitle string `json:"asset_title"`
+
+	// ContractIds Contracts moved with the asset. Empty until the transfer is completed
+	ContractIds []string `json:"contract_ids"`
+
+	// CreatedAt Time in RFC3339 format when the transfer was requested
+	CreatedAt         string `json:"created_at"`
+	Id                string `json:"id"`
+	Reason            string `json:"reason"`
+	RequesterUsername string `json:"requester_username"`
+
+	// ReviewedAt Time in RFC3339 format when the transfer was completed or rejected
+	ReviewedAt *string `json:"reviewed_at,omitempty"`
+
+	// ReviewerUsername User who completed or rejected the transfer
+	ReviewerUsername *string `json:"reviewer_username,omitempty"`
+
+	// SourceOwnerApprovedAt Time in RFC3339 format when the owner of the source study approved. Absent if not yet approved
+	SourceOwnerApprovedAt *string `json:"source_owner_approved_at,omitempty"`
+
+	// SourceStudyId Absent once the source study has been erased
+	SourceStudyId *string `json:"source_study_id,omitempty"`
+
+	// SourceStudyTitle Absent once the source study has been erased
+	SourceStudyTitle *string             `json:"source_study_title,omitempty"`
+	Status           AssetTransferStatus `json:"status"`
+
+	// TargetOwnerApprovedAt Time in RFC3339 format when the owner of the target study approved. Absent if not yet approved
+	TargetOwnerApprovedAt *string `json:"target_owner_approved_at,omitempty"`
+
+	// TargetStudyId Absent once the target study has been erased
+	TargetStudyId *string `json:"target_study_id,omitempty"`
+
+	// TargetStudyTitle Absent once the target study has been erased
+	TargetStudyTitle *string `json:"target_study_title,omitempty"`
+}
+
+// AssetTransferRequest defines model for AssetTransferRequest.
+type AssetTransferRequest struct {
+	// Reason Why the asset is being transferred
+	Reason string `json:"reason"`
+
+	// TargetStudyId Study to transfer the asset to
+	TargetStudyId string `json:"target_study_id"`
+}
+
+// AssetTransferStatus defines model for AssetTransferStatus.
+type AssetTransferStatus string
+
 // Auth defines model for Auth.
 type Auth struct {
 	// Roles List of roles assigned to the user. This array can contain both:
@@ -1060,6 +1841,9 @@ type Contract struct {
 	// OtherSignatories Other signatories to the contract. Could be name(s) or email(s)
 	OtherSignatories *string `json:"other_signatories,omitempty"`
 
+	// PredecessorContractId Unique identifier of the contract this renews, if any
+	PredecessorContractId *string `json:"predecessor_contract_id,omitempty"`
+
 	// RetentionEndDate Contract retention end date in YYYY-MM-DD format
 	//
 	// Example: 2035-12-31
@@ -1076,6 +1860,9 @@ type Contract struct {
 	// StudyId Unique identifier of the study to which the contract belongs
 	StudyId string `json:"study_id"`
 
+	// SuccessorContractId Unique identifier of the renewal of this contract, if one has been started
+	SuccessorContractId *string `json:"successor_contract_id,omitempty"`
+
 	// ThirdPartyName Name of the third party organization
 	ThirdPartyName *string `json:"third_party_name,omitempty"`
 
@@ -1084,11 +1871,30 @@ type Contract struct {
 
 	// UpdatedAt Time in RFC3339 format when the contract was last updated
 	UpdatedAt string `json:"updated_at"`
+
+	// Version Version of the current effective terms. The original terms are version 1
+	Version int `json:"version"`
 }
 
 // ContractStatus Current status of the contract
 type ContractStatus string
 
+// ContractAmendmentRequest defines model for ContractAmendmentRequest.
+type ContractAmendmentRequest struct {
+	// EffectiveDate Date in YYYY-MM-DD format from which the amended terms apply
+	EffectiveDate string `json:"effective_date"`
+
+	// Reason Why the contract was varied
+	Reason string       `json:"reason"`
+	Terms  ContractBase `json:"terms"`
+}
+
+// ContractAmendmentReview defines model for ContractAmendmentReview.
+type ContractAmendmentReview struct {
+	// Comments Comments of the reviewer
+	Comments *string `json:"comments,omitempty"`
+}
+
 // ContractBase defines model for ContractBase.
 type ContractBase struct {
 	// AssetIds List of assets associated with this contract
@@ -1145,14 +1951,140 @@ type ContractObject struct {
 	File openapi_types.File `json:"file"`
 }
 
+// ContractObjectDownload Record of a user downloading a contract object
+type ContractObjectDownload struct {
+	ContractObjectId string `json:"contract_object_id"`
+
+	// CreatedAt Time in RFC3339 format when the object was downloaded
+	CreatedAt string `json:"created_at"`
+	Filename  string `json:"filename"`
+	Id        string `json:"id"`
+	Username  string `json:"username"`
+
+	// Watermarked Whether the downloaded file was stamped with a watermark identifying the user
+	Watermarked bool `json:"watermarked"`
+}
+
 // ContractObjectMetadata defines model for ContractObjectMetadata.
 type ContractObjectMetadata struct {
+	// ContractVersionId Contract version the object was uploaded for, if any
+	ContractVersionId *string `json:"contract_version_id,omitempty"`
+
 	// CreatedAt Time in RFC3339 format when the contract was created
 	CreatedAt string `json:"created_at"`
 	Filename  string `json:"filename"`
 
 	// Id Unique identifier for the contract metadata object
 	Id string `json:"id"`
+
+	// ScanStatus Whether an uploaded file has been scanned for malware. Pending and infected files are
+	// quarantined and cannot be downloaded. Skipped files were uploaded while scanning was disabled
+	ScanStatus MalwareScanStatus `json:"scan_status"`
+
+	// ScannedAt Time in RFC3339 format when the object was scanned for malware
+	ScannedAt *string `json:"scanned_at,omitempty"`
+}
+
+// ContractVersion Terms of a contract from an effective date, either the original terms or an amendment
+type ContractVersion struct {
+	// AppliedAt Time in RFC3339 format when the terms became the current terms of the contract
+	AppliedAt *string `json:"applied_at,omitempty"`
+
+	// ChangedFields Fields of the terms which differ from the previous effective version
+	ChangedFields []string `json:"changed_fields"`
+	ContractId    string   `json:"contract_id"`
+	CreatedAt     string   `json:"created_at"`
+
+	// EffectiveDate Date in YYYY-MM-DD format from which the terms apply
+	EffectiveDate     string                   `json:"effective_date"`
+	Id                string                   `json:"id"`
+	ObjectsMetadata   []ContractObjectMetadata `json:"objects_metadata"`
+	Reason            string                   `json:"reason"`
+	RequesterUsername string                   `json:"requester_username"`
+	ReviewedAt        *string                  `json:"reviewed_at,omitempty"`
+	ReviewerComments  *string                  `json:"reviewer_comments,omitempty"`
+	ReviewerUsername  *string                  `json:"reviewer_username,omitempty"`
+	Status            ContractVersionStatus    `json:"status"`
+	Terms             ContractBase             `json:"terms"`
+
+	// Version Version number. The original terms are version 1
+	Version int `json:"version"`
+}
+
+// ContractVersionStatus defines model for ContractVersionStatus.
+type ContractVersionStatus string
+
+// Dpia defines model for Dpia.
+type Dpia struct {
+	// Answers Answers to every DPIA question, in order
+	Answers []DpiaAnswer `json:"answers"`
+
+	// ReviewDate Date in YYYY-MM-DD format by which the DPIA must be reviewed
+	ReviewDate *string    `json:"review_date,omitempty"`
+	Risks      []DpiaRisk `json:"risks"`
+
+	// SignedOffAt Time in RFC3339 format of the sign-off
+	SignedOffAt *string `json:"signed_off_at,omitempty"`
+
+	// SignedOffByUsername Username of the DPO who signed off the DPIA
+	SignedOffByUsername *string `json:"signed_off_by_username,omitempty"`
+
+	// Status Status of a DPIA. Absent on a study without a DPIA
+	Status DpiaStatus `json:"status"`
+
+	// TriggerReason Why the DPIA is required
+	TriggerReason string `json:"trigger_reason"`
+
+	// UpdatedAt Time in RFC3339 format of the last update
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DpiaAnswer defines model for DpiaAnswer.
+type DpiaAnswer struct {
+	// Answer Empty if not yet answered
+	Answer string `json:"answer"`
+
+	// Prompt Question text to show to the user
+	Prompt string `json:"prompt"`
+
+	// Question Key of the question e.g. processing_description
+	Question string `json:"question"`
+}
+
+// DpiaAnswerUpdate defines model for DpiaAnswerUpdate.
+type DpiaAnswerUpdate struct {
+	Answer   string `json:"answer"`
+	Question string `json:"question"`
+}
+
+// DpiaRisk defines model for DpiaRisk.
+type DpiaRisk struct {
+	// Description Risk to the rights and freedoms of data subjects
+	Description string        `json:"description"`
+	Likelihood  DpiaRiskLevel `json:"likelihood"`
+
+	// Mitigation Measures taken to reduce the risk
+	Mitigation   string        `json:"mitigation"`
+	ResidualRisk DpiaRiskLevel `json:"residual_risk"`
+	Severity     DpiaRiskLevel `json:"severity"`
+}
+
+// DpiaRiskLevel defines model for DpiaRiskLevel.
+type DpiaRiskLevel string
+
+// DpiaSignoff defines model for DpiaSignoff.
+type DpiaSignoff struct {
+	// ReviewDate Date in YYYY-MM-DD format by which the DPIA must be reviewed
+	ReviewDate string `json:"review_date"`
+}
+
+// DpiaStatus Status of a DPIA. Absent on a study without a DPIA
+type DpiaStatus string
+
+// DpiaUpdate defines model for DpiaUpdate.
+type DpiaUpdate struct {
+	Answers []DpiaAnswerUpdate `json:"answers"`
+	Risks   []DpiaRisk         `json:"risks"`
 }
 
 // Environment An environment with its tier mapping
@@ -1170,11 +2102,63 @@ type Environment struct {
 // EnvironmentName defines model for EnvironmentName.
 type EnvironmentName string
 
+// Erasure defines model for Erasure.
+type Erasure struct {
+	// CompletedAt Time in RFC3339 format when the erasure was carried out
+	CompletedAt *string `json:"completed_at,omitempty"`
+
+	// CreatedAt Time in RFC3339 format when the erasure was requested
+	CreatedAt         string          `json:"created_at"`
+	Id                string          `json:"id"`
+	Manifest          ErasureManifest `json:"manifest"`
+	Reason            string          `json:"reason"`
+	RequesterUsername string          `json:"requester_username"`
+
+	// ReviewerUsername Administrator who approved or rejected the erasure
+	ReviewerUsername *string       `json:"reviewer_username,omitempty"`
+	Status           ErasureStatus `json:"status"`
+	SubjectId        string        `json:"subject_id"`
+
+	// SubjectKind Kind of record being erased
+	SubjectKind ErasureSubjectKind `json:"subject_kind"`
+}
+
+// ErasureManifest defines model for ErasureManifest.
+type ErasureManifest struct {
+	// Objects Keys of the stored objects removed, or to be removed
+	Objects []string `json:"objects"`
+
+	// Rows Number of rows removed, or to be removed, from each table
+	Rows map[string]int `json:"rows"`
+}
+
+// ErasureRequest defines model for ErasureRequest.
+type ErasureRequest struct {
+	// Reason Legal basis for the erasure e.g. a data subject request reference
+	Reason string `json:"reason"`
+
+	// SubjectId UUID of the study or user to erase
+	SubjectId string `json:"subject_id"`
+
+	// SubjectKind Kind of record being erased
+	SubjectKind ErasureSubjectKind `json:"subject_kind"`
+}
+
+// ErasureStatus defines model for ErasureStatus.
+type ErasureStatus string
+
+// ErasureSubjectKind Kind of record being erased
+type ErasureSubjectKind string
+
 // Feedback defines model for Feedback.
 type Feedback struct {
 	Message string `json:"message"`
 }
 
+// MalwareScanStatus Whether an uploaded file has been scanned for malware. Pending and infected files are
+// quarantined and cannot be downloaded. Skipped files were uploaded while scanning was disabled
+type MalwareScanStatus string
+
 // Notification defines model for Notification.
 type Notification struct {
 	Body *string `json:"body,omitempty"`
@@ -1426,6 +2410,122 @@ type ProjectTREUserDesktopConfig struct {
 	RootVolumeGb    *int    `json:"root_volume_gb,omitempty"`
 }
 
+// RegulatoryApproval An ethics or regulatory approval of a study
+type RegulatoryApproval struct {
+	// Conditions Any conditions attached to the approval
+	Conditions *string `json:"conditions,omitempty"`
+
+	// CreatedAt Time in RFC3339 format when the approval was recorded
+	CreatedAt string `json:"created_at"`
+
+	// ExpiryDate Date the approval expires in YYYY-MM-DD format. Absent if it does not expire
+	//
+	// Example: 2027-01-01
+	ExpiryDate *string `json:"expiry_date,omitempty"`
+
+	// GrantedDate Date the approval was granted in YYYY-MM-DD format
+	//
+	// Example: 2024-01-01
+	GrantedDate string `json:"granted_date"`
+	Id          string `json:"id"`
+
+	// IssuingBody Name of the committee or organisation which granted the approval
+	IssuingBody string `json:"issuing_body"`
+
+	// LetterFilename Filename of the uploaded approval letter. Absent if none has been uploaded
+	LetterFilename *string `json:"letter_filename,omitempty"`
+
+	// Reference Reference issued with the approval e.g. an IRAS ID or CAG reference
+	Reference string `json:"reference"`
+	StudyId   string `json:"study_id"`
+
+	// Type Kind of approval e.g. research ethics committee (ethics) or Health Research Authority (hra)
+	Type RegulatoryApprovalType `json:"type"`
+
+	// UpdatedAt Time in RFC3339 format when the approval was last updated
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RegulatoryApprovalBase defines model for RegulatoryApprovalBase.
+type RegulatoryApprovalBase struct {
+	// Conditions Any conditions attached to the approval
+	Conditions *string `json:"conditions,omitempty"`
+
+	// ExpiryDate Date the approval expires in YYYY-MM-DD format. Absent if it does not expire
+	//
+	// Example: 2027-01-01
+	ExpiryDate *string `json:"expiry_date,omitempty"`
+
+	// GrantedDate Date the approval was granted in YYYY-MM-DD format
+	//
+	// Example: 2024-01-01
+	GrantedDate string `json:"granted_date"`
+
+	// IssuingBody Name of the committee or organisation which granted the approval
+	IssuingBody string `json:"issuing_body"`
+
+	// Reference Reference issued with the approval e.g. an IRAS ID or CAG reference
+	Reference string `json:"reference"`
+
+	// Type Kind of approval e.g. research ethics committee (ethics) or Health Research Authority (hra)
+	Type RegulatoryApprovalType `json:"type"`
+}
+
+// RegulatoryApprovalLetter defines model for RegulatoryApprovalLetter.
+type RegulatoryApprovalLetter struct {
+	// File The approval letter to upload (e.g., PDF)
+	File openapi_types.File `json:"file"`
+}
+
+// RegulatoryApprovalType Kind of approval e.g. research ethics committee (ethics) or Health Research Authority (hra)
+type RegulatoryApprovalType string
+
+// SearchEntityType defines model for SearchEntityType.
+type SearchEntityType string
+
+// SearchFacetCount defines model for SearchFacetCount.
+type SearchFacetCount struct {
+	Count int    `json:"count"`
+	Value string `json:"value"`
+}
+
+// SearchFacets Number of matching items by each filter value. Each facet applies every filter except its own
+type SearchFacets struct {
+	EntityTypes []SearchFacetCount `json:"entity_types"`
+	Statuses    []SearchFacetCount `json:"statuses"`
+	Tiers       []SearchFacetCount `json:"tiers"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	EntityType SearchEntityType `json:"entity_type"`
+	Id         string           `json:"id"`
+
+	// Status Status of the item. Absent if the item has no status
+	Status       *string `json:"status,omitempty"`
+	StudyCaseref int     `json:"study_caseref"`
+
+	// StudyId Study the item belongs to. The item itself for a study
+	StudyId    string `json:"study_id"`
+	StudyTitle string `json:"study_title"`
+
+	// Tier Tier of an asset
+	Tier *int `json:"tier,omitempty"`
+
+	// Title Title of the item. The name of a project
+	Title string `json:"title"`
+}
+
+// SearchResults defines model for SearchResults.
+type SearchResults struct {
+	// Facets Number of matching items by each filter value. Each facet applies every filter except its own
+	Facets SearchFacets   `json:"facets"`
+	Items  []SearchResult `json:"items"`
+
+	// Total Number of matching items across all pages
+	Total int `json:"total"`
+}
+
 // Study A research study
 type Study struct {
 	// AdditionalStudyAdminUsernames List of additional study administrator usernames (empty array if none)
@@ -1451,7 +2551,12 @@ type Study struct {
 
 	// Description Description of the study
 	Description *string `json:"description,omitempty"`
-	Feedback    *string `json:"feedback,omitempty"`
+
+	// DpiaStatus Status of a DPIA. Absent on a study without a DPIA
+	DpiaStatus *DpiaStatus `json:"dpia_status,omitempty"`
+
+	// Feedback Latest reviewer feedback. Deprecated in favour of review threads
+	Feedback *string `json:"feedback,omitempty"`
 
 	// Id Unique identifier for the study
 	Id string `json:"id"`
@@ -1519,6 +2624,21 @@ type Study struct {
 	// RequiresDspt Whether NHS Data Security & Protection Toolkit is required
 	RequiresDspt *bool `json:"requires_dspt,omitempty"`
 
+	// ReviewerUsername Username of the IG ops staff member reviewing the study. Absent if unassigned
+	ReviewerUsername *string `json:"reviewer_username,omitempty"`
+
+	// RiskRating Rating derived from the study risk score
+	RiskRating *StudyRiskRating `json:"risk_rating,omitempty"`
+
+	// RiskScore Risk score of the highest risk asset. Absent if the study has no assets
+	RiskScore *int `json:"risk_score,omitempty"`
+
+	// SignoffExpiresAt Time in RFC3339 format when the latest signoff expires. Absent if never signed off
+	SignoffExpiresAt *string `json:"signoff_expires_at,omitempty"`
+
+	// SignoffValidityDays Number of days a signoff is valid for, set by the highest tier of the active study assets
+	SignoffValidityDays int `json:"signoff_validity_days"`
+
 	// Title Title of the study
 	Title string `json:"title"`
 
@@ -1613,6 +2733,135 @@ type StudyBase struct {
 	Title string `json:"title"`
 }
 
+// StudyBulkImport defines model for StudyBulkImport.
+type StudyBulkImport struct {
+	// Assets CSV of assets
+	Assets *openapi_types.File `json:"assets,omitempty"`
+
+	// Contracts CSV of contracts
+	Contracts *openapi_types.File `json:"contracts,omitempty"`
+
+	// Studies CSV of studies
+	Studies *openapi_types.File `json:"studies,omitempty"`
+
+	// Workbook XLSX workbook with studies, assets and/or contracts sheets
+	Workbook *openapi_types.File `json:"workbook,omitempty"`
+}
+
+// StudyBulkImportError defines model for StudyBulkImportError.
+type StudyBulkImportError struct {
+	Message string `json:"message"`
+
+	// Row Row number within the sheet, where the header is row 1
+	Row int `json:"row"`
+
+	// Sheet Sheet containing the row e.g. studies
+	Sheet string `json:"sheet"`
+}
+
+// StudyBulkImportReport defines model for StudyBulkImportReport.
+type StudyBulkImportReport struct {
+	// Assets Number of assets imported, or that would be imported in a dry run
+	Assets int `json:"assets"`
+
+	// Committed Whether the rows were imported
+	Committed bool `json:"committed"`
+
+	// Contracts Number of contracts imported, or that would be imported in a dry run
+	Contracts int                    `json:"contracts"`
+	DryRun    bool                   `json:"dry_run"`
+	Errors    []StudyBulkImportError `json:"errors"`
+
+	// Studies Number of studies imported, or that would be imported in a dry run
+	Studies int `json:"studies"`
+}
+
+// StudyClosure defines model for StudyClosure.
+type StudyClosure struct {
+	// CanComplete Whether the study is closing and has no outstanding assets or contracts
+	CanComplete bool `json:"can_complete"`
+
+	// OutstandingAssets Assets not yet destroyed or transferred
+	OutstandingAssets []StudyClosureItem `json:"outstanding_assets"`
+
+	// OutstandingContracts Contracts not yet closed
+	OutstandingContracts []StudyClosureItem `json:"outstanding_contracts"`
+
+	// OutstandingProjects TRE projects not yet deleted. Deletion is requested when closure starts and does not block closure
+	OutstandingProjects []StudyClosureItem `json:"outstanding_projects"`
+}
+
+// StudyClosureItem defines model for StudyClosureItem.
+type StudyClosureItem struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// StudyDocument defines model for StudyDocument.
+type StudyDocument struct {
+	// Category Kind of evidence the document provides
+	Category StudyDocumentCategory `json:"category"`
+
+	// CreatedAt Time in RFC3339 format when the document was created
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	StudyId   string `json:"study_id"`
+	Title     string `json:"title"`
+
+	// UpdatedAt Time in RFC3339 format when the document was last updated
+	UpdatedAt string `json:"updated_at"`
+
+	// Versions Uploaded versions of the document, newest first
+	Versions []StudyDocumentVersion `json:"versions"`
+}
+
+// StudyDocumentCategory Kind of evidence the document provides
+type StudyDocumentCategory string
+
+// StudyDocumentUpload defines model for StudyDocumentUpload.
+type StudyDocumentUpload struct {
+	// Category Kind of evidence the document provides
+	Category StudyDocumentCategory `json:"category"`
+
+	// File The first version of the document to upload (e.g., PDF)
+	File openapi_types.File `json:"file"`
+
+	// Title Short title of the document
+	Title string `json:"title"`
+}
+
+// StudyDocumentVersion defines model for StudyDocumentVersion.
+type StudyDocumentVersion struct {
+	// CreatedAt Time in RFC3339 format when the version was uploaded
+	CreatedAt string `json:"created_at"`
+
+	// CreatorUsername Username of the user who uploaded this version
+	CreatorUsername string `json:"creator_username"`
+	Filename        string `json:"filename"`
+	Id              string `json:"id"`
+
+	// Version Version number, starting at 1
+	Version int `json:"version"`
+}
+
+// StudyDocumentVersionUpload defines model for StudyDocumentVersionUpload.
+type StudyDocumentVersionUpload struct {
+	// File The new version of the document to upload (e.g., PDF)
+	File openapi_types.File `json:"file"`
+}
+
+// StudyFieldChange defines model for StudyFieldChange.
+type StudyFieldChange struct {
+	// Field Name of the changed study field e.g. title
+	Field string `json:"field"`
+
+	// NewValue Value after the change. Absent if the field was unset
+	NewValue *string `json:"new_value,omitempty"`
+
+	// OldValue Value before the change. Absent if the field was unset
+	OldValue *string `json:"old_value,omitempty"`
+}
+
 // StudyImport defines model for StudyImport.
 type StudyImport struct {
 	AdditionalStudyAdminUsername *string `json:"additional_study_admin_username,omitempty"`
@@ -1657,6 +2906,34 @@ type StudyImport struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+// StudyOwnerChange An owner change request awaiting a decision
+type StudyOwnerChange struct {
+	// ExpiresAt Time in RFC3339 format after which the request expires if not approved
+	ExpiresAt string `json:"expires_at"`
+
+	// FromUsername Username of the current owner
+	FromUsername string `json:"from_username"`
+
+	// RequestedAt Time in RFC3339 format when the change was requested
+	RequestedAt string `json:"requested_at"`
+
+	// RequestedByUsername Username of the user who requested the change
+	RequestedByUsername *string `json:"requested_by_username,omitempty"`
+
+	// StudyId Study UUID
+	StudyId    string `json:"study_id"`
+	StudyTitle string `json:"study_title"`
+
+	// ToUsername Username of the proposed owner
+	ToUsername string `json:"to_username"`
+}
+
+// StudyOwnerChangeDecision defines model for StudyOwnerChangeDecision.
+type StudyOwnerChangeDecision struct {
+	// Reason Why the owner change was rejected or cancelled
+	Reason string `json:"reason"`
+}
+
 // StudyOwnerUpdate defines model for StudyOwnerUpdate.
 type StudyOwnerUpdate struct {
 	// Username Username to change the owner of the study to. Must exist
@@ -1668,13 +2945,118 @@ type StudyRequest = StudyBase
 
 // StudyReview defines model for StudyReview.
 type StudyReview struct {
-	// Feedback Feedback for the study
+	// Feedback Feedback for the study. Non-empty feedback starts a general review thread
 	Feedback *string `json:"feedback,omitempty"`
 
 	// Status Current approval status
 	Status StudyApprovalStatus `json:"status"`
 }
 
+// StudyReviewComment defines model for StudyReviewComment.
+type StudyReviewComment struct {
+	Comment string `json:"comment"`
+
+	// CreatedAt Time in RFC3339 format when the comment was made
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+
+	// Username Username of the comment author
+	Username string `json:"username"`
+}
+
+// StudyReviewCommentRequest defines model for StudyReviewCommentRequest.
+type StudyReviewCommentRequest struct {
+	Comment string `json:"comment"`
+}
+
+// StudyReviewThread A reviewer comment thread, oldest comment first
+type StudyReviewThread struct {
+	// AssetId UUID of the asset if the field is an asset field
+	AssetId  *string              `json:"asset_id,omitempty"`
+	Comments []StudyReviewComment `json:"comments"`
+
+	// CreatedAt Time in RFC3339 format when the thread was started
+	CreatedAt string `json:"created_at"`
+
+	// CreatorUsername Username of the reviewer who started the thread
+	CreatorUsername string `json:"creator_username"`
+
+	// Field JSON name of the field the thread is about e.g. title, or "general" for the study as a whole
+	Field string `json:"field"`
+	Id    string `json:"id"`
+
+	// ResolvedAt Time in RFC3339 format when the thread was resolved. Absent if unresolved
+	ResolvedAt *string `json:"resolved_at,omitempty"`
+
+	// ResolvedByUsername Username of the reviewer who resolved the thread
+	ResolvedByUsername *string `json:"resolved_by_username,omitempty"`
+}
+
+// StudyReviewThreadRequest defines model for StudyReviewThreadRequest.
+type StudyReviewThreadRequest struct {
+	// AssetId UUID of the asset if the field is an asset field
+	AssetId *string `json:"asset_id,omitempty"`
+
+	// Comment Initial comment of the thread
+	Comment string `json:"comment"`
+
+	// Field JSON name of the field the thread is about e.g. title, or "general" for the study as a whole
+	Field string `json:"field"`
+}
+
+// StudyReviewerUpdate defines model for StudyReviewerUpdate.
+type StudyReviewerUpdate struct {
+	// Username Username of the IG ops staff member to review the study
+	Username string `json:"username"`
+}
+
+// StudyRevision An immutable record of the fields changed by a single save of a study
+type StudyRevision struct {
+	Changes []StudyFieldChange `json:"changes"`
+
+	// CreatedAt Time in RFC3339 format when the study was saved
+	CreatedAt string `json:"created_at"`
+
+	// Id Unique identifier for the revision
+	Id string `json:"id"`
+
+	// Username Username of the user who saved the study
+	Username string `json:"username"`
+}
+
+// StudyRevisionDiff defines model for StudyRevisionDiff.
+type StudyRevisionDiff struct {
+	Changes        []StudyFieldChange `json:"changes"`
+	FromRevisionId string             `json:"from_revision_id"`
+	ToRevisionId   string             `json:"to_revision_id"`
+}
+
+// StudyRiskRating Rating derived from the study risk score
+type StudyRiskRating string
+
+// StudySignoff An immutable record of an attestation that the study details are up to date
+type StudySignoff struct {
+	// AgreementId ID of the attestation agreement version shown
+	AgreementId string  `json:"agreement_id"`
+	Comments    *string `json:"comments,omitempty"`
+
+	// CreatedAt Time in RFC3339 format when the study was signed off
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+
+	// Username Username of the user who signed off the study
+	Username string `json:"username"`
+}
+
+// StudySignoffRequest defines model for StudySignoffRequest.
+type StudySignoffRequest struct {
+	// AgreementId ID of the study-signoff agreement version shown to the IAO. Defaults to the latest
+	AgreementId *string `json:"agreement_id,omitempty"`
+
+	// Comments Optional comments from the IAO
+	Comments *string `json:"comments,omitempty"`
+}
+
 // Token defines model for Token.
 type Token struct {
 	// ExpiresAt Time in RFC3339 at which the token expires
@@ -1763,6 +3145,9 @@ type ValidationError struct {
 	ErrorMessage string `json:"error_message"`
 }
 
+// ApprovalIdParam defines model for ApprovalIdParam.
+type ApprovalIdParam = string
+
 // AssetIdParam defines model for AssetIdParam.
 type AssetIdParam = string
 
@@ -1772,21 +3157,72 @@ type ContractIdParam = string
 // ContractObjectIdParam defines model for ContractObjectIdParam.
 type ContractObjectIdParam = string
 
+// ContractVersionIdParam defines model for ContractVersionIdParam.
+type ContractVersionIdParam = string
+
+// DestructionIdParam defines model for DestructionIdParam.
+type DestructionIdParam = string
+
+// DocumentIdParam defines model for DocumentIdParam.
+type DocumentIdParam = string
+
+// DocumentVersionIdParam defines model for DocumentVersionIdParam.
+type DocumentVersionIdParam = string
+
 // EnvironmentParam defines model for EnvironmentParam.
 type EnvironmentParam string
 
+// ErasureIdParam defines model for ErasureIdParam.
+type ErasureIdParam = string
+
+// ExpiryReviewIdParam defines model for ExpiryReviewIdParam.
+type ExpiryReviewIdParam = string
+
+// ExportIdParam defines model for ExportIdParam.
+type ExportIdParam = string
+
+// ParentAssetIdParam defines model for ParentAssetIdParam.
+type ParentAssetIdParam = string
+
 // ProjectIdParam defines model for ProjectIdParam.
 type ProjectIdParam = string
 
+// ReviewThreadIdParam defines model for ReviewThreadIdParam.
+type ReviewThreadIdParam = string
+
 // StudyIdParam defines model for StudyIdParam.
 type StudyIdParam = string
 
+// TransferIdParam defines model for TransferIdParam.
+type TransferIdParam = string
+
 // UserFindParam defines model for UserFindParam.
 type UserFindParam = string
 
 // UserIdParam defines model for UserIdParam.
 type UserIdParam = string
 
+// GetSearchParams defines parameters for GetSearch.
+type GetSearchParams struct {
+	// Query Text to search for
+	Query string `form:"query" json:"query"`
+
+	// EntityType Only return results of this type
+	EntityType *SearchEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// Status Only return results with this status e.g. approved, active
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tier Only return results with this tier
+	Tier *int `form:"tier,omitempty" json:"tier,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Index of the first item to return
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // GetStudiesParams defines parameters for GetStudies.
 type GetStudiesParams struct {
 	// Status get studies by status
@@ -1810,6 +3246,12 @@ type GetStudiesParams struct {
 	// Administrator Username/name/email of a study administrator. e.g. ccxyz@ucl.ac.uk, Bob Smith, bob.smith@ucl.ac.uk
 	Administrator *string `form:"administrator,omitempty" json:"administrator,omitempty"`
 
+	// Reviewer Filter by review assignment. "me" for studies assigned to the current user, "unassigned" for those with no reviewer
+	Reviewer *GetStudiesParamsReviewer `form:"reviewer,omitempty" json:"reviewer,omitempty"`
+
+	// RiskRating Filter by study risk rating
+	RiskRating *StudyRiskRating `form:"risk_rating,omitempty" json:"risk_rating,omitempty"`
+
 	// Limit Maximum number of items to return
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
@@ -1817,6 +3259,45 @@ type GetStudiesParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetStudiesParamsReviewer defines parameters for GetStudies.
+type GetStudiesParamsReviewer string
+
+// GetStudiesAdminAssetLocationsParams defines parameters for GetStudiesAdminAssetLocations.
+type GetStudiesAdminAssetLocationsParams struct {
+	// Kind Only include locations of this kind
+	Kind *AssetLocationKind `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// GetStudiesAdminAssetRegisterParams defines parameters for GetStudiesAdminAssetRegister.
+type GetStudiesAdminAssetRegisterParams struct {
+	// Format Format of the export. Defaults to csv
+	Format *AssetRegisterFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Status Only include assets with this status
+	Status *GetStudiesAdminAssetRegisterParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tier Only include assets with this tier
+	Tier *int `form:"tier,omitempty" json:"tier,omitempty"`
+}
+
+// GetStudiesAdminAssetRegisterParamsStatus defines parameters for GetStudiesAdminAssetRegister.
+type GetStudiesAdminAssetRegisterParamsStatus string
+
+// PostStudiesAdminImportBulkParams defines parameters for PostStudiesAdminImportBulk.
+type PostStudiesAdminImportBulkParams struct {
+	// DryRun Validate and report on the import without committing it
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetStudiesStudyIdRevisionsDiffParams defines parameters for GetStudiesStudyIdRevisionsDiff.
+type GetStudiesStudyIdRevisionsDiffParams struct {
+	// From Revision UUID to compare from. Changes made in this revision are excluded
+	From string `form:"from" json:"from"`
+
+	// To Revision UUID to compare to. Changes made in this revision are included
+	To string `form:"to" json:"to"`
+}
+
 // GetTokensEnvironmentParamsEnvironment defines parameters for GetTokensEnvironment.
 type GetTokensEnvironmentParamsEnvironment string
 
@@ -1855,6 +3336,9 @@ type PutUsersUserIdAttributesJSONBody struct {
 	ChosenName string `json:"chosen_name"`
 }
 
+// PostErasuresJSONRequestBody defines body for PostErasures for application/json ContentType.
+type PostErasuresJSONRequestBody = ErasureRequest
+
 // PostFeedbackJSONRequestBody defines body for PostFeedback for application/json ContentType.
 type PostFeedbackJSONRequestBody = Feedback
 
@@ -1885,33 +3369,87 @@ type PostStudiesJSONRequestBody = StudyRequest
 // PostStudiesAdminImportJSONRequestBody defines body for PostStudiesAdminImport for application/json ContentType.
 type PostStudiesAdminImportJSONRequestBody = StudyImport
 
+// PostStudiesAdminImportBulkMultipartRequestBody defines body for PostStudiesAdminImportBulk for multipart/form-data ContentType.
+type PostStudiesAdminImportBulkMultipartRequestBody = StudyBulkImport
+
 // PostStudiesAdminStudyIdAssetsImportJSONRequestBody defines body for PostStudiesAdminStudyIdAssetsImport for application/json ContentType.
 type PostStudiesAdminStudyIdAssetsImportJSONRequestBody = AssetImport
 
+// PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApproveJSONRequestBody defines body for PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove for application/json ContentType.
+type PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApproveJSONRequestBody = AssetDestructionReview
+
+// PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdRejectJSONRequestBody defines body for PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject for application/json ContentType.
+type PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdRejectJSONRequestBody = AssetDestructionReview
+
 // PostStudiesAdminStudyIdContractsImportJSONRequestBody defines body for PostStudiesAdminStudyIdContractsImport for application/json ContentType.
 type PostStudiesAdminStudyIdContractsImportJSONRequestBody = ContractImport
 
+// PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApproveJSONRequestBody defines body for PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove for application/json ContentType.
+type PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApproveJSONRequestBody = ContractAmendmentReview
+
+// PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdRejectJSONRequestBody defines body for PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject for application/json ContentType.
+type PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdRejectJSONRequestBody = ContractAmendmentReview
+
+// PostStudiesAdminStudyIdDpiaSignoffJSONRequestBody defines body for PostStudiesAdminStudyIdDpiaSignoff for application/json ContentType.
+type PostStudiesAdminStudyIdDpiaSignoffJSONRequestBody = DpiaSignoff
+
 // PostStudiesAdminStudyIdOwnerApproveJSONRequestBody defines body for PostStudiesAdminStudyIdOwnerApprove for application/json ContentType.
 type PostStudiesAdminStudyIdOwnerApproveJSONRequestBody = StudyOwnerUpdate
 
+// PostStudiesAdminStudyIdOwnerCancelJSONRequestBody defines body for PostStudiesAdminStudyIdOwnerCancel for application/json ContentType.
+type PostStudiesAdminStudyIdOwnerCancelJSONRequestBody = StudyOwnerChangeDecision
+
+// PostStudiesAdminStudyIdOwnerRejectJSONRequestBody defines body for PostStudiesAdminStudyIdOwnerReject for application/json ContentType.
+type PostStudiesAdminStudyIdOwnerRejectJSONRequestBody = StudyOwnerChangeDecision
+
 // PostStudiesAdminStudyIdOwnerRequestJSONRequestBody defines body for PostStudiesAdminStudyIdOwnerRequest for application/json ContentType.
 type PostStudiesAdminStudyIdOwnerRequestJSONRequestBody = StudyOwnerUpdate
 
 // PostStudiesAdminStudyIdReviewJSONRequestBody defines body for PostStudiesAdminStudyIdReview for application/json ContentType.
 type PostStudiesAdminStudyIdReviewJSONRequestBody = StudyReview
 
+// PostStudiesAdminStudyIdReviewThreadsJSONRequestBody defines body for PostStudiesAdminStudyIdReviewThreads for application/json ContentType.
+type PostStudiesAdminStudyIdReviewThreadsJSONRequestBody = StudyReviewThreadRequest
+
+// PostStudiesAdminStudyIdReviewThreadsReviewThreadIdCommentsJSONRequestBody defines body for PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments for application/json ContentType.
+type PostStudiesAdminStudyIdReviewThreadsReviewThreadIdCommentsJSONRequestBody = StudyReviewCommentRequest
+
+// PutStudiesAdminStudyIdReviewerJSONRequestBody defines body for PutStudiesAdminStudyIdReviewer for application/json ContentType.
+type PutStudiesAdminStudyIdReviewerJSONRequestBody = StudyReviewerUpdate
+
 // PutStudiesStudyIdJSONRequestBody defines body for PutStudiesStudyId for application/json ContentType.
 type PutStudiesStudyIdJSONRequestBody = StudyRequest
 
 // PostStudiesStudyIdAgreementsJSONRequestBody defines body for PostStudiesStudyIdAgreements for application/json ContentType.
 type PostStudiesStudyIdAgreementsJSONRequestBody = AgreementConfirmation
 
+// PostStudiesStudyIdApprovalsJSONRequestBody defines body for PostStudiesStudyIdApprovals for application/json ContentType.
+type PostStudiesStudyIdApprovalsJSONRequestBody = RegulatoryApprovalBase
+
+// PutStudiesStudyIdApprovalsApprovalIdJSONRequestBody defines body for PutStudiesStudyIdApprovalsApprovalId for application/json ContentType.
+type PutStudiesStudyIdApprovalsApprovalIdJSONRequestBody = RegulatoryApprovalBase
+
+// PostStudiesStudyIdApprovalsApprovalIdLetterMultipartRequestBody defines body for PostStudiesStudyIdApprovalsApprovalIdLetter for multipart/form-data ContentType.
+type PostStudiesStudyIdApprovalsApprovalIdLetterMultipartRequestBody = RegulatoryApprovalLetter
+
+// PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolveJSONRequestBody defines body for PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve for application/json ContentType.
+type PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolveJSONRequestBody = AssetExpiryResolution
+
 // PostStudiesStudyIdAssetsJSONRequestBody defines body for PostStudiesStudyIdAssets for application/json ContentType.
 type PostStudiesStudyIdAssetsJSONRequestBody = AssetBase
 
 // PutStudiesStudyIdAssetsAssetIdJSONRequestBody defines body for PutStudiesStudyIdAssetsAssetId for application/json ContentType.
 type PutStudiesStudyIdAssetsAssetIdJSONRequestBody = AssetBase
 
+// PostStudiesStudyIdAssetsAssetIdDestructionsMultipartRequestBody defines body for PostStudiesStudyIdAssetsAssetIdDestructions for multipart/form-data ContentType.
+type PostStudiesStudyIdAssetsAssetIdDestructionsMultipartRequestBody = AssetDestructionRequest
+
+// PostStudiesStudyIdAssetsAssetIdParentsJSONRequestBody defines body for PostStudiesStudyIdAssetsAssetIdParents for application/json ContentType.
+type PostStudiesStudyIdAssetsAssetIdParentsJSONRequestBody = AssetLinkRequest
+
+// PostStudiesStudyIdAssetsAssetIdTransfersJSONRequestBody defines body for PostStudiesStudyIdAssetsAssetIdTransfers for application/json ContentType.
+type PostStudiesStudyIdAssetsAssetIdTransfersJSONRequestBody = AssetTransferRequest
+
 // PostStudiesStudyIdContractsJSONRequestBody defines body for PostStudiesStudyIdContracts for application/json ContentType.
 type PostStudiesStudyIdContractsJSONRequestBody = ContractBase
 
@@ -1921,9 +3459,33 @@ type PutStudiesStudyIdContractsContractIdJSONRequestBody = ContractBase
 // PostStudiesStudyIdContractsContractIdObjectsMultipartRequestBody defines body for PostStudiesStudyIdContractsContractIdObjects for multipart/form-data ContentType.
 type PostStudiesStudyIdContractsContractIdObjectsMultipartRequestBody = ContractObject
 
+// PostStudiesStudyIdContractsContractIdVersionsJSONRequestBody defines body for PostStudiesStudyIdContractsContractIdVersions for application/json ContentType.
+type PostStudiesStudyIdContractsContractIdVersionsJSONRequestBody = ContractAmendmentRequest
+
+// PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjectsMultipartRequestBody defines body for PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects for multipart/form-data ContentType.
+type PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjectsMultipartRequestBody = ContractObject
+
+// PostStudiesStudyIdDocumentsMultipartRequestBody defines body for PostStudiesStudyIdDocuments for multipart/form-data ContentType.
+type PostStudiesStudyIdDocumentsMultipartRequestBody = StudyDocumentUpload
+
+// PostStudiesStudyIdDocumentsDocumentIdVersionsMultipartRequestBody defines body for PostStudiesStudyIdDocumentsDocumentIdVersions for multipart/form-data ContentType.
+type PostStudiesStudyIdDocumentsDocumentIdVersionsMultipartRequestBody = StudyDocumentVersionUpload
+
+// PutStudiesStudyIdDpiaJSONRequestBody defines body for PutStudiesStudyIdDpia for application/json ContentType.
+type PutStudiesStudyIdDpiaJSONRequestBody = DpiaUpdate
+
+// PostStudiesStudyIdOwnerCancelJSONRequestBody defines body for PostStudiesStudyIdOwnerCancel for application/json ContentType.
+type PostStudiesStudyIdOwnerCancelJSONRequestBody = StudyOwnerChangeDecision
+
 // PostStudiesStudyIdOwnerRequestJSONRequestBody defines body for PostStudiesStudyIdOwnerRequest for application/json ContentType.
 type PostStudiesStudyIdOwnerRequestJSONRequestBody = StudyOwnerUpdate
 
+// PostStudiesStudyIdReviewThreadsReviewThreadIdCommentsJSONRequestBody defines body for PostStudiesStudyIdReviewThreadsReviewThreadIdComments for application/json ContentType.
+type PostStudiesStudyIdReviewThreadsReviewThreadIdCommentsJSONRequestBody = StudyReviewCommentRequest
+
+// PostStudiesStudyIdSignoffJSONRequestBody defines body for PostStudiesStudyIdSignoff for application/json ContentType.
+type PostStudiesStudyIdSignoffJSONRequestBody = StudySignoffRequest
+
 // PostTokensEnvironmentJSONRequestBody defines body for PostTokensEnvironment for application/json ContentType.
 type PostTokensEnvironmentJSONRequestBody = TokenRequest
 
@@ -1948,6 +3510,21 @@ type ServerInterface interface {
 	// (GET /environments)
 	GetEnvironments(c *gin.Context)
 
+	// (GET /erasures)
+	GetErasures(c *gin.Context)
+
+	// (POST /erasures)
+	PostErasures(c *gin.Context)
+
+	// (GET /erasures/{erasureId})
+	GetErasuresErasureId(c *gin.Context, erasureId ErasureIdParam)
+
+	// (POST /erasures/{erasureId}/approve)
+	PostErasuresErasureIdApprove(c *gin.Context, erasureId ErasureIdParam)
+
+	// (POST /erasures/{erasureId}/reject)
+	PostErasuresErasureIdReject(c *gin.Context, erasureId ErasureIdParam)
+
 	// (POST /feedback)
 	PostFeedback(c *gin.Context)
 
@@ -2011,30 +3588,108 @@ type ServerInterface interface {
 	// (PATCH /projects/tre/{projectId}/pending)
 	PatchProjectsTreProjectIdPending(c *gin.Context, projectId ProjectIdParam)
 
+	// (GET /search)
+	GetSearch(c *gin.Context, params GetSearchParams)
+
 	// (GET /studies)
 	GetStudies(c *gin.Context, params GetStudiesParams)
 
 	// (POST /studies)
 	PostStudies(c *gin.Context)
 
+	// (GET /studies/admin/asset-destructions)
+	GetStudiesAdminAssetDestructions(c *gin.Context)
+
+	// (GET /studies/admin/asset-locations)
+	GetStudiesAdminAssetLocations(c *gin.Context, params GetStudiesAdminAssetLocationsParams)
+
+	// (GET /studies/admin/asset-register)
+	GetStudiesAdminAssetRegister(c *gin.Context, params GetStudiesAdminAssetRegisterParams)
+
+	// (GET /studies/admin/asset-register/exports)
+	GetStudiesAdminAssetRegisterExports(c *gin.Context)
+
+	// (GET /studies/admin/asset-register/exports/{exportId}/file)
+	GetStudiesAdminAssetRegisterExportsExportIdFile(c *gin.Context, exportId ExportIdParam)
+
+	// (GET /studies/admin/asset-transfers)
+	GetStudiesAdminAssetTransfers(c *gin.Context)
+
+	// (POST /studies/admin/asset-transfers/{transferId}/approve)
+	PostStudiesAdminAssetTransfersTransferIdApprove(c *gin.Context, transferId TransferIdParam)
+
+	// (POST /studies/admin/asset-transfers/{transferId}/reject)
+	PostStudiesAdminAssetTransfersTransferIdReject(c *gin.Context, transferId TransferIdParam)
+
 	// (POST /studies/admin/import)
 	PostStudiesAdminImport(c *gin.Context)
 
+	// (POST /studies/admin/import/bulk)
+	PostStudiesAdminImportBulk(c *gin.Context, params PostStudiesAdminImportBulkParams)
+
+	// (GET /studies/admin/owner-changes)
+	GetStudiesAdminOwnerChanges(c *gin.Context)
+
+	// (POST /studies/admin/{studyId}/archive)
+	PostStudiesAdminStudyIdArchive(c *gin.Context, studyId StudyIdParam)
+
 	// (POST /studies/admin/{studyId}/assets/import)
 	PostStudiesAdminStudyIdAssetsImport(c *gin.Context, studyId StudyIdParam)
 
+	// (POST /studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/approve)
+	PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam, destructionId DestructionIdParam)
+
+	// (POST /studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/reject)
+	PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam, destructionId DestructionIdParam)
+
 	// (POST /studies/admin/{studyId}/contracts/import)
 	PostStudiesAdminStudyIdContractsImport(c *gin.Context, studyId StudyIdParam)
 
+	// (GET /studies/admin/{studyId}/contracts/{contractId}/downloads)
+	GetStudiesAdminStudyIdContractsContractIdDownloads(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam)
+
+	// (POST /studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/approve)
+	PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam, contractVersionId ContractVersionIdParam)
+
+	// (POST /studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/reject)
+	PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam, contractVersionId ContractVersionIdParam)
+
+	// (POST /studies/admin/{studyId}/dpia/signoff)
+	PostStudiesAdminStudyIdDpiaSignoff(c *gin.Context, studyId StudyIdParam)
+
 	// (POST /studies/admin/{studyId}/owner-approve)
 	PostStudiesAdminStudyIdOwnerApprove(c *gin.Context, studyId StudyIdParam)
 
+	// (POST /studies/admin/{studyId}/owner-cancel)
+	PostStudiesAdminStudyIdOwnerCancel(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/admin/{studyId}/owner-reject)
+	PostStudiesAdminStudyIdOwnerReject(c *gin.Context, studyId StudyIdParam)
+
 	// (POST /studies/admin/{studyId}/owner-request)
 	PostStudiesAdminStudyIdOwnerRequest(c *gin.Context, studyId StudyIdParam)
 
 	// (POST /studies/admin/{studyId}/review)
 	PostStudiesAdminStudyIdReview(c *gin.Context, studyId StudyIdParam)
 
+	// (POST /studies/admin/{studyId}/review-threads)
+	PostStudiesAdminStudyIdReviewThreads(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/admin/{studyId}/review-threads/{reviewThreadId}/comments)
+	PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments(c *gin.Context, studyId StudyIdParam, reviewThreadId ReviewThreadIdParam)
+
+	// (POST /studies/admin/{studyId}/review-threads/{reviewThreadId}/resolve)
+	PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve(c *gin.Context, studyId StudyIdParam, reviewThreadId ReviewThreadIdParam)
+
+	// (DELETE /studies/admin/{studyId}/reviewer)
+	DeleteStudiesAdminStudyIdReviewer(c *gin.Context, studyId StudyIdParam)
+
+	// (PUT /studies/admin/{studyId}/reviewer)
+	PutStudiesAdminStudyIdReviewer(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/admin/{studyId}/reviewer/claim)
+	PostStudiesAdminStudyIdReviewerClaim(c *gin.Context, studyId StudyIdParam)
+
 	// (GET /studies/{studyId})
 	GetStudiesStudyId(c *gin.Context, studyId StudyIdParam)
 
@@ -2047,6 +3702,30 @@ type ServerInterface interface {
 	// (POST /studies/{studyId}/agreements)
 	PostStudiesStudyIdAgreements(c *gin.Context, studyId StudyIdParam)
 
+	// (GET /studies/{studyId}/approvals)
+	GetStudiesStudyIdApprovals(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/approvals)
+	PostStudiesStudyIdApprovals(c *gin.Context, studyId StudyIdParam)
+
+	// (DELETE /studies/{studyId}/approvals/{approvalId})
+	DeleteStudiesStudyIdApprovalsApprovalId(c *gin.Context, studyId StudyIdParam, approvalId ApprovalIdParam)
+
+	// (PUT /studies/{studyId}/approvals/{approvalId})
+	PutStudiesStudyIdApprovalsApprovalId(c *gin.Context, studyId StudyIdParam, approvalId ApprovalIdParam)
+
+	// (GET /studies/{studyId}/approvals/{approvalId}/letter)
+	GetStudiesStudyIdApprovalsApprovalIdLetter(c *gin.Context, studyId StudyIdParam, approvalId ApprovalIdParam)
+
+	// (POST /studies/{studyId}/approvals/{approvalId}/letter)
+	PostStudiesStudyIdApprovalsApprovalIdLetter(c *gin.Context, studyId StudyIdParam, approvalId ApprovalIdParam)
+
+	// (GET /studies/{studyId}/asset-expiry-reviews)
+	GetStudiesStudyIdAssetExpiryReviews(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/asset-expiry-reviews/{expiryReviewId}/resolve)
+	PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve(c *gin.Context, studyId StudyIdParam, expiryReviewId ExpiryReviewIdParam)
+
 	// (GET /studies/{studyId}/assets)
 	GetStudiesStudyIdAssets(c *gin.Context, studyId StudyIdParam)
 
@@ -2065,6 +3744,36 @@ type ServerInterface interface {
 	// (GET /studies/{studyId}/assets/{assetId}/contracts)
 	GetStudiesStudyIdAssetsAssetIdContracts(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
 
+	// (GET /studies/{studyId}/assets/{assetId}/destructions)
+	GetStudiesStudyIdAssetsAssetIdDestructions(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
+
+	// (POST /studies/{studyId}/assets/{assetId}/destructions)
+	PostStudiesStudyIdAssetsAssetIdDestructions(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
+
+	// (GET /studies/{studyId}/assets/{assetId}/destructions/{destructionId}/certificate)
+	GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam, destructionId DestructionIdParam)
+
+	// (POST /studies/{studyId}/assets/{assetId}/parents)
+	PostStudiesStudyIdAssetsAssetIdParents(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
+
+	// (DELETE /studies/{studyId}/assets/{assetId}/parents/{parentAssetId})
+	DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam, parentAssetId ParentAssetIdParam)
+
+	// (GET /studies/{studyId}/assets/{assetId}/transfers)
+	GetStudiesStudyIdAssetsAssetIdTransfers(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
+
+	// (POST /studies/{studyId}/assets/{assetId}/transfers)
+	PostStudiesStudyIdAssetsAssetIdTransfers(c *gin.Context, studyId StudyIdParam, assetId AssetIdParam)
+
+	// (GET /studies/{studyId}/closure)
+	GetStudiesStudyIdClosure(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/closure)
+	PostStudiesStudyIdClosure(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/closure/complete)
+	PostStudiesStudyIdClosureComplete(c *gin.Context, studyId StudyIdParam)
+
 	// (GET /studies/{studyId}/contracts)
 	GetStudiesStudyIdContracts(c *gin.Context, studyId StudyIdParam)
 
@@ -2089,15 +3798,81 @@ type ServerInterface interface {
 	// (GET /studies/{studyId}/contracts/{contractId}/objects/{contractObjectId})
 	GetStudiesStudyIdContractsContractIdObjectsContractObjectId(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam, contractObjectId ContractObjectIdParam)
 
+	// (POST /studies/{studyId}/contracts/{contractId}/renew)
+	PostStudiesStudyIdContractsContractIdRenew(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam)
+
+	// (GET /studies/{studyId}/contracts/{contractId}/versions)
+	GetStudiesStudyIdContractsContractIdVersions(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam)
+
+	// (POST /studies/{studyId}/contracts/{contractId}/versions)
+	PostStudiesStudyIdContractsContractIdVersions(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam)
+
+	// (POST /studies/{studyId}/contracts/{contractId}/versions/{contractVersionId}/objects)
+	PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects(c *gin.Context, studyId StudyIdParam, contractId ContractIdParam, contractVersionId ContractVersionIdParam)
+
+	// (GET /studies/{studyId}/documents)
+	GetStudiesStudyIdDocuments(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/documents)
+	PostStudiesStudyIdDocuments(c *gin.Context, studyId StudyIdParam)
+
+	// (DELETE /studies/{studyId}/documents/{documentId})
+	DeleteStudiesStudyIdDocumentsDocumentId(c *gin.Context, studyId StudyIdParam, documentId DocumentIdParam)
+
+	// (POST /studies/{studyId}/documents/{documentId}/versions)
+	PostStudiesStudyIdDocumentsDocumentIdVersions(c *gin.Context, studyId StudyIdParam, documentId DocumentIdParam)
+
+	// (GET /studies/{studyId}/documents/{documentId}/versions/{documentVersionId})
+	GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId(c *gin.Context, studyId StudyIdParam, documentId DocumentIdParam, documentVersionId DocumentVersionIdParam)
+
+	// (GET /studies/{studyId}/dpia)
+	GetStudiesStudyIdDpia(c *gin.Context, studyId StudyIdParam)
+
+	// (PUT /studies/{studyId}/dpia)
+	PutStudiesStudyIdDpia(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/dpia/submit)
+	PostStudiesStudyIdDpiaSubmit(c *gin.Context, studyId StudyIdParam)
+
+	// (GET /studies/{studyId}/lineage)
+	GetStudiesStudyIdLineage(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/owner-cancel)
+	PostStudiesStudyIdOwnerCancel(c *gin.Context, studyId StudyIdParam)
+
 	// (POST /studies/{studyId}/owner-request)
 	PostStudiesStudyIdOwnerRequest(c *gin.Context, studyId StudyIdParam)
 
 	// (PATCH /studies/{studyId}/pending)
 	PatchStudiesStudyIdPending(c *gin.Context, studyId StudyIdParam)
 
+	// (GET /studies/{studyId}/review-threads)
+	GetStudiesStudyIdReviewThreads(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/review-threads/{reviewThreadId}/comments)
+	PostStudiesStudyIdReviewThreadsReviewThreadIdComments(c *gin.Context, studyId StudyIdParam, reviewThreadId ReviewThreadIdParam)
+
+	// (GET /studies/{studyId}/revisions)
+	GetStudiesStudyIdRevisions(c *gin.Context, studyId StudyIdParam)
+
+	// (GET /studies/{studyId}/revisions/diff)
+	GetStudiesStudyIdRevisionsDiff(c *gin.Context, studyId StudyIdParam, params GetStudiesStudyIdRevisionsDiffParams)
+
 	// (POST /studies/{studyId}/signoff)
 	PostStudiesStudyIdSignoff(c *gin.Context, studyId StudyIdParam)
 
+	// (GET /studies/{studyId}/signoffs)
+	GetStudiesStudyIdSignoffs(c *gin.Context, studyId StudyIdParam)
+
+	// (GET /studies/{studyId}/transfers)
+	GetStudiesStudyIdTransfers(c *gin.Context, studyId StudyIdParam)
+
+	// (POST /studies/{studyId}/transfers/{transferId}/approve)
+	PostStudiesStudyIdTransfersTransferIdApprove(c *gin.Context, studyId StudyIdParam, transferId TransferIdParam)
+
+	// (POST /studies/{studyId}/transfers/{transferId}/reject)
+	PostStudiesStudyIdTransfersTransferIdReject(c *gin.Context, studyId StudyIdParam, transferId TransferIdParam)
+
 	// (GET /tokens/{environment})
 	GetTokensEnvironment(c *gin.Context, environment GetTokensEnvironmentParamsEnvironment)
 
@@ -2192,8 +3967,8 @@ func (siw *ServerInterfaceWrapper) GetEnvironments(c *gin.Context) {
 	siw.Handler.GetEnvironments(c)
 }
 
-// PostFeedback operation middleware
-func (siw *ServerInterfaceWrapper) PostFeedback(c *gin.Context) {
+// GetErasures operation middleware
+func (siw *ServerInterfaceWrapper) GetErasures(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2202,11 +3977,11 @@ func (siw *ServerInterfaceWrapper) PostFeedback(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostFeedback(c)
+	siw.Handler.GetErasures(c)
 }
 
-// GetLogout operation middleware
-func (siw *ServerInterfaceWrapper) GetLogout(c *gin.Context) {
+// PostErasures operation middleware
+func (siw *ServerInterfaceWrapper) PostErasures(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2215,18 +3990,119 @@ func (siw *ServerInterfaceWrapper) GetLogout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLogout(c)
+	siw.Handler.PostErasures(c)
 }
 
-// GetNotifications operation middleware
-func (siw *ServerInterfaceWrapper) GetNotifications(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// GetErasuresErasureId operation middleware
+func (siw *ServerInterfaceWrapper) GetErasuresErasureId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "erasureId" -------------
+	var erasureId ErasureIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "erasureId", c.Param("erasureId"), &erasureId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter erasureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetErasuresErasureId(c, erasureId)
+}
+
+// PostErasuresErasureIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostErasuresErasureIdApprove(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "erasureId" -------------
+	var erasureId ErasureIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "erasureId", c.Param("erasureId"), &erasureId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter erasureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostErasuresErasureIdApprove(c, erasureId)
+}
+
+// PostErasuresErasureIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostErasuresErasureIdReject(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "erasureId" -------------
+	var erasureId ErasureIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "erasureId", c.Param("erasureId"), &erasureId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter erasureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostErasuresErasureIdReject(c, erasureId)
+}
+
+// PostFeedback operation middleware
+func (siw *ServerInterfaceWrapper) PostFeedback(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostFeedback(c)
+}
+
+// GetLogout operation middleware
+func (siw *ServerInterfaceWrapper) GetLogout(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLogout(c)
+}
+
+// GetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetNotifications(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
 	siw.Handler.GetNotifications(c)
 }
@@ -2549,6 +4425,73 @@ func (siw *ServerInterfaceWrapper) PatchProjectsTreProjectIdPending(c *gin.Conte
 	siw.Handler.PatchProjectsTreProjectIdPending(c, projectId)
 }
 
+// GetSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetSearch(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSearchParams
+
+	// ------------- Required query parameter "query" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "query", c.Request.URL.Query(), &params.Query, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entity_type", c.Request.URL.Query(), &params.EntityType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entity_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", c.Request.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tier" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tier", c.Request.URL.Query(), &params.Tier, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", c.Request.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSearch(c, params)
+}
+
 // GetStudies operation middleware
 func (siw *ServerInterfaceWrapper) GetStudies(c *gin.Context) {
 
@@ -2606,6 +4549,22 @@ func (siw *ServerInterfaceWrapper) GetStudies(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "reviewer" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "reviewer", c.Request.URL.Query(), &params.Reviewer, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewer: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "risk_rating" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "risk_rating", c.Request.URL.Query(), &params.RiskRating, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter risk_rating: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
@@ -2645,8 +4604,8 @@ func (siw *ServerInterfaceWrapper) PostStudies(c *gin.Context) {
 	siw.Handler.PostStudies(c)
 }
 
-// PostStudiesAdminImport operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminImport(c *gin.Context) {
+// GetStudiesAdminAssetDestructions operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetDestructions(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2655,21 +4614,23 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminImport(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostStudiesAdminImport(c)
+	siw.Handler.GetStudiesAdminAssetDestructions(c)
 }
 
-// PostStudiesAdminStudyIdAssetsImport operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdAssetsImport(c *gin.Context) {
+// GetStudiesAdminAssetLocations operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetLocations(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "studyId" -------------
-	var studyId StudyIdParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStudiesAdminAssetLocationsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "kind", c.Request.URL.Query(), &params.Kind, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2680,21 +4641,1960 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdAssetsImport(c *gin.Co
 		}
 	}
 
-	siw.Handler.PostStudiesAdminStudyIdAssetsImport(c, studyId)
+	siw.Handler.GetStudiesAdminAssetLocations(c, params)
 }
 
-// PostStudiesAdminStudyIdContractsImport operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdContractsImport(c *gin.Context) {
+// GetStudiesAdminAssetRegister operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetRegister(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "studyId" -------------
-	var studyId StudyIdParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStudiesAdminAssetRegisterParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", c.Request.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", c.Request.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tier" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tier", c.Request.URL.Query(), &params.Tier, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminAssetRegister(c, params)
+}
+
+// GetStudiesAdminAssetRegisterExports operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetRegisterExports(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminAssetRegisterExports(c)
+}
+
+// GetStudiesAdminAssetRegisterExportsExportIdFile operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetRegisterExportsExportIdFile(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "exportId" -------------
+	var exportId ExportIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", c.Param("exportId"), &exportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exportId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminAssetRegisterExportsExportIdFile(c, exportId)
+}
+
+// GetStudiesAdminAssetTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminAssetTransfers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminAssetTransfers(c)
+}
+
+// PostStudiesAdminAssetTransfersTransferIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminAssetTransfersTransferIdApprove(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminAssetTransfersTransferIdApprove(c, transferId)
+}
+
+// PostStudiesAdminAssetTransfersTransferIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminAssetTransfersTransferIdReject(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminAssetTransfersTransferIdReject(c, transferId)
+}
+
+// PostStudiesAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminImport(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminImport(c)
+}
+
+// PostStudiesAdminImportBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminImportBulk(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostStudiesAdminImportBulkParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminImportBulk(c, params)
+}
+
+// GetStudiesAdminOwnerChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminOwnerChanges(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminOwnerChanges(c)
+}
+
+// PostStudiesAdminStudyIdArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdArchive(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdArchive(c, studyId)
+}
+
+// PostStudiesAdminStudyIdAssetsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdAssetsImport(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdAssetsImport(c, studyId)
+}
+
+// PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "destructionId" -------------
+	var destructionId DestructionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "destructionId", c.Param("destructionId"), &destructionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter destructionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove(c, studyId, assetId, destructionId)
+}
+
+// PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "destructionId" -------------
+	var destructionId DestructionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "destructionId", c.Param("destructionId"), &destructionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter destructionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject(c, studyId, assetId, destructionId)
+}
+
+// PostStudiesAdminStudyIdContractsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdContractsImport(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdContractsImport(c, studyId)
+}
+
+// GetStudiesAdminStudyIdContractsContractIdDownloads operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesAdminStudyIdContractsContractIdDownloads(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesAdminStudyIdContractsContractIdDownloads(c, studyId, contractId)
+}
+
+// PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractVersionId" -------------
+	var contractVersionId ContractVersionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractVersionId", c.Param("contractVersionId"), &contractVersionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractVersionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove(c, studyId, contractId, contractVersionId)
+}
+
+// PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractVersionId" -------------
+	var contractVersionId ContractVersionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractVersionId", c.Param("contractVersionId"), &contractVersionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractVersionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject(c, studyId, contractId, contractVersionId)
+}
+
+// PostStudiesAdminStudyIdDpiaSignoff operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdDpiaSignoff(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdDpiaSignoff(c, studyId)
+}
+
+// PostStudiesAdminStudyIdOwnerApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerApprove(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdOwnerApprove(c, studyId)
+}
+
+// PostStudiesAdminStudyIdOwnerCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerCancel(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdOwnerCancel(c, studyId)
+}
+
+// PostStudiesAdminStudyIdOwnerReject operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerReject(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdOwnerReject(c, studyId)
+}
+
+// PostStudiesAdminStudyIdOwnerRequest operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerRequest(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdOwnerRequest(c, studyId)
+}
+
+// PostStudiesAdminStudyIdReview operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReview(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdReview(c, studyId)
+}
+
+// PostStudiesAdminStudyIdReviewThreads operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReviewThreads(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdReviewThreads(c, studyId)
+}
+
+// PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "reviewThreadId" -------------
+	var reviewThreadId ReviewThreadIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewThreadId", c.Param("reviewThreadId"), &reviewThreadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewThreadId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments(c, studyId, reviewThreadId)
+}
+
+// PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "reviewThreadId" -------------
+	var reviewThreadId ReviewThreadIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewThreadId", c.Param("reviewThreadId"), &reviewThreadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewThreadId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve(c, studyId, reviewThreadId)
+}
+
+// DeleteStudiesAdminStudyIdReviewer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesAdminStudyIdReviewer(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesAdminStudyIdReviewer(c, studyId)
+}
+
+// PutStudiesAdminStudyIdReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesAdminStudyIdReviewer(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutStudiesAdminStudyIdReviewer(c, studyId)
+}
+
+// PostStudiesAdminStudyIdReviewerClaim operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReviewerClaim(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesAdminStudyIdReviewerClaim(c, studyId)
+}
+
+// GetStudiesStudyId operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyId(c, studyId)
+}
+
+// PutStudiesStudyId operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesStudyId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutStudiesStudyId(c, studyId)
+}
+
+// GetStudiesStudyIdAgreements operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAgreements(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAgreements(c, studyId)
+}
+
+// PostStudiesStudyIdAgreements operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAgreements(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAgreements(c, studyId)
+}
+
+// GetStudiesStudyIdApprovals operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdApprovals(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdApprovals(c, studyId)
+}
+
+// PostStudiesStudyIdApprovals operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdApprovals(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdApprovals(c, studyId)
+}
+
+// DeleteStudiesStudyIdApprovalsApprovalId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdApprovalsApprovalId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", c.Param("approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter approvalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesStudyIdApprovalsApprovalId(c, studyId, approvalId)
+}
+
+// PutStudiesStudyIdApprovalsApprovalId operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesStudyIdApprovalsApprovalId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", c.Param("approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter approvalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutStudiesStudyIdApprovalsApprovalId(c, studyId, approvalId)
+}
+
+// GetStudiesStudyIdApprovalsApprovalIdLetter operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdApprovalsApprovalIdLetter(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", c.Param("approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter approvalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdApprovalsApprovalIdLetter(c, studyId, approvalId)
+}
+
+// PostStudiesStudyIdApprovalsApprovalIdLetter operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdApprovalsApprovalIdLetter(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", c.Param("approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter approvalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdApprovalsApprovalIdLetter(c, studyId, approvalId)
+}
+
+// GetStudiesStudyIdAssetExpiryReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetExpiryReviews(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetExpiryReviews(c, studyId)
+}
+
+// PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "expiryReviewId" -------------
+	var expiryReviewId ExpiryReviewIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "expiryReviewId", c.Param("expiryReviewId"), &expiryReviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expiryReviewId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve(c, studyId, expiryReviewId)
+}
+
+// GetStudiesStudyIdAssets operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssets(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssets(c, studyId)
+}
+
+// PostStudiesStudyIdAssets operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssets(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAssets(c, studyId)
+}
+
+// DeleteStudiesStudyIdAssetsAssetId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdAssetsAssetId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+}
+
+// GetStudiesStudyIdAssetsAssetId operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+}
+
+// PutStudiesStudyIdAssetsAssetId operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesStudyIdAssetsAssetId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+}
+
+// GetStudiesStudyIdAssetsAssetIdContracts operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdContracts(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetsAssetIdContracts(c, studyId, assetId)
+}
+
+// GetStudiesStudyIdAssetsAssetIdDestructions operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdDestructions(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetsAssetIdDestructions(c, studyId, assetId)
+}
+
+// PostStudiesStudyIdAssetsAssetIdDestructions operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssetsAssetIdDestructions(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAssetsAssetIdDestructions(c, studyId, assetId)
+}
+
+// GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "destructionId" -------------
+	var destructionId DestructionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "destructionId", c.Param("destructionId"), &destructionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter destructionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate(c, studyId, assetId, destructionId)
+}
+
+// PostStudiesStudyIdAssetsAssetIdParents operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssetsAssetIdParents(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAssetsAssetIdParents(c, studyId, assetId)
+}
+
+// DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "parentAssetId" -------------
+	var parentAssetId ParentAssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "parentAssetId", c.Param("parentAssetId"), &parentAssetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter parentAssetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId(c, studyId, assetId, parentAssetId)
+}
+
+// GetStudiesStudyIdAssetsAssetIdTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdTransfers(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdAssetsAssetIdTransfers(c, studyId, assetId)
+}
+
+// PostStudiesStudyIdAssetsAssetIdTransfers operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssetsAssetIdTransfers(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdAssetsAssetIdTransfers(c, studyId, assetId)
+}
+
+// GetStudiesStudyIdClosure operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdClosure(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdClosure(c, studyId)
+}
+
+// PostStudiesStudyIdClosure operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdClosure(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdClosure(c, studyId)
+}
+
+// PostStudiesStudyIdClosureComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdClosureComplete(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdClosureComplete(c, studyId)
+}
+
+// GetStudiesStudyIdContracts operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContracts(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdContracts(c, studyId)
+}
+
+// PostStudiesStudyIdContracts operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContracts(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdContracts(c, studyId)
+}
+
+// DeleteStudiesStudyIdContractsContractId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesStudyIdContractsContractId(c, studyId, contractId)
+}
+
+// GetStudiesStudyIdContractsContractId operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdContractsContractId(c, studyId, contractId)
+}
+
+// PutStudiesStudyIdContractsContractId operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesStudyIdContractsContractId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutStudiesStudyIdContractsContractId(c, studyId, contractId)
+}
+
+// PostStudiesStudyIdContractsContractIdObjects operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdObjects(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStudiesStudyIdContractsContractIdObjects(c, studyId, contractId)
+}
+
+// DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractObjectId" -------------
+	var contractObjectId ContractObjectIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractObjectId", c.Param("contractObjectId"), &contractObjectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractObjectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId(c, studyId, contractId, contractObjectId)
+}
+
+// GetStudiesStudyIdContractsContractIdObjectsContractObjectId operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractIdObjectsContractObjectId(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractObjectId" -------------
+	var contractObjectId ContractObjectIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractObjectId", c.Param("contractObjectId"), &contractObjectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractObjectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStudiesStudyIdContractsContractIdObjectsContractObjectId(c, studyId, contractId, contractObjectId)
+}
+
+// PostStudiesStudyIdContractsContractIdRenew operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdRenew(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "studyId" -------------
+	var studyId StudyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter studyId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2705,11 +6605,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdContractsImport(c *gin
 		}
 	}
 
-	siw.Handler.PostStudiesAdminStudyIdContractsImport(c, studyId)
+	siw.Handler.PostStudiesStudyIdContractsContractIdRenew(c, studyId, contractId)
 }
 
-// PostStudiesAdminStudyIdOwnerApprove operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerApprove(c *gin.Context) {
+// GetStudiesStudyIdContractsContractIdVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractIdVersions(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2723,6 +6623,15 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerApprove(c *gin.Co
 		return
 	}
 
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2730,11 +6639,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerApprove(c *gin.Co
 		}
 	}
 
-	siw.Handler.PostStudiesAdminStudyIdOwnerApprove(c, studyId)
+	siw.Handler.GetStudiesStudyIdContractsContractIdVersions(c, studyId, contractId)
 }
 
-// PostStudiesAdminStudyIdOwnerRequest operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerRequest(c *gin.Context) {
+// PostStudiesStudyIdContractsContractIdVersions operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdVersions(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2748,6 +6657,15 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerRequest(c *gin.Co
 		return
 	}
 
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2755,11 +6673,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdOwnerRequest(c *gin.Co
 		}
 	}
 
-	siw.Handler.PostStudiesAdminStudyIdOwnerRequest(c, studyId)
+	siw.Handler.PostStudiesStudyIdContractsContractIdVersions(c, studyId, contractId)
 }
 
-// PostStudiesAdminStudyIdReview operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReview(c *gin.Context) {
+// PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2773,6 +6691,24 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReview(c *gin.Context)
 		return
 	}
 
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contractVersionId" -------------
+	var contractVersionId ContractVersionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractVersionId", c.Param("contractVersionId"), &contractVersionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractVersionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2780,11 +6716,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesAdminStudyIdReview(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostStudiesAdminStudyIdReview(c, studyId)
+	siw.Handler.PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects(c, studyId, contractId, contractVersionId)
 }
 
-// GetStudiesStudyId operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyId(c *gin.Context) {
+// GetStudiesStudyIdDocuments operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdDocuments(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2805,17 +6741,17 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStudiesStudyId(c, studyId)
+	siw.Handler.GetStudiesStudyIdDocuments(c, studyId)
 }
 
-// PutStudiesStudyId operation middleware
-func (siw *ServerInterfaceWrapper) PutStudiesStudyId(c *gin.Context) {
+// PostStudiesStudyIdDocuments operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdDocuments(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "studyId" -------------
-	var studyId string
+	var studyId StudyIdParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "studyId", c.Param("studyId"), &studyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
@@ -2830,11 +6766,11 @@ func (siw *ServerInterfaceWrapper) PutStudiesStudyId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutStudiesStudyId(c, studyId)
+	siw.Handler.PostStudiesStudyIdDocuments(c, studyId)
 }
 
-// GetStudiesStudyIdAgreements operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAgreements(c *gin.Context) {
+// DeleteStudiesStudyIdDocumentsDocumentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdDocumentsDocumentId(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2848,6 +6784,15 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAgreements(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "documentId" -------------
+	var documentId DocumentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", c.Param("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2855,11 +6800,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAgreements(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdAgreements(c, studyId)
+	siw.Handler.DeleteStudiesStudyIdDocumentsDocumentId(c, studyId, documentId)
 }
 
-// PostStudiesStudyIdAgreements operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAgreements(c *gin.Context) {
+// PostStudiesStudyIdDocumentsDocumentIdVersions operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdDocumentsDocumentIdVersions(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2873,6 +6818,15 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAgreements(c *gin.Context)
 		return
 	}
 
+	// ------------- Path parameter "documentId" -------------
+	var documentId DocumentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", c.Param("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2880,11 +6834,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAgreements(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdAgreements(c, studyId)
+	siw.Handler.PostStudiesStudyIdDocumentsDocumentIdVersions(c, studyId, documentId)
 }
 
-// GetStudiesStudyIdAssets operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssets(c *gin.Context) {
+// GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2898,6 +6852,24 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssets(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "documentId" -------------
+	var documentId DocumentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", c.Param("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "documentVersionId" -------------
+	var documentVersionId DocumentVersionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentVersionId", c.Param("documentVersionId"), &documentVersionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentVersionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2905,11 +6877,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssets(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdAssets(c, studyId)
+	siw.Handler.GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId(c, studyId, documentId, documentVersionId)
 }
 
-// PostStudiesStudyIdAssets operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssets(c *gin.Context) {
+// GetStudiesStudyIdDpia operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdDpia(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2930,11 +6902,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdAssets(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdAssets(c, studyId)
+	siw.Handler.GetStudiesStudyIdDpia(c, studyId)
 }
 
-// DeleteStudiesStudyIdAssetsAssetId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdAssetsAssetId(c *gin.Context) {
+// PutStudiesStudyIdDpia operation middleware
+func (siw *ServerInterfaceWrapper) PutStudiesStudyIdDpia(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2948,15 +6920,6 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdAssetsAssetId(c *gin.Cont
 		return
 	}
 
-	// ------------- Path parameter "assetId" -------------
-	var assetId AssetIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2964,11 +6927,11 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdAssetsAssetId(c *gin.Cont
 		}
 	}
 
-	siw.Handler.DeleteStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+	siw.Handler.PutStudiesStudyIdDpia(c, studyId)
 }
 
-// GetStudiesStudyIdAssetsAssetId operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetId(c *gin.Context) {
+// PostStudiesStudyIdDpiaSubmit operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdDpiaSubmit(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2982,15 +6945,6 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetId(c *gin.Context
 		return
 	}
 
-	// ------------- Path parameter "assetId" -------------
-	var assetId AssetIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2998,11 +6952,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetId(c *gin.Context
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+	siw.Handler.PostStudiesStudyIdDpiaSubmit(c, studyId)
 }
 
-// PutStudiesStudyIdAssetsAssetId operation middleware
-func (siw *ServerInterfaceWrapper) PutStudiesStudyIdAssetsAssetId(c *gin.Context) {
+// GetStudiesStudyIdLineage operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdLineage(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3016,15 +6970,6 @@ func (siw *ServerInterfaceWrapper) PutStudiesStudyIdAssetsAssetId(c *gin.Context
 		return
 	}
 
-	// ------------- Path parameter "assetId" -------------
-	var assetId AssetIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3032,11 +6977,11 @@ func (siw *ServerInterfaceWrapper) PutStudiesStudyIdAssetsAssetId(c *gin.Context
 		}
 	}
 
-	siw.Handler.PutStudiesStudyIdAssetsAssetId(c, studyId, assetId)
+	siw.Handler.GetStudiesStudyIdLineage(c, studyId)
 }
 
-// GetStudiesStudyIdAssetsAssetIdContracts operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdContracts(c *gin.Context) {
+// PostStudiesStudyIdOwnerCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdOwnerCancel(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3050,15 +6995,6 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdContracts(c *gi
 		return
 	}
 
-	// ------------- Path parameter "assetId" -------------
-	var assetId AssetIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "assetId", c.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assetId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3066,11 +7002,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdAssetsAssetIdContracts(c *gi
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdAssetsAssetIdContracts(c, studyId, assetId)
+	siw.Handler.PostStudiesStudyIdOwnerCancel(c, studyId)
 }
 
-// GetStudiesStudyIdContracts operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContracts(c *gin.Context) {
+// PostStudiesStudyIdOwnerRequest operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdOwnerRequest(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3091,11 +7027,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContracts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdContracts(c, studyId)
+	siw.Handler.PostStudiesStudyIdOwnerRequest(c, studyId)
 }
 
-// PostStudiesStudyIdContracts operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContracts(c *gin.Context) {
+// PatchStudiesStudyIdPending operation middleware
+func (siw *ServerInterfaceWrapper) PatchStudiesStudyIdPending(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3116,11 +7052,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContracts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdContracts(c, studyId)
+	siw.Handler.PatchStudiesStudyIdPending(c, studyId)
 }
 
-// DeleteStudiesStudyIdContractsContractId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractId(c *gin.Context) {
+// GetStudiesStudyIdReviewThreads operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdReviewThreads(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3134,15 +7070,6 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractId(c *gi
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3150,11 +7077,11 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractId(c *gi
 		}
 	}
 
-	siw.Handler.DeleteStudiesStudyIdContractsContractId(c, studyId, contractId)
+	siw.Handler.GetStudiesStudyIdReviewThreads(c, studyId)
 }
 
-// GetStudiesStudyIdContractsContractId operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractId(c *gin.Context) {
+// PostStudiesStudyIdReviewThreadsReviewThreadIdComments operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdReviewThreadsReviewThreadIdComments(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3168,12 +7095,12 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractId(c *gin.C
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
+	// ------------- Path parameter "reviewThreadId" -------------
+	var reviewThreadId ReviewThreadIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewThreadId", c.Param("reviewThreadId"), &reviewThreadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewThreadId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3184,11 +7111,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractId(c *gin.C
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdContractsContractId(c, studyId, contractId)
+	siw.Handler.PostStudiesStudyIdReviewThreadsReviewThreadIdComments(c, studyId, reviewThreadId)
 }
 
-// PutStudiesStudyIdContractsContractId operation middleware
-func (siw *ServerInterfaceWrapper) PutStudiesStudyIdContractsContractId(c *gin.Context) {
+// GetStudiesStudyIdRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdRevisions(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3202,15 +7129,6 @@ func (siw *ServerInterfaceWrapper) PutStudiesStudyIdContractsContractId(c *gin.C
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3218,11 +7136,11 @@ func (siw *ServerInterfaceWrapper) PutStudiesStudyIdContractsContractId(c *gin.C
 		}
 	}
 
-	siw.Handler.PutStudiesStudyIdContractsContractId(c, studyId, contractId)
+	siw.Handler.GetStudiesStudyIdRevisions(c, studyId)
 }
 
-// PostStudiesStudyIdContractsContractIdObjects operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdObjects(c *gin.Context) {
+// GetStudiesStudyIdRevisionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdRevisionsDiff(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3236,12 +7154,22 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdObjects(
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStudiesStudyIdRevisionsDiffParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "from", c.Request.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "to", c.Request.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3252,11 +7180,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdContractsContractIdObjects(
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdContractsContractIdObjects(c, studyId, contractId)
+	siw.Handler.GetStudiesStudyIdRevisionsDiff(c, studyId, params)
 }
 
-// DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId(c *gin.Context) {
+// PostStudiesStudyIdSignoff operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdSignoff(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3270,24 +7198,6 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractIdObject
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Path parameter "contractObjectId" -------------
-	var contractObjectId ContractObjectIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractObjectId", c.Param("contractObjectId"), &contractObjectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractObjectId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3295,11 +7205,11 @@ func (siw *ServerInterfaceWrapper) DeleteStudiesStudyIdContractsContractIdObject
 		}
 	}
 
-	siw.Handler.DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId(c, studyId, contractId, contractObjectId)
+	siw.Handler.PostStudiesStudyIdSignoff(c, studyId)
 }
 
-// GetStudiesStudyIdContractsContractIdObjectsContractObjectId operation middleware
-func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractIdObjectsContractObjectId(c *gin.Context) {
+// GetStudiesStudyIdSignoffs operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdSignoffs(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3313,24 +7223,6 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractIdObjectsCo
 		return
 	}
 
-	// ------------- Path parameter "contractId" -------------
-	var contractId ContractIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractId", c.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Path parameter "contractObjectId" -------------
-	var contractObjectId ContractObjectIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "contractObjectId", c.Param("contractObjectId"), &contractObjectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contractObjectId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3338,11 +7230,11 @@ func (siw *ServerInterfaceWrapper) GetStudiesStudyIdContractsContractIdObjectsCo
 		}
 	}
 
-	siw.Handler.GetStudiesStudyIdContractsContractIdObjectsContractObjectId(c, studyId, contractId, contractObjectId)
+	siw.Handler.GetStudiesStudyIdSignoffs(c, studyId)
 }
 
-// PostStudiesStudyIdOwnerRequest operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdOwnerRequest(c *gin.Context) {
+// GetStudiesStudyIdTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetStudiesStudyIdTransfers(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3363,11 +7255,11 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdOwnerRequest(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdOwnerRequest(c, studyId)
+	siw.Handler.GetStudiesStudyIdTransfers(c, studyId)
 }
 
-// PatchStudiesStudyIdPending operation middleware
-func (siw *ServerInterfaceWrapper) PatchStudiesStudyIdPending(c *gin.Context) {
+// PostStudiesStudyIdTransfersTransferIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdTransfersTransferIdApprove(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3381,6 +7273,15 @@ func (siw *ServerInterfaceWrapper) PatchStudiesStudyIdPending(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "transferId" -------------
+	var transferId TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3388,11 +7289,11 @@ func (siw *ServerInterfaceWrapper) PatchStudiesStudyIdPending(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchStudiesStudyIdPending(c, studyId)
+	siw.Handler.PostStudiesStudyIdTransfersTransferIdApprove(c, studyId, transferId)
 }
 
-// PostStudiesStudyIdSignoff operation middleware
-func (siw *ServerInterfaceWrapper) PostStudiesStudyIdSignoff(c *gin.Context) {
+// PostStudiesStudyIdTransfersTransferIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostStudiesStudyIdTransfersTransferIdReject(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -3406,6 +7307,15 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdSignoff(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "transferId" -------------
+	var transferId TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3413,7 +7323,7 @@ func (siw *ServerInterfaceWrapper) PostStudiesStudyIdSignoff(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostStudiesStudyIdSignoff(c, studyId)
+	siw.Handler.PostStudiesStudyIdTransfersTransferIdReject(c, studyId, transferId)
 }
 
 // GetTokensEnvironment operation middleware
@@ -3718,19 +7628,48 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/users/approved-researchers/import/csv", wrapper.PostUsersApprovedResearchersImportCsv)
 	router.POST(options.BaseURL+"/users/invite", wrapper.PostUsersInvite)
 	router.GET(options.BaseURL+"/users/metrics", wrapper.GetUsersMetrics)
+	router.GET(options.BaseURL+"/search", wrapper.GetSearch)
 	router.GET(options.BaseURL+"/studies", wrapper.GetStudies)
 	router.POST(options.BaseURL+"/studies", wrapper.PostStudies)
 	router.GET(options.BaseURL+"/studies/:studyId", wrapper.GetStudiesStudyId)
 	router.PUT(options.BaseURL+"/studies/:studyId", wrapper.PutStudiesStudyId)
 	router.POST(options.BaseURL+"/studies/admin/:studyId/review", wrapper.PostStudiesAdminStudyIdReview)
+	router.DELETE(options.BaseURL+"/studies/admin/:studyId/reviewer", wrapper.DeleteStudiesAdminStudyIdReviewer)
+	router.PUT(options.BaseURL+"/studies/admin/:studyId/reviewer", wrapper.PutStudiesAdminStudyIdReviewer)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/reviewer/claim", wrapper.PostStudiesAdminStudyIdReviewerClaim)
 	router.POST(options.BaseURL+"/studies/admin/:studyId/owner-request", wrapper.PostStudiesAdminStudyIdOwnerRequest)
 	router.POST(options.BaseURL+"/studies/admin/:studyId/owner-approve", wrapper.PostStudiesAdminStudyIdOwnerApprove)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/owner-reject", wrapper.PostStudiesAdminStudyIdOwnerReject)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/owner-cancel", wrapper.PostStudiesAdminStudyIdOwnerCancel)
+	router.GET(options.BaseURL+"/studies/admin/owner-changes", wrapper.GetStudiesAdminOwnerChanges)
+	router.GET(options.BaseURL+"/studies/admin/asset-destructions", wrapper.GetStudiesAdminAssetDestructions)
+	router.GET(options.BaseURL+"/studies/admin/asset-locations", wrapper.GetStudiesAdminAssetLocations)
+	router.GET(options.BaseURL+"/studies/admin/asset-register", wrapper.GetStudiesAdminAssetRegister)
+	router.GET(options.BaseURL+"/studies/admin/asset-register/exports", wrapper.GetStudiesAdminAssetRegisterExports)
+	router.GET(options.BaseURL+"/studies/admin/asset-register/exports/:exportId/file", wrapper.GetStudiesAdminAssetRegisterExportsExportIdFile)
+	router.GET(options.BaseURL+"/studies/admin/asset-transfers", wrapper.GetStudiesAdminAssetTransfers)
+	router.POST(options.BaseURL+"/studies/admin/asset-transfers/:transferId/approve", wrapper.PostStudiesAdminAssetTransfersTransferIdApprove)
+	router.POST(options.BaseURL+"/studies/admin/asset-transfers/:transferId/reject", wrapper.PostStudiesAdminAssetTransfersTransferIdReject)
 	router.POST(options.BaseURL+"/studies/admin/import", wrapper.PostStudiesAdminImport)
 	router.POST(options.BaseURL+"/studies/admin/:studyId/assets/import", wrapper.PostStudiesAdminStudyIdAssetsImport)
 	router.POST(options.BaseURL+"/studies/admin/:studyId/contracts/import", wrapper.PostStudiesAdminStudyIdContractsImport)
+	router.POST(options.BaseURL+"/studies/admin/import/bulk", wrapper.PostStudiesAdminImportBulk)
 	router.PATCH(options.BaseURL+"/studies/:studyId/pending", wrapper.PatchStudiesStudyIdPending)
 	router.POST(options.BaseURL+"/studies/:studyId/signoff", wrapper.PostStudiesStudyIdSignoff)
+	router.GET(options.BaseURL+"/studies/:studyId/signoffs", wrapper.GetStudiesStudyIdSignoffs)
 	router.POST(options.BaseURL+"/studies/:studyId/owner-request", wrapper.PostStudiesStudyIdOwnerRequest)
+	router.POST(options.BaseURL+"/studies/:studyId/owner-cancel", wrapper.PostStudiesStudyIdOwnerCancel)
+	router.GET(options.BaseURL+"/studies/:studyId/review-threads", wrapper.GetStudiesStudyIdReviewThreads)
+	router.POST(options.BaseURL+"/studies/:studyId/review-threads/:reviewThreadId/comments", wrapper.PostStudiesStudyIdReviewThreadsReviewThreadIdComments)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/review-threads", wrapper.PostStudiesAdminStudyIdReviewThreads)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/review-threads/:reviewThreadId/comments", wrapper.PostStudiesAdminStudyIdReviewThreadsReviewThreadIdComments)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/review-threads/:reviewThreadId/resolve", wrapper.PostStudiesAdminStudyIdReviewThreadsReviewThreadIdResolve)
+	router.GET(options.BaseURL+"/studies/:studyId/revisions", wrapper.GetStudiesStudyIdRevisions)
+	router.GET(options.BaseURL+"/studies/:studyId/revisions/diff", wrapper.GetStudiesStudyIdRevisionsDiff)
+	router.GET(options.BaseURL+"/studies/:studyId/closure", wrapper.GetStudiesStudyIdClosure)
+	router.POST(options.BaseURL+"/studies/:studyId/closure", wrapper.PostStudiesStudyIdClosure)
+	router.POST(options.BaseURL+"/studies/:studyId/closure/complete", wrapper.PostStudiesStudyIdClosureComplete)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/archive", wrapper.PostStudiesAdminStudyIdArchive)
 	router.GET(options.BaseURL+"/environments", wrapper.GetEnvironments)
 	router.GET(options.BaseURL+"/projects", wrapper.GetProjects)
 	router.GET(options.BaseURL+"/projects/tre", wrapper.GetProjectsTre)
@@ -3742,12 +7681,34 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/projects/tre/admin/:projectId/approve", wrapper.PostProjectsTreAdminProjectIdApprove)
 	router.POST(options.BaseURL+"/projects/tre/admin/import", wrapper.PostProjectsTreAdminImport)
 	router.GET(options.BaseURL+"/projects/dsh/:projectId", wrapper.GetProjectsDshProjectId)
+	router.GET(options.BaseURL+"/studies/:studyId/dpia", wrapper.GetStudiesStudyIdDpia)
+	router.PUT(options.BaseURL+"/studies/:studyId/dpia", wrapper.PutStudiesStudyIdDpia)
+	router.POST(options.BaseURL+"/studies/:studyId/dpia/submit", wrapper.PostStudiesStudyIdDpiaSubmit)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/dpia/signoff", wrapper.PostStudiesAdminStudyIdDpiaSignoff)
 	router.GET(options.BaseURL+"/studies/:studyId/assets", wrapper.GetStudiesStudyIdAssets)
 	router.POST(options.BaseURL+"/studies/:studyId/assets", wrapper.PostStudiesStudyIdAssets)
 	router.DELETE(options.BaseURL+"/studies/:studyId/assets/:assetId", wrapper.DeleteStudiesStudyIdAssetsAssetId)
 	router.GET(options.BaseURL+"/studies/:studyId/assets/:assetId", wrapper.GetStudiesStudyIdAssetsAssetId)
 	router.PUT(options.BaseURL+"/studies/:studyId/assets/:assetId", wrapper.PutStudiesStudyIdAssetsAssetId)
 	router.GET(options.BaseURL+"/studies/:studyId/assets/:assetId/contracts", wrapper.GetStudiesStudyIdAssetsAssetIdContracts)
+	router.POST(options.BaseURL+"/studies/:studyId/assets/:assetId/parents", wrapper.PostStudiesStudyIdAssetsAssetIdParents)
+	router.DELETE(options.BaseURL+"/studies/:studyId/assets/:assetId/parents/:parentAssetId", wrapper.DeleteStudiesStudyIdAssetsAssetIdParentsParentAssetId)
+	router.GET(options.BaseURL+"/studies/:studyId/assets/:assetId/destructions", wrapper.GetStudiesStudyIdAssetsAssetIdDestructions)
+	router.POST(options.BaseURL+"/studies/:studyId/assets/:assetId/destructions", wrapper.PostStudiesStudyIdAssetsAssetIdDestructions)
+	router.GET(options.BaseURL+"/studies/:studyId/assets/:assetId/destructions/:destructionId/certificate", wrapper.GetStudiesStudyIdAssetsAssetIdDestructionsDestructionIdCertificate)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/assets/:assetId/destructions/:destructionId/approve", wrapper.PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdApprove)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/assets/:assetId/destructions/:destructionId/reject", wrapper.PostStudiesAdminStudyIdAssetsAssetIdDestructionsDestructionIdReject)
+	router.GET(options.BaseURL+"/studies/admin/:studyId/contracts/:contractId/downloads", wrapper.GetStudiesAdminStudyIdContractsContractIdDownloads)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/contracts/:contractId/versions/:contractVersionId/approve", wrapper.PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdApprove)
+	router.POST(options.BaseURL+"/studies/admin/:studyId/contracts/:contractId/versions/:contractVersionId/reject", wrapper.PostStudiesAdminStudyIdContractsContractIdVersionsContractVersionIdReject)
+	router.GET(options.BaseURL+"/studies/:studyId/assets/:assetId/transfers", wrapper.GetStudiesStudyIdAssetsAssetIdTransfers)
+	router.POST(options.BaseURL+"/studies/:studyId/assets/:assetId/transfers", wrapper.PostStudiesStudyIdAssetsAssetIdTransfers)
+	router.GET(options.BaseURL+"/studies/:studyId/transfers", wrapper.GetStudiesStudyIdTransfers)
+	router.POST(options.BaseURL+"/studies/:studyId/transfers/:transferId/approve", wrapper.PostStudiesStudyIdTransfersTransferIdApprove)
+	router.POST(options.BaseURL+"/studies/:studyId/transfers/:transferId/reject", wrapper.PostStudiesStudyIdTransfersTransferIdReject)
+	router.GET(options.BaseURL+"/studies/:studyId/asset-expiry-reviews", wrapper.GetStudiesStudyIdAssetExpiryReviews)
+	router.POST(options.BaseURL+"/studies/:studyId/asset-expiry-reviews/:expiryReviewId/resolve", wrapper.PostStudiesStudyIdAssetExpiryReviewsExpiryReviewIdResolve)
+	router.GET(options.BaseURL+"/studies/:studyId/lineage", wrapper.GetStudiesStudyIdLineage)
 	router.GET(options.BaseURL+"/logout", wrapper.GetLogout)
 	router.GET(options.BaseURL+"/studies/:studyId/agreements", wrapper.GetStudiesStudyIdAgreements)
 	router.POST(options.BaseURL+"/studies/:studyId/agreements", wrapper.PostStudiesStudyIdAgreements)
@@ -3757,6 +7718,26 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/studies/:studyId/contracts/:contractId", wrapper.GetStudiesStudyIdContractsContractId)
 	router.PUT(options.BaseURL+"/studies/:studyId/contracts/:contractId", wrapper.PutStudiesStudyIdContractsContractId)
 	router.POST(options.BaseURL+"/studies/:studyId/contracts/:contractId/objects", wrapper.PostStudiesStudyIdContractsContractIdObjects)
+	router.GET(options.BaseURL+"/studies/:studyId/contracts/:contractId/versions", wrapper.GetStudiesStudyIdContractsContractIdVersions)
+	router.POST(options.BaseURL+"/studies/:studyId/contracts/:contractId/versions", wrapper.PostStudiesStudyIdContractsContractIdVersions)
+	router.POST(options.BaseURL+"/studies/:studyId/contracts/:contractId/versions/:contractVersionId/objects", wrapper.PostStudiesStudyIdContractsContractIdVersionsContractVersionIdObjects)
 	router.DELETE(options.BaseURL+"/studies/:studyId/contracts/:contractId/objects/:contractObjectId", wrapper.DeleteStudiesStudyIdContractsContractIdObjectsContractObjectId)
 	router.GET(options.BaseURL+"/studies/:studyId/contracts/:contractId/objects/:contractObjectId", wrapper.GetStudiesStudyIdContractsContractIdObjectsContractObjectId)
+	router.POST(options.BaseURL+"/studies/:studyId/contracts/:contractId/renew", wrapper.PostStudiesStudyIdContractsContractIdRenew)
+	router.GET(options.BaseURL+"/studies/:studyId/approvals", wrapper.GetStudiesStudyIdApprovals)
+	router.POST(options.BaseURL+"/studies/:studyId/approvals", wrapper.PostStudiesStudyIdApprovals)
+	router.DELETE(options.BaseURL+"/studies/:studyId/approvals/:approvalId", wrapper.DeleteStudiesStudyIdApprovalsApprovalId)
+	router.PUT(options.BaseURL+"/studies/:studyId/approvals/:approvalId", wrapper.PutStudiesStudyIdApprovalsApprovalId)
+	router.GET(options.BaseURL+"/studies/:studyId/approvals/:approvalId/letter", wrapper.GetStudiesStudyIdApprovalsApprovalIdLetter)
+	router.POST(options.BaseURL+"/studies/:studyId/approvals/:approvalId/letter", wrapper.PostStudiesStudyIdApprovalsApprovalIdLetter)
+	router.GET(options.BaseURL+"/studies/:studyId/documents", wrapper.GetStudiesStudyIdDocuments)
+	router.POST(options.BaseURL+"/studies/:studyId/documents", wrapper.PostStudiesStudyIdDocuments)
+	router.DELETE(options.BaseURL+"/studies/:studyId/documents/:documentId", wrapper.DeleteStudiesStudyIdDocumentsDocumentId)
+	router.POST(options.BaseURL+"/studies/:studyId/documents/:documentId/versions", wrapper.PostStudiesStudyIdDocumentsDocumentIdVersions)
+	router.GET(options.BaseURL+"/studies/:studyId/documents/:documentId/versions/:documentVersionId", wrapper.GetStudiesStudyIdDocumentsDocumentIdVersionsDocumentVersionId)
+	router.GET(options.BaseURL+"/erasures", wrapper.GetErasures)
+	router.POST(options.BaseURL+"/erasures", wrapper.PostErasures)
+	router.GET(options.BaseURL+"/erasures/:erasureId", wrapper.GetErasuresErasureId)
+	router.POST(options.BaseURL+"/erasures/:erasureId/approve", wrapper.PostErasuresErasureIdApprove)
+	router.POST(options.BaseURL+"/erasures/:erasureId/reject", wrapper.PostErasuresErasureIdReject)
 }
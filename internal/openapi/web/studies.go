@@ -18,6 +18,12 @@ func (s GetStudiesParams) Valid() bool {
 	if s.Status != nil && !s.Status.Valid() {
 		return false
 	}
+	if s.Reviewer != nil && !s.Reviewer.Valid() {
+		return false
+	}
+	if s.RiskRating != nil && !s.RiskRating.Valid() {
+		return false
+	}
 	return true
 }
 
@@ -0,0 +1,38 @@
+package pdf
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/klippa-app/go-pdfium"
+	pdfwasm "github.com/klippa-app/go-pdfium/webassembly"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const instanceTimeout = 10 * time.Second
+
+// Single pool of pdfium WebAssembly instances for every package handling PDFs.
+// An instance processes one document at a time, so allow one per CPU for
+// concurrent requests and keep them all idle rather than start them again
+var instancePool = must(pdfwasm.Init(pdfwasm.Config{
+	MinIdle:  0,
+	MaxIdle:  runtime.GOMAXPROCS(0),
+	MaxTotal: runtime.GOMAXPROCS(0),
+}))
+
+// Instance takes a pdfium instance from the shared pool, waiting for one to be
+// returned if all are in use. The caller must close it to return it
+func Instance() (pdfium.Pdfium, error) {
+	instance, err := instancePool.GetInstance(instanceTimeout)
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	return instance, nil
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,163 @@
+package pdf
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/klippa-app/go-pdfium"
+	pdfref "github.com/klippa-app/go-pdfium/references"
+	pdfreq "github.com/klippa-app/go-pdfium/requests"
+	pdfstructs "github.com/klippa-app/go-pdfium/structs"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const (
+	watermarkFont         = "Helvetica"
+	watermarkFooterSize   = 8
+	watermarkMargin       = 12
+	watermarkDiagonalFill = 0.7 // Fraction of the page diagonal the text spans
+)
+
+var (
+	watermarkColor = pdfstructs.FPDF_COLOR{R: 200, G: 0, B: 0, A: 64}
+	footerColor    = pdfstructs.FPDF_COLOR{R: 200, G: 0, B: 0, A: 255}
+)
+
+// Watermark stamps every page of a PDF with the text, both faintly across the
+// page and in the footer, and returns the rewritten document
+func Watermark(content []byte, text string) ([]byte, error) {
+	instance, err := Instance()
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck // always non nil error
+	defer instance.Close()
+
+	doc, err := instance.OpenDocument(&pdfreq.OpenDocument{File: &content})
+	if err != nil {
+		return nil, types.NewErrInvalidObjectF("failed to open PDF: %v", err)
+	}
+	//nolint:errcheck // always non nil error
+	defer instance.FPDF_CloseDocument(&pdfreq.FPDF_CloseDocument{Document: doc.Document})
+
+	font, err := instance.FPDFText_LoadStandardFont(&pdfreq.FPDFText_LoadStandardFont{
+		Document: doc.Document,
+		Font:     watermarkFont,
+	})
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	//nolint:errcheck // always non nil error
+	defer instance.FPDFFont_Close(&pdfreq.FPDFFont_Close{Font: font.Font})
+
+	pageCount, err := instance.FPDF_GetPageCount(&pdfreq.FPDF_GetPageCount{Document: doc.Document})
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	stamper := stamper{instance: instance, doc: doc.Document, font: font.Font, text: text}
+	for index := range pageCount.PageCount {
+		if err := stamper.stampPage(index); err != nil {
+			return nil, err
+		}
+	}
+
+	buffer := bytes.Buffer{}
+	_, err = instance.FPDF_SaveAsCopy(&pdfreq.FPDF_SaveAsCopy{
+		Document:   doc.Document,
+		Flags:      pdfreq.SaveFlagNoIncremental, // Incremental saves would retain the unstamped pages
+		FileWriter: &buffer,
+	})
+	if err != nil {
+		return nil, types.NewErrServerError(err)
+	}
+	return buffer.Bytes(), nil
+}
+
+type stamper struct {
+	instance pdfium.Pdfium
+	doc      pdfref.FPDF_DOCUMENT
+	font     pdfref.FPDF_FONT
+	text     string
+}
+
+func (s stamper) stampPage(index int) error {
+	page, err := s.instance.FPDF_LoadPage(&pdfreq.FPDF_LoadPage{Document: s.doc, Index: index})
+	if err != nil {
+		return types.NewErrServerError(err)
+	}
+	//nolint:errcheck // always non nil error
+	defer s.instance.FPDF_ClosePage(&pdfreq.FPDF_ClosePage{Page: page.Page})
+	pageRef := pdfreq.Page{ByReference: &page.Page}
+
+	width, err := s.instance.FPDF_GetPageWidthF(&pdfreq.FPDF_GetPageWidthF{Page: pageRef})
+	if err != nil {
+		return types.NewErrServerError(err)
+	}
+	height, err := s.instance.FPDF_GetPageHeightF(&pdfreq.FPDF_GetPageHeightF{Page: pageRef})
+	if err != nil {
+		return types.NewErrServerError(err)
+	}
+
+	// Unit sized text scaled and rotated to span the page diagonal
+	diagonal, err := s.newText(1, watermarkColor)
+	if err != nil {
+		return err
+	}
+	bounds, err := s.instance.FPDFPageObj_GetBounds(&pdfreq.FPDFPageObj_GetBounds{PageObject: diagonal})
+	if err != nil {
+		return types.NewErrServerError(err)
+	}
+	angle := math.Atan2(float64(height.PageHeight), float64(width.PageWidth))
+	length := math.Hypot(float64(width.PageWidth), float64(height.PageHeight))
+	scale := watermarkDiagonalFill * length / max(float64(bounds.Right-bounds.Left), 1e-3)
+	offset := (1 - watermarkDiagonalFill) / 2
+	transform := pdfstructs.FPDF_FS_MATRIX{
+		A: float32(scale * math.Cos(angle)),
+		B: float32(scale * math.Sin(angle)),
+		C: float32(-scale * math.Sin(angle)),
+		D: float32(scale * math.Cos(angle)),
+		E: float32(offset) * width.PageWidth,
+		F: float32(offset) * height.PageHeight,
+	}
+	if err := s.insert(pageRef, diagonal, transform); err != nil {
+		return err
+	}
+
+	footer, err := s.newText(watermarkFooterSize, footerColor)
+	if err != nil {
+		return err
+	}
+	transform = pdfstructs.FPDF_FS_MATRIX{A: 1, D: 1, E: watermarkMargin, F: watermarkMargin}
+	if err := s.insert(pageRef, footer, transform); err != nil {
+		return err
+	}
+
+	if _, err := s.instance.FPDFPage_GenerateContent(&pdfreq.FPDFPage_GenerateContent{Page: pageRef}); err != nil {
+		return types.NewErrServerError(err)
+	}
+	return nil
+}
+
+func (s stamper) newText(fontSize float32, color pdfstructs.FPDF_COLOR) (pdfref.FPDF_PAGEOBJECT, error) {
+	text, err := s.instance.FPDFPageObj_CreateTextObj(&pdfreq.FPDFPageObj_CreateTextObj{
+		Document: s.doc,
+		Font:     s.font,
+		FontSize: fontSize,
+	})
+	if err != nil {
+		return "", types.NewErrServerError(err)
+	}
+	if _, err := s.instance.FPDFText_SetText(&pdfreq.FPDFText_SetText{PageObject: text.PageObject, Text: s.text}); err != nil {
+		return "", types.NewErrServerError(err)
+	}
+	_, err = s.instance.FPDFPageObj_SetFillColor(&pdfreq.FPDFPageObj_SetFillColor{PageObject: text.PageObject, FillColor: color})
+	return text.PageObject, types.NewErrServerError(err)
+}
+
+func (s stamper) insert(page pdfreq.Page, object pdfref.FPDF_PAGEOBJECT, transform pdfstructs.FPDF_FS_MATRIX) error {
+	if _, err := s.instance.FPDFPageObj_Transform(&pdfreq.FPDFPageObj_Transform{PageObject: object, Transform: transform}); err != nil {
+		return types.NewErrServerError(err)
+	}
+	_, err := s.instance.FPDFPage_InsertObject(&pdfreq.FPDFPage_InsertObject{Page: page, PageObject: object})
+	return types.NewErrServerError(err)
+}
@@ -0,0 +1,96 @@
+package pdf
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	pdfreq "github.com/klippa-app/go-pdfium/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func newTestPDF(t *testing.T, numPages int) []byte {
+	t.Helper()
+	instance, err := Instance()
+	require.NoError(t, err)
+	//nolint:errcheck // always non nil error
+	defer instance.Close()
+
+	doc, err := instance.FPDF_CreateNewDocument(&pdfreq.FPDF_CreateNewDocument{})
+	require.NoError(t, err)
+	//nolint:errcheck // always non nil error
+	defer instance.FPDF_CloseDocument(&pdfreq.FPDF_CloseDocument{Document: doc.Document})
+	for index := range numPages {
+		page, err := instance.FPDFPage_New(&pdfreq.FPDFPage_New{Document: doc.Document, PageIndex: index, Width: 595, Height: 842})
+		require.NoError(t, err)
+		_, err = instance.FPDF_ClosePage(&pdfreq.FPDF_ClosePage{Page: page.Page})
+		require.NoError(t, err)
+	}
+	buffer := bytes.Buffer{}
+	_, err = instance.FPDF_SaveAsCopy(&pdfreq.FPDF_SaveAsCopy{Document: doc.Document, FileWriter: &buffer})
+	require.NoError(t, err)
+	return buffer.Bytes()
+}
+
+func pagesText(t *testing.T, content []byte) []string {
+	t.Helper()
+	instance, err := Instance()
+	require.NoError(t, err)
+	//nolint:errcheck // always non nil error
+	defer instance.Close()
+
+	doc, err := instance.OpenDocument(&pdfreq.OpenDocument{File: &content})
+	require.NoError(t, err)
+	//nolint:errcheck // always non nil error
+	defer instance.FPDF_CloseDocument(&pdfreq.FPDF_CloseDocument{Document: doc.Document})
+	pageCount, err := instance.FPDF_GetPageCount(&pdfreq.FPDF_GetPageCount{Document: doc.Document})
+	require.NoError(t, err)
+	texts := []string{}
+	for index := range pageCount.PageCount {
+		text, err := instance.GetPageText(&pdfreq.GetPageText{
+			Page: pdfreq.Page{ByIndex: &pdfreq.PageByIndex{Document: doc.Document, Index: index}},
+		})
+		require.NoError(t, err)
+		texts = append(texts, text.Text)
+	}
+	return texts
+}
+
+func TestWatermark(t *testing.T) {
+	text := "Downloaded by alice@example.com at 2026-01-02T03:04:05Z - Caseref 10001"
+	watermarked, err := Watermark(newTestPDF(t, 2), text)
+	require.NoError(t, err)
+
+	texts := pagesText(t, watermarked)
+	require.Len(t, texts, 2)
+	for _, pageText := range texts {
+		assert.Equal(t, 2, strings.Count(strings.ReplaceAll(pageText, "\r\n", ""), text))
+	}
+}
+
+func TestWatermarkInvalidPDF(t *testing.T) {
+	_, err := Watermark([]byte("not a pdf"), "text")
+	assert.ErrorIs(t, err, types.ErrInvalidObject)
+}
+
+func TestWatermarkConcurrent(t *testing.T) {
+	content := newTestPDF(t, 1)
+	numDownloads := 4 * runtime.GOMAXPROCS(0) // more than there are instances
+	errs := make(chan error, numDownloads)
+	wg := sync.WaitGroup{}
+	for range numDownloads {
+		wg.Go(func() {
+			_, err := Watermark(content, "text")
+			errs <- err
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
@@ -21,6 +21,7 @@ func seedPolicies(enforcer *casbin.SyncedEnforcer) {
 	addIgOpsStaffPolicy(enforcer)
 	addIgAdminPolicy(enforcer)
 	addDSHOpsStaffPolicy(enforcer)
+	addDpoPolicy(enforcer)
 
 	runMigrations()
 }
@@ -50,6 +51,7 @@ func addBasePolicies(enforcer *casbin.SyncedEnforcer) {
 func addApprovedResearcherPolicies(enforcer *casbin.SyncedEnforcer) {
 	mustAddPolicies(enforcer,
 		Policy{RoleName: ApprovedResearcher, Resource: "/studies", Action: ReadAction},
+		Policy{RoleName: ApprovedResearcher, Resource: "/search", Action: ReadAction},
 		Policy{RoleName: ApprovedStaffResearcher, Resource: "/studies", Action: WriteAction},
 		Policy{RoleName: ApprovedStaffResearcher, Resource: "/agreements/study-owner", Action: ReadAction},
 		Policy{RoleName: ApprovedStaffResearcher, Resource: "/agreements/study-administrator", Action: ReadAction},
@@ -77,6 +79,7 @@ func addTreOpsStaffPolicy(enforcer *casbin.SyncedEnforcer) {
 		Policy{RoleName: TreOpsStaff, Resource: "/tokens/tre/*", Action: ReadAction},
 		Policy{RoleName: TreOpsStaff, Resource: "/tokens/tre/*", Action: WriteAction},
 		Policy{RoleName: TreOpsStaff, Resource: "/studies", Action: ReadAction},
+		Policy{RoleName: TreOpsStaff, Resource: "/search", Action: ReadAction},
 		Policy{RoleName: TreOpsStaff, Resource: "/studies/:id", Action: ReadAction},
 	)
 }
@@ -90,6 +93,7 @@ func addIgOpsStaffPolicy(enforcer *casbin.SyncedEnforcer) {
 		Policy{RoleName: IGOpsStaff, Resource: "/users/metrics", Action: ReadAction},
 		Policy{RoleName: IGOpsStaff, Resource: "/users/invite", Action: WriteAction},
 
+		Policy{RoleName: IGOpsStaff, Resource: "/search", Action: ReadAction},
 		Policy{RoleName: IGOpsStaff, Resource: "/studies", Action: ReadAction},
 		Policy{RoleName: IGOpsStaff, Resource: "/studies/*", Action: ReadAction},
 		Policy{RoleName: IGOpsStaff, Resource: "/studies/admin/*", Action: ReadAction},
@@ -105,6 +109,7 @@ func addIgAdminPolicy(enforcer *casbin.SyncedEnforcer) {
 		Policy{RoleName: IGAdmin, Resource: "/users/:id/attributes", Action: WriteAction},
 		Policy{RoleName: IGAdmin, Resource: "/users/metrics", Action: ReadAction},
 		Policy{RoleName: IGAdmin, Resource: "/users/invite", Action: WriteAction},
+		Policy{RoleName: IGAdmin, Resource: "/search", Action: ReadAction},
 		Policy{RoleName: IGAdmin, Resource: "/studies", Action: ReadAction},
 		Policy{RoleName: IGAdmin, Resource: "/studies/*", Action: ReadAction},
 		Policy{RoleName: IGAdmin, Resource: "/studies/*", Action: WriteAction},
@@ -120,12 +125,21 @@ func addDSHOpsStaffPolicy(enforcer *casbin.SyncedEnforcer) {
 		Policy{RoleName: DSHOpsStaff, Resource: "/tokens/dsh/*", Action: ReadAction},
 		Policy{RoleName: DSHOpsStaff, Resource: "/tokens/dsh/*", Action: WriteAction},
 		Policy{RoleName: DSHOpsStaff, Resource: "/studies", Action: ReadAction},
+		Policy{RoleName: DSHOpsStaff, Resource: "/search", Action: ReadAction},
 		Policy{RoleName: DSHOpsStaff, Resource: "/studies/:id", Action: ReadAction},
 		Policy{RoleName: DSHOpsStaff, Resource: "/projects", Action: ReadAction},
 		Policy{RoleName: DSHOpsStaff, Resource: "/projects/dsh/*", Action: ReadAction},
 	)
 }
 
+func addDpoPolicy(enforcer *casbin.SyncedEnforcer) {
+	mustAddPolicies(enforcer,
+		Policy{RoleName: DPO, Resource: "/studies/:id", Action: ReadAction},
+		Policy{RoleName: DPO, Resource: "/studies/:id/dpia", Action: ReadAction},
+		Policy{RoleName: DPO, Resource: "/studies/admin/:id/dpia/signoff", Action: WriteAction},
+	)
+}
+
 func mustAddPolicies(enforcer *casbin.SyncedEnforcer, policies ...Policy) {
 	for _, policy := range policies {
 		_ = must(addPolicy(enforcer, policy))
@@ -29,13 +29,14 @@ const (
 	IGOpsStaff                    = ConfigRolename(openapi.AuthRolesIgOpsStaff)              // Information governance operations staff
 	IGAdmin                       = ConfigRolename(openapi.AuthRolesIgAdmin)                 // Information governance administrator
 	DSHOpsStaff                   = ConfigRolename(openapi.AuthRolesDshOpsStaff)             // Data Safe Haven operations staff
+	DPO                           = ConfigRolename(openapi.AuthRolesDpo)                     // Data protection officer, who signs off DPIAs
 
 	ReadAction  = Action("read")
 	WriteAction = Action("write")
 )
 
 var (
-	ConfigRoleNames = []ConfigRolename{Admin, TreOpsStaff, IGOpsStaff, IGAdmin, DSHOpsStaff}
+	ConfigRoleNames = []ConfigRolename{Admin, TreOpsStaff, IGOpsStaff, IGAdmin, DSHOpsStaff, DPO}
 )
 
 var enforcer *casbin.SyncedEnforcer
@@ -173,6 +174,24 @@ func RemoveRole(user types.User, role RoleName) (bool, error) {
 	return roleRemoved, types.NewErrServerError(err)
 }
 
+// Delete the owner roles of a study and its projects, including their
+// policies and bindings to users
+func DeleteStudyRoles(studyId uuid.UUID, projectIds []uuid.UUID) error {
+	for _, projectId := range projectIds {
+		if _, err := enforcer.DeleteRole(string(makeProjectOwnerRole(projectId).RoleName())); err != nil {
+			return types.NewErrServerError(err)
+		}
+	}
+	_, err := enforcer.DeleteRole(string(makeStudyOwnerRole(studyId).RoleName()))
+	return types.NewErrServerError(err)
+}
+
+// Delete all roles and policies of a user
+func DeleteUserRoles(user types.User) error {
+	_, err := enforcer.DeleteUser(user.ID.String())
+	return types.NewErrServerError(err)
+}
+
 // Get all roles of a user
 func Roles(user types.User) ([]RoleName, error) {
 	rawRoles, err := enforcer.GetImplicitRolesForUser(user.ID.String())
@@ -48,6 +48,19 @@ func TestRBAC(t *testing.T) {
 	aliceStudyIDsWithOwnerRole, err := StudyIDsWithRole(alice, StudyOwner)
 	assert.NoError(t, err)
 	assert.Len(t, aliceStudyIDsWithOwnerRole, 0)
+
+	projectId := uuid.MustParse("5b0ac4a6-0d0c-4b8e-9d67-0e0e5d4a8f21")
+	_, err = AddProjectTreOwnerRole(studyId, projectId)
+	assert.NoError(t, err)
+	assert.Contains(t, must(Roles(bob)), makeProjectOwnerRole(projectId).RoleName())
+
+	assert.NoError(t, DeleteStudyRoles(studyId, []uuid.UUID{projectId}))
+	assert.Len(t, must(StudyIDsWithRole(bob, StudyOwner)), 0)
+	assert.Len(t, must(ProjectIDsWithRole(bob, ProjectOwner)), 0)
+	assert.True(t, must(HasRole(bob, Admin)))
+
+	assert.NoError(t, DeleteUserRoles(bob))
+	assert.Len(t, must(Roles(bob)), 0)
 }
 
 func TestKeyMatch2(t *testing.T) {
@@ -19,12 +19,16 @@ var studyOwnerMarkdown string
 //go:embed study_administrator.md
 var studyAdministratorMarkdown string
 
+//go:embed study_signoff.md
+var studySignoffMarkdown string
+
 // Initialise the agreements
 func Init() {
 	db := graceful.NewDB()
 	initAgreement(db, approvedResearcherMarkdown, ApprovedResearcherType)
 	initAgreement(db, studyOwnerMarkdown, StudyOwnerType)
 	initAgreement(db, studyAdministratorMarkdown, StudyAdministratorType)
+	initAgreement(db, studySignoffMarkdown, StudySignoffType)
 }
 
 func initAgreement(db *gorm.DB, agreementMarkdown string, agreementType types.AgreementType) {
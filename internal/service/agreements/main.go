@@ -11,6 +11,7 @@ const (
 	ApprovedResearcherType = types.AgreementType("approved-researcher")
 	StudyOwnerType         = types.AgreementType("study-owner")
 	StudyAdministratorType = types.AgreementType("study-administrator")
+	StudySignoffType       = types.AgreementType("study-signoff") // Attestation shown on study signoff
 )
 
 type Service struct {
@@ -33,6 +34,10 @@ func (s *Service) LatestStudyAdministrator() (*types.Agreement, error) {
 	return s.latestAgreement(StudyAdministratorType)
 }
 
+func (s *Service) LatestStudySignoff() (*types.Agreement, error) {
+	return s.latestAgreement(StudySignoffType)
+}
+
 func (s *Service) AgreementTypeById(id uuid.UUID) (*types.AgreementType, error) {
 	agreement := types.Agreement{}
 	result := s.db.Select("type").Where("id = ?", id).First(&agreement)
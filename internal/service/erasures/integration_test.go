@@ -0,0 +1,293 @@
+//go:build integration
+
+package erasures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockcontrollers"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockdb"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+func migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&types.User{},
+		&types.Agreement{},
+		&types.UserAgreementConfirmation{},
+		&types.UserTrainingRecord{},
+		&types.UserAttributes{},
+		&types.UserSponsorship{},
+		&types.Study{},
+		&types.StudyAdmin{},
+		&types.StudyOwnerChangelog{},
+		&types.StudySignoff{},
+		&types.StudyRevision{},
+		&types.StudyRevisionChange{},
+		&types.StudyReviewThread{},
+		&types.StudyReviewComment{},
+		&types.StudyAgreementSignature{},
+		&types.Dpia{},
+		&types.DpiaAnswer{},
+		&types.DpiaRisk{},
+		&types.Asset{},
+		&types.AssetLocation{},
+		&types.AssetDataType{},
+		&types.AssetLink{},
+		&types.AssetDestruction{},
+		&types.AssetTransfer{},
+		&types.AssetExpiryReview{},
+		&types.Contract{},
+		&types.ContractObjectMetadata{},
+		&types.ContractObjectDownload{},
+		&types.ContractVersion{},
+		&types.RegulatoryApproval{},
+		&types.StudyDocument{},
+		&types.StudyDocumentVersion{},
+		&types.Environment{},
+		&types.Project{},
+		&types.ProjectTRE{},
+		&types.ProjectTRERoleBinding{},
+		&types.ProjectTREVMImage{},
+		&types.ProjectTREUserConfig{},
+		&types.ProjectTRESuspensionChangelog{},
+		&types.ProjectDSH{},
+		&types.ProjectDSHRoleBinding{},
+		&types.ProjectAsset{},
+		&types.Notification{},
+		&types.Erasure{},
+	)
+}
+
+func TestIntegration_StudyErasure(t *testing.T) {
+	db := mockdb.NewTestDBSchema(t, migrate)
+	rbac.Init()
+
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3}
+
+	requester := types.User{Username: "requester@example.com"}
+	reviewer := types.User{Username: "reviewer@example.com"}
+	owner := types.User{Username: "owner@example.com"}
+	require.NoError(t, db.Create(&[]*types.User{&requester, &reviewer, &owner}).Error)
+
+	study := types.Study{OwnerUserID: owner.ID, Title: "erased"}
+	require.NoError(t, db.Create(&study).Error)
+	_, err := rbac.AddStudyOwnerRole(owner, study.ID)
+	require.NoError(t, err)
+
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "asset", Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&asset).Error)
+	require.NoError(t, db.Create(&types.AssetLocation{AssetID: asset.ID, Location: "somewhere"}).Error)
+	contract := types.Contract{CreatorUserID: owner.ID, StudyID: study.ID, Title: "contract", Status: types.ContractStatusActive}
+	require.NoError(t, db.Create(&contract).Error)
+	contractObject := types.ContractObjectMetadata{ContractID: contract.ID, Filename: "contract.pdf"}
+	require.NoError(t, db.Create(&contractObject).Error)
+	require.NoError(t, db.Delete(&contract).Error) // soft deleted rows are erased too
+	require.NoError(t, db.Create(&types.Notification{
+		RecipientUserID: owner.ID,
+		DedupeKey:       "erased-study",
+		Title:           "About the study",
+		Href:            new("/studies/manage?studyId=" + study.ID.String()),
+	}).Error)
+
+	data := openapi.ErasureRequest{
+		SubjectKind: openapi.ErasureSubjectKindStudy,
+		SubjectId:   study.ID.String(),
+		Reason:      "DSR-1234",
+	}
+	erasure, err := svc.RequestErasure(requester, data)
+	require.NoError(t, err)
+	assert.Equal(t, types.ErasureStatusPending, erasure.Status)
+	assert.Equal(t, 1, erasure.Manifest.Rows["studies"])
+	assert.Equal(t, 1, erasure.Manifest.Rows["contracts"])
+	assert.Equal(t, 1, erasure.Manifest.Rows["asset_locations"])
+	assert.Equal(t, 1, erasure.Manifest.Rows["notifications"])
+	contractObjectMetadata := s3.ObjectMetadata{Id: contractObject.ID, Kind: s3.ContractKind}
+	assert.Equal(t, []string{contractObjectMetadata.Key()}, erasure.Manifest.Objects)
+
+	_, err = svc.RequestErasure(requester, data)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // already pending
+
+	_, err = svc.ApproveErasure(requester, erasure.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // same administrator
+
+	mockS3.On("DeleteObject", contractObjectMetadata).Return(errors.New("unavailable")).Once()
+	erasure, err = svc.ApproveErasure(reviewer, erasure.ID)
+	require.NoError(t, err) // rows are erased even though the object is not yet
+	assert.Equal(t, types.ErasureStatusCompleted, erasure.Status)
+	assert.NotNil(t, erasure.CompletedAt)
+	assert.Equal(t, reviewer.Username, erasure.ReviewerUser.Username)
+	require.NotNil(t, erasure.Cleanup)
+	assert.Equal(t, []types.ErasureObject{{ID: contractObject.ID, Kind: string(s3.ContractKind)}}, erasure.Cleanup.Objects)
+	assert.False(t, erasure.Cleanup.Roles)
+
+	mockS3.On("DeleteObject", contractObjectMetadata).Return(nil).Once()
+	require.NoError(t, svc.RetryErasureCleanups())
+	erasure, err = svc.GetErasure(erasure.ID)
+	require.NoError(t, err)
+	assert.Nil(t, erasure.Cleanup)
+	mockS3.AssertExpectations(t)
+
+	var numStudies, numContracts, numNotifications int64
+	require.NoError(t, db.Unscoped().Model(&types.Study{}).Where("id = ?", study.ID).Count(&numStudies).Error)
+	require.NoError(t, db.Unscoped().Model(&types.Contract{}).Where("study_id = ?", study.ID).Count(&numContracts).Error)
+	require.NoError(t, db.Model(&types.Notification{}).Where("recipient_user_id = ?", owner.ID).Count(&numNotifications).Error)
+	assert.Zero(t, numStudies)
+	assert.Zero(t, numContracts)
+	assert.Zero(t, numNotifications)
+
+	ownedStudyIDs, err := rbac.StudyIDsWithRole(owner, rbac.StudyOwner)
+	require.NoError(t, err)
+	assert.NotContains(t, ownedStudyIDs, study.ID)
+
+	_, err = svc.ApproveErasure(reviewer, erasure.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not pending
+}
+
+func TestIntegration_StudyErasureKeepsOtherStudies(t *testing.T) {
+	db := mockdb.NewTestDBSchema(t, migrate)
+	rbac.Init()
+
+	svc := &Service{db: db, s3: new(mockcontrollers.MockS3)}
+
+	requester := types.User{Username: "requester@example.com"}
+	reviewer := types.User{Username: "reviewer@example.com"}
+	owner := types.User{Username: "owner@example.com"}
+	require.NoError(t, db.Create(&[]*types.User{&requester, &reviewer, &owner}).Error)
+
+	study := types.Study{OwnerUserID: owner.ID, Title: "erased"}
+	other := types.Study{OwnerUserID: owner.ID, Title: "kept"}
+	require.NoError(t, db.Create(&[]*types.Study{&study, &other}).Error)
+
+	moved := types.Asset{CreatorUserID: owner.ID, StudyID: other.ID, Title: "moved", Status: types.AssetStatusActive}
+	staying := types.Asset{CreatorUserID: owner.ID, StudyID: other.ID, Title: "staying", Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&[]*types.Asset{&moved, &staying}).Error)
+	completed := types.AssetTransfer{
+		AssetID:         moved.ID,
+		SourceStudyID:   &study.ID,
+		TargetStudyID:   &other.ID,
+		RequesterUserID: owner.ID,
+		Reason:          "moved",
+		Status:          types.AssetTransferStatusCompleted,
+	}
+	pending := types.AssetTransfer{
+		AssetID:         staying.ID,
+		SourceStudyID:   &other.ID,
+		TargetStudyID:   &study.ID,
+		RequesterUserID: owner.ID,
+		Reason:          "moving",
+		Status:          types.AssetTransferStatusPending,
+	}
+	require.NoError(t, db.Create(&[]*types.AssetTransfer{&completed, &pending}).Error)
+
+	erasure, err := svc.RequestErasure(requester, openapi.ErasureRequest{
+		SubjectKind: openapi.ErasureSubjectKindStudy,
+		SubjectId:   study.ID.String(),
+		Reason:      "DSR-1",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, erasure.Manifest.Rows["asset_transfers"])
+	_, err = svc.ApproveErasure(reviewer, erasure.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, db.First(&completed, completed.ID).Error)
+	assert.Nil(t, completed.SourceStudyID)
+	assert.Equal(t, &other.ID, completed.TargetStudyID)
+	require.NoError(t, db.First(&pending, pending.ID).Error)
+	assert.Equal(t, types.AssetTransferStatusRejected, pending.Status)
+	assert.Equal(t, &other.ID, pending.SourceStudyID)
+	assert.Nil(t, pending.TargetStudyID)
+
+	var numAssets int64
+	require.NoError(t, db.Model(&types.Asset{}).Where("study_id = ?", other.ID).Count(&numAssets).Error)
+	assert.Equal(t, int64(2), numAssets)
+}
+
+func TestIntegration_UserErasure(t *testing.T) {
+	db := mockdb.NewTestDBSchema(t, migrate)
+	rbac.Init()
+
+	svc := &Service{db: db, s3: new(mockcontrollers.MockS3)}
+
+	requester := types.User{Username: "requester@example.com"}
+	reviewer := types.User{Username: "reviewer@example.com"}
+	owner := types.User{Username: "owner@example.com"}
+	subject := types.User{Username: "subject@example.com"}
+	require.NoError(t, db.Create(&[]*types.User{&requester, &reviewer, &owner, &subject}).Error)
+	require.NoError(t, db.Create(&types.UserAttributes{UserID: subject.ID, ChosenName: "Subject"}).Error)
+	_, err := rbac.AddRole(subject, rbac.ApprovedResearcher)
+	require.NoError(t, err)
+
+	study := types.Study{OwnerUserID: owner.ID, Title: "kept"}
+	require.NoError(t, db.Create(&study).Error)
+	require.NoError(t, db.Create(&types.StudyAdmin{StudyID: study.ID, UserID: subject.ID}).Error)
+	revision := types.StudyRevision{StudyID: study.ID, UserID: owner.ID}
+	require.NoError(t, db.Create(&revision).Error)
+	change := types.StudyRevisionChange{
+		RevisionID: revision.ID,
+		Field:      "additional_study_admin_usernames",
+		OldValue:   new("other@example.com"),
+		NewValue:   new("other@example.com, subject@example.com"),
+	}
+	require.NoError(t, db.Create(&change).Error)
+
+	_, err = svc.RequestErasure(requester, openapi.ErasureRequest{
+		SubjectKind: openapi.ErasureSubjectKindUser,
+		SubjectId:   owner.ID.String(),
+		Reason:      "DSR-1",
+	})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // owns a study
+
+	erasure, err := svc.RequestErasure(requester, openapi.ErasureRequest{
+		SubjectKind: openapi.ErasureSubjectKindUser,
+		SubjectId:   subject.ID.String(),
+		Reason:      "DSR-2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, erasure.Manifest.Rows["user_attributes"])
+	assert.Equal(t, 1, erasure.Manifest.Rows["study_admins"])
+
+	rejected, err := svc.RejectErasure(reviewer, erasure.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ErasureStatusRejected, rejected.Status)
+
+	erasure, err = svc.RequestErasure(requester, openapi.ErasureRequest{
+		SubjectKind: openapi.ErasureSubjectKindUser,
+		SubjectId:   subject.ID.String(),
+		Reason:      "DSR-2",
+	})
+	require.NoError(t, err)
+	erasure, err = svc.ApproveErasure(reviewer, erasure.ID)
+	require.NoError(t, err)
+	assert.Nil(t, erasure.Cleanup)
+
+	require.NoError(t, db.First(&subject, subject.ID).Error)
+	assert.Equal(t, types.Username(subject.ID.String()+"@erased.invalid"), subject.Username)
+	require.NoError(t, db.First(&change, change.ID).Error)
+	assert.Equal(t, "other@example.com", *change.OldValue)
+	assert.Equal(t, "other@example.com, "+subject.ID.String()+"@erased.invalid", *change.NewValue)
+
+	var numAttributes int64
+	require.NoError(t, db.Model(&types.UserAttributes{}).Where("user_id = ?", subject.ID).Count(&numAttributes).Error)
+	assert.Zero(t, numAttributes)
+	assert.Empty(t, must(rbac.Roles(subject)))
+
+	erasures, err := svc.Erasures()
+	require.NoError(t, err)
+	assert.Len(t, erasures, 2)
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
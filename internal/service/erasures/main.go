@@ -0,0 +1,338 @@
+package erasures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/objects"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	erasedUsernameDomain   = "erased.invalid"
+	revisionUsernamesField = "additional_study_admin_usernames" // Revision field holding a list of usernames
+)
+
+type Service struct {
+	db *gorm.DB
+	s3 s3.Interface
+}
+
+func New() *Service {
+	return &Service{
+		db: graceful.NewDB(),
+		s3: objects.New(),
+	}
+}
+
+// All erasures, most recently requested first
+func (s *Service) Erasures() ([]types.Erasure, error) {
+	erasures := []types.Erasure{}
+	err := s.db.Preload("RequesterUser").Preload("ReviewerUser").
+		Order("created_at DESC").
+		Find(&erasures).Error
+	return erasures, types.NewErrFromGorm(err, "failed to get erasures")
+}
+
+func (s *Service) GetErasure(id uuid.UUID) (*types.Erasure, error) {
+	erasure := types.Erasure{}
+	err := s.db.Preload("RequesterUser").Preload("ReviewerUser").Where("id = ?", id).First(&erasure).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get erasure")
+	}
+	return &erasure, nil
+}
+
+// Request the erasure of a study or user. Nothing is removed until the
+// erasure is approved by a second administrator
+func (s *Service) RequestErasure(requester types.User, data openapi.ErasureRequest) (*types.Erasure, error) {
+	if !data.SubjectKind.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("Invalid subject kind [%v]", data.SubjectKind)
+	} else if strings.TrimSpace(data.Reason) == "" {
+		return nil, types.NewErrClientInvalidObjectF("A reason is required")
+	}
+	subjectID, err := uuid.Parse(data.SubjectId)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("Invalid subject ID [%v]", data.SubjectId)
+	}
+	kind := types.ErasureSubjectKind(data.SubjectKind)
+
+	if err := s.validateSubject(s.db, kind, subjectID); err != nil {
+		return nil, err
+	}
+
+	var numPending int64
+	err = s.db.Model(&types.Erasure{}).
+		Where("subject_id = ? AND status = ?", subjectID, types.ErasureStatusPending).
+		Count(&numPending).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to check for pending erasures")
+	} else if numPending > 0 {
+		return nil, types.NewErrClientInvalidObjectF("An erasure of this %s is already pending", kind)
+	}
+
+	plan, err := s.plan(s.db, kind, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := plan.manifest(s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	erasure := types.Erasure{
+		SubjectKind:     kind,
+		SubjectID:       subjectID,
+		Reason:          data.Reason,
+		Status:          types.ErasureStatusPending,
+		RequesterUserID: requester.ID,
+		Manifest:        manifest,
+	}
+	if err := s.db.Create(&erasure).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to create erasure")
+	}
+	log.Info().Any("erasureID", erasure.ID).Any("kind", kind).Msg("Requested erasure")
+	return s.GetErasure(erasure.ID)
+}
+
+// Approve a pending erasure and permanently remove its subject. The reviewer
+// must not be the administrator who requested it
+func (s *Service) ApproveErasure(reviewer types.User, id uuid.UUID) (*types.Erasure, error) {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	// Lock the erasure so concurrent approvals or a rejection wait for this one
+	erasure, err := pendingErasure(tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if erasure.RequesterUserID == reviewer.ID {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("An erasure must be approved by a different administrator to the requester")
+	}
+
+	// Subject may have changed since the request so validate and plan again
+	if err := s.validateSubject(tx, erasure.SubjectKind, erasure.SubjectID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	plan, err := s.plan(tx, erasure.SubjectKind, erasure.SubjectID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	log.Info().Any("erasureID", erasure.ID).Any("kind", erasure.SubjectKind).Msg("Erasing")
+	manifest, err := plan.execute(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if erasure.SubjectKind == types.ErasureSubjectKindUser {
+		if err := anonymiseUser(tx, erasure.SubjectID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = tx.Model(erasure).Updates(types.Erasure{
+		Status:         types.ErasureStatusCompleted,
+		ReviewerUserID: &reviewer.ID,
+		Manifest:       manifest,
+		CompletedAt:    new(time.Now()),
+		Cleanup:        plan.cleanup(),
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to complete erasure")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to commit erasure transaction")
+	}
+
+	// Rows are gone so the erasure stands even if this fails, in which case
+	// the remainder is retried by RetryErasureCleanups
+	erasure, err = s.GetErasure(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cleanupErasure(erasure); err != nil {
+		log.Err(err).Any("erasureID", erasure.ID).Msg("Failed to clean up erasure, will retry")
+	}
+	return s.GetErasure(id)
+}
+
+// Retry removing the stored objects and roles of completed erasures which
+// could not be removed when they were approved
+func (s *Service) RetryErasureCleanups() error {
+	erasures := []types.Erasure{}
+	err := s.db.Where("status = ? AND cleanup IS NOT NULL", types.ErasureStatusCompleted).
+		Order("completed_at").
+		Find(&erasures).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get erasures to clean up")
+	}
+
+	errs := []error{}
+	for i := range erasures {
+		if err := s.cleanupErasure(&erasures[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Remove the stored objects and roles left by a completed erasure, recording
+// whatever could not be removed
+func (s *Service) cleanupErasure(erasure *types.Erasure) error {
+	if erasure.Cleanup == nil {
+		return nil
+	}
+	remaining := *erasure.Cleanup
+	remaining.Objects = []types.ErasureObject{}
+	errs := []error{}
+	for _, object := range erasure.Cleanup.Objects {
+		metadata := s3.ObjectMetadata{Id: object.ID, Kind: s3.ObjectKind(object.Kind)}
+		if err := s.s3.DeleteObject(metadata); err != nil {
+			remaining.Objects = append(remaining.Objects, object)
+			errs = append(errs, err)
+		}
+	}
+	if remaining.Roles {
+		if err := deleteRoles(erasure.SubjectKind, erasure.SubjectID, remaining.ProjectIDs); err != nil {
+			errs = append(errs, err)
+		} else {
+			remaining.Roles = false
+		}
+	}
+
+	cleanup := &remaining
+	if remaining.Done() {
+		cleanup = nil
+	} else {
+		log.Warn().Any("erasureID", erasure.ID).Int("numObjects", len(remaining.Objects)).
+			Bool("roles", remaining.Roles).Msg("Erasure cleanup incomplete")
+	}
+	err := s.db.Model(erasure).Select("Cleanup").Updates(types.Erasure{Cleanup: cleanup}).Error
+	if err != nil {
+		errs = append(errs, types.NewErrFromGorm(err, "failed to update erasure cleanup"))
+	}
+	return errors.Join(errs...)
+}
+
+// Reject a pending erasure. Nothing is removed
+func (s *Service) RejectErasure(reviewer types.User, id uuid.UUID) (*types.Erasure, error) {
+	erasure, err := pendingErasure(s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	// Conditional so an erasure approved in the meantime is not marked rejected
+	result := s.db.Model(erasure).Where("status = ?", types.ErasureStatusPending).Updates(types.Erasure{
+		Status:         types.ErasureStatusRejected,
+		ReviewerUserID: &reviewer.ID,
+	})
+	if result.Error != nil {
+		return nil, types.NewErrFromGorm(result.Error, "failed to reject erasure")
+	} else if result.RowsAffected == 0 {
+		return nil, types.NewErrClientInvalidObjectF("Erasure is no longer pending")
+	}
+	return s.GetErasure(id)
+}
+
+// Check the subject of an erasure exists and can be erased
+func (s *Service) validateSubject(db *gorm.DB, kind types.ErasureSubjectKind, subjectID uuid.UUID) error {
+	switch kind {
+	case types.ErasureSubjectKindStudy:
+		study := types.Study{}
+		if err := db.Unscoped().Where("id = ?", subjectID).First(&study).Error; err != nil {
+			return types.NewErrFromGorm(err, "failed to get study")
+		}
+		return nil
+
+	case types.ErasureSubjectKindUser:
+		user := types.User{}
+		if err := db.Where("id = ?", subjectID).First(&user).Error; err != nil {
+			return types.NewErrFromGorm(err, "failed to get user")
+		}
+		var numOwnedStudies int64
+		if err := db.Unscoped().Model(&types.Study{}).Where("owner_user_id = ?", subjectID).Count(&numOwnedStudies).Error; err != nil {
+			return types.NewErrFromGorm(err, "failed to count owned studies")
+		} else if numOwnedStudies > 0 {
+			return types.NewErrClientInvalidObjectF("User owns %d studies which must be erased or transferred first", numOwnedStudies)
+		}
+		return nil
+
+	default:
+		return types.NewErrInvalidObjectF("unknown erasure subject kind [%v]", kind)
+	}
+}
+
+func (s *Service) plan(db *gorm.DB, kind types.ErasureSubjectKind, subjectID uuid.UUID) (*erasurePlan, error) {
+	switch kind {
+	case types.ErasureSubjectKindStudy:
+		return studyErasurePlan(db, subjectID)
+	case types.ErasureSubjectKindUser:
+		return userErasurePlan(subjectID), nil
+	default:
+		return nil, types.NewErrInvalidObjectF("unknown erasure subject kind [%v]", kind)
+	}
+}
+
+func deleteRoles(kind types.ErasureSubjectKind, subjectID uuid.UUID, projectIDs []uuid.UUID) error {
+	switch kind {
+	case types.ErasureSubjectKindStudy:
+		return rbac.DeleteStudyRoles(subjectID, projectIDs)
+	case types.ErasureSubjectKindUser:
+		return rbac.DeleteUserRoles(types.User{Model: types.Model{ID: subjectID}})
+	default:
+		return types.NewErrInvalidObjectF("unknown erasure subject kind [%v]", kind)
+	}
+}
+
+// Replace the username of a user, including where it was copied into the
+// revision history of studies they administered
+func anonymiseUser(tx *gorm.DB, userID uuid.UUID) error {
+	user := types.User{}
+	if err := tx.Where("id = ?", userID).First(&user).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get user")
+	}
+	username := fmt.Sprintf("%v@%s", userID, erasedUsernameDomain)
+	if err := tx.Model(&user).Update("username", username).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to anonymise user")
+	}
+
+	args := map[string]any{"field": revisionUsernamesField, "username": string(user.Username), "erased": username}
+	for _, column := range []string{"old_value", "new_value"} {
+		err := tx.Exec(fmt.Sprintf(
+			"UPDATE study_revision_changes SET %[1]s = array_to_string(array_replace(string_to_array(%[1]s, ', '), @username, @erased), ', ') "+
+				"WHERE field = @field AND @username = ANY(string_to_array(%[1]s, ', '))", column,
+		), args).Error
+		if err != nil {
+			return types.NewErrFromGorm(err, "failed to anonymise study revisions")
+		}
+	}
+	return nil
+}
+
+func pendingErasure(db *gorm.DB, id uuid.UUID) (*types.Erasure, error) {
+	erasure := types.Erasure{}
+	err := db.Where("id = ?", id).First(&erasure).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get erasure")
+	} else if erasure.Status != types.ErasureStatusPending {
+		return nil, types.NewErrClientInvalidObjectF("Erasure is %s, not pending", erasure.Status)
+	}
+	return &erasure, nil
+}
@@ -0,0 +1,212 @@
+package erasures
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Rows of a table to hard delete. The condition may reference the subject as @id
+type erasureStep struct {
+	table     string
+	condition string
+}
+
+// Rows of a table kept when erasing the subject, as they belong to another
+// study, but updated to no longer reference it. Both may reference the subject as @id
+type erasureDetachment struct {
+	table     string
+	set       string
+	condition string
+}
+
+// Everything to remove for the subject of an erasure
+type erasurePlan struct {
+	subjectID   uuid.UUID
+	detachments []erasureDetachment // applied before the steps
+	steps       []erasureStep       // ordered so rows are deleted before those they reference
+	objects     []s3.ObjectMetadata
+	projectIDs  []uuid.UUID // projects whose roles must be removed
+}
+
+// Transfers of assets now in another study are its history, so are kept
+// without the erased study. Pending transfers into the study can no longer complete
+var studyErasureDetachments = []erasureDetachment{
+	{"asset_transfers", "status = 'rejected', reviewed_at = now()", "status = 'pending' AND target_study_id = @id"},
+	{"asset_transfers", "source_study_id = NULL", "source_study_id = @id"},
+	{"asset_transfers", "target_study_id = NULL", "target_study_id = @id"},
+}
+
+// Rows of a study including soft deleted ones, children first. Asset links
+// belong to the child, which is always in the same study as its parent
+var studyErasureSteps = []erasureStep{
+	{"contract_assets", "contract_id IN (SELECT id FROM contracts WHERE study_id = @id) OR asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"contract_object_downloads", "contract_id IN (SELECT id FROM contracts WHERE study_id = @id)"},
+	{"contract_object_metadata", "contract_id IN (SELECT id FROM contracts WHERE study_id = @id)"},
+	{"contract_versions", "contract_id IN (SELECT id FROM contracts WHERE study_id = @id)"},
+	{"contracts", "study_id = @id"},
+	{"project_assets", "project_id IN (SELECT id FROM projects WHERE study_id = @id) OR asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"asset_locations", "project_id IN (SELECT id FROM projects WHERE study_id = @id) OR asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"project_tre_role_bindings", "project_tre_id IN (SELECT id FROM project_tres WHERE project_id IN (SELECT id FROM projects WHERE study_id = @id))"},
+	{"project_tre_user_configs", "project_tre_id IN (SELECT id FROM project_tres WHERE project_id IN (SELECT id FROM projects WHERE study_id = @id))"},
+	{"project_tre_suspension_changelogs", "project_tre_id IN (SELECT id FROM project_tres WHERE project_id IN (SELECT id FROM projects WHERE study_id = @id))"},
+	{"project_tres", "project_id IN (SELECT id FROM projects WHERE study_id = @id)"},
+	{"project_dsh_role_bindings", "project_dsh_id IN (SELECT id FROM project_dshes WHERE project_id IN (SELECT id FROM projects WHERE study_id = @id))"},
+	{"project_dshes", "project_id IN (SELECT id FROM projects WHERE study_id = @id)"},
+	{"projects", "study_id = @id"},
+	{"study_review_comments", "thread_id IN (SELECT id FROM study_review_threads WHERE study_id = @id)"},
+	{"study_review_threads", "study_id = @id"},
+	{"asset_transfers", "asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"asset_expiry_reviews", "asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"asset_destructions", "asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"asset_links", "child_asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"asset_data_types", "asset_id IN (SELECT id FROM assets WHERE study_id = @id)"},
+	{"assets", "study_id = @id"},
+	{"dpia_answers", "dpia_id IN (SELECT id FROM dpia WHERE study_id = @id)"},
+	{"dpia_risks", "dpia_id IN (SELECT id FROM dpia WHERE study_id = @id)"},
+	{"dpia", "study_id = @id"},
+	{"study_document_versions", "document_id IN (SELECT id FROM study_documents WHERE study_id = @id)"},
+	{"study_documents", "study_id = @id"},
+	{"regulatory_approvals", "study_id = @id"},
+	{"study_revision_changes", "revision_id IN (SELECT id FROM study_revisions WHERE study_id = @id)"},
+	{"study_revisions", "study_id = @id"},
+	{"study_signoffs", "study_id = @id"},
+	{"study_owner_changelogs", "study_id = @id"},
+	{"study_agreement_signatures", "study_id = @id"},
+	{"study_admins", "study_id = @id"},
+	{"notifications", "href LIKE '%' || @id || '%'"},
+	{"studies", "id = @id"},
+}
+
+// Personal data of a user. The user itself is kept, with an anonymised
+// username, as it is referenced by records of other studies and users
+var userErasureSteps = []erasureStep{
+	{"user_attributes", "user_id = @id"},
+	{"user_training_records", "user_id = @id"},
+	{"user_agreement_confirmations", "user_id = @id"},
+	{"user_sponsorships", "user_id = @id OR sponsor_id = @id"},
+	{"study_admins", "user_id = @id"},
+	{"project_tre_role_bindings", "user_id = @id"},
+	{"project_tre_user_configs", "user_id = @id"},
+	{"project_dsh_role_bindings", "user_id = @id"},
+	{"notifications", "recipient_user_id = @id"},
+}
+
+func studyErasurePlan(tx *gorm.DB, studyID uuid.UUID) (*erasurePlan, error) {
+	plan := erasurePlan{subjectID: studyID, detachments: studyErasureDetachments, steps: studyErasureSteps}
+
+	contractObjects := []types.ContractObjectMetadata{}
+	err := tx.Unscoped().Select("id", "scan_status").
+		Where("contract_id IN (?)", tx.Unscoped().Model(&types.Contract{}).Select("id").Where("study_id = ?", studyID)).
+		Find(&contractObjects).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get contract objects")
+	}
+	for _, object := range contractObjects {
+		kind := s3.ContractKind
+		if object.IsQuarantined() {
+			kind = s3.ContractQuarantineKind
+		}
+		plan.addObjects(kind, []uuid.UUID{object.ID})
+	}
+
+	objectIDs := []uuid.UUID{}
+	err = tx.Unscoped().Model(&types.RegulatoryApproval{}).
+		Where("study_id = ? AND letter_filename IS NOT NULL", studyID).
+		Pluck("id", &objectIDs).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get approval letters")
+	}
+	plan.addObjects(s3.ApprovalLetterKind, objectIDs)
+
+	objectIDs = []uuid.UUID{}
+	err = tx.Unscoped().Model(&types.StudyDocumentVersion{}).
+		Where("document_id IN (?)", tx.Unscoped().Model(&types.StudyDocument{}).Select("id").Where("study_id = ?", studyID)).
+		Pluck("id", &objectIDs).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get study document versions")
+	}
+	plan.addObjects(s3.StudyDocumentKind, objectIDs)
+
+	objectIDs = []uuid.UUID{}
+	err = tx.Model(&types.AssetDestruction{}).
+		Where("asset_id IN (?)", tx.Unscoped().Model(&types.Asset{}).Select("id").Where("study_id = ?", studyID)).
+		Pluck("id", &objectIDs).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get destruction certificates")
+	}
+	plan.addObjects(s3.DestructionCertificateKind, objectIDs)
+
+	err = tx.Unscoped().Model(&types.Project{}).Where("study_id = ?", studyID).Pluck("id", &plan.projectIDs).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get study projects")
+	}
+	return &plan, nil
+}
+
+func userErasurePlan(userID uuid.UUID) *erasurePlan {
+	return &erasurePlan{subjectID: userID, steps: userErasureSteps}
+}
+
+func (p *erasurePlan) addObjects(kind s3.ObjectKind, ids []uuid.UUID) {
+	for _, id := range ids {
+		p.objects = append(p.objects, s3.ObjectMetadata{Id: id, Kind: kind})
+	}
+}
+
+// Count what the plan will remove without removing anything
+func (p *erasurePlan) manifest(tx *gorm.DB) (types.ErasureManifest, error) {
+	manifest := p.emptyManifest()
+	for _, step := range p.steps {
+		var count int64
+		if err := tx.Table(step.table).Where(step.condition, p.args()).Count(&count).Error; err != nil {
+			return manifest, types.NewErrFromGorm(err, fmt.Sprintf("failed to count %s", step.table))
+		}
+		manifest.Rows[step.table] += int(count)
+	}
+	return manifest, nil
+}
+
+// Detach then hard delete the rows of the plan, returning what was removed.
+// Stored objects are not deleted
+func (p *erasurePlan) execute(tx *gorm.DB) (types.ErasureManifest, error) {
+	manifest := p.emptyManifest()
+	for _, detachment := range p.detachments {
+		err := tx.Exec(fmt.Sprintf("UPDATE %s SET %s WHERE %s", detachment.table, detachment.set, detachment.condition), p.args()).Error
+		if err != nil {
+			return manifest, types.NewErrFromGorm(err, fmt.Sprintf("failed to detach %s", detachment.table))
+		}
+	}
+	for _, step := range p.steps {
+		result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", step.table, step.condition), p.args())
+		if result.Error != nil {
+			return manifest, types.NewErrFromGorm(result.Error, fmt.Sprintf("failed to delete %s", step.table))
+		}
+		manifest.Rows[step.table] += int(result.RowsAffected)
+	}
+	return manifest, nil
+}
+
+// Stored objects and roles to remove once the rows have been committed
+func (p *erasurePlan) cleanup() *types.ErasureCleanup {
+	cleanup := types.ErasureCleanup{Objects: []types.ErasureObject{}, ProjectIDs: p.projectIDs, Roles: true}
+	for _, object := range p.objects {
+		cleanup.Objects = append(cleanup.Objects, types.ErasureObject{ID: object.Id, Kind: string(object.Kind)})
+	}
+	return &cleanup
+}
+
+func (p *erasurePlan) emptyManifest() types.ErasureManifest {
+	manifest := types.ErasureManifest{Rows: map[string]int{}, Objects: []string{}}
+	for _, object := range p.objects {
+		manifest.Objects = append(manifest.Objects, object.Key())
+	}
+	return manifest
+}
+
+func (p *erasurePlan) args() map[string]any {
+	return map[string]any{"id": p.subjectID}
+}
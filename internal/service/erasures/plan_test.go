@@ -0,0 +1,38 @@
+package erasures
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+)
+
+func TestStudyErasureStepsOrder(t *testing.T) {
+	fromTable := regexp.MustCompile(`FROM (\w+)`)
+	tables := []string{}
+	for _, step := range studyErasureSteps {
+		tables = append(tables, step.table)
+	}
+
+	for i, step := range studyErasureSteps {
+		for _, match := range fromTable.FindAllStringSubmatch(step.condition, -1) {
+			// Referenced rows must still exist when this step runs
+			assert.Contains(t, tables[i+1:], match[1], "step [%v] references [%v]", step.table, match[1])
+		}
+	}
+	assert.Equal(t, "studies", tables[len(tables)-1])
+}
+
+func TestErasurePlanManifestObjects(t *testing.T) {
+	plan := userErasurePlan(uuid.New())
+	assert.Empty(t, plan.emptyManifest().Objects)
+
+	id := uuid.New()
+	plan.addObjects(s3.ContractKind, []uuid.UUID{id})
+	manifest := plan.emptyManifest()
+	assert.True(t, slices.Contains(manifest.Objects, "contract/"+id.String()))
+	assert.Empty(t, manifest.Rows)
+}
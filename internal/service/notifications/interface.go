@@ -13,12 +13,20 @@ type Interface interface {
 
 	NotifyToCompleteProfile(user types.User) error
 	NotifyContractExpiry(ctx context.Context, contract types.Contract, study types.Study) error
+	NotifyRegulatoryApprovalExpiry(ctx context.Context, approval types.RegulatoryApproval, study types.Study) error
 	NotifyTrainingExpiry(ctx context.Context, training types.UserTrainingRecord) error
 	NotifyStudyReview(ctx context.Context, study types.Study, igOpsStaff []types.User) error
+	NotifyReviewerAssignment(ctx context.Context, study types.Study, reviewer types.User) error
 	NotifyIaaAssignment(ctx context.Context, iaa types.User, study types.Study) error
 	NotifyStudySignoffExpiry(ctx context.Context, study types.Study) error
 	NotifyAssetExpiry(ctx context.Context, assets []types.Asset, study types.Study) error
+	NotifyAssetExpiryEnforced(ctx context.Context, study types.Study, review types.AssetExpiryReview) error
+	NotifyMalwareDetected(ctx context.Context, contract types.Contract, object types.ContractObjectMetadata, igOpsStaff []types.User) error
+	NotifyStoredObjectsFailedVerification(ctx context.Context, objects []types.StoredObject, admins []types.User) error
+	NotifyDpiaReview(ctx context.Context, dpia types.Dpia) error
 	NotifyOwnerChange(ctx context.Context, study types.Study, igOpsStaff []types.User) error
+	NotifyOwnerChangeOutcome(ctx context.Context, study types.Study, change types.StudyOwnerChangelog) error
 	NotifyUserNameChange(attrs types.UserAttributes, igOpsStaff []types.User) error
 	NotifyProjectDeployed(project types.Project, user types.User) error
+	NotifyProjectSuspension(ctx context.Context, study types.Study, change types.ProjectTRESuspensionChangelog) error
 }
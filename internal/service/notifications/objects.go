@@ -0,0 +1,34 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func (s *Service) NotifyStoredObjectsFailedVerification(ctx context.Context, objects []types.StoredObject, admins []types.User) error {
+	log.Debug().Int("numObjects", len(objects)).Msg("Notifying stored objects failed verification")
+	lines := []string{}
+	for _, object := range objects {
+		lines = append(lines, fmt.Sprintf("%s/%s (%s)", object.Kind, object.ObjectID.String(), object.Status))
+	}
+	content := template.HTML(fmt.Sprintf( // #nosec G203 -- all content is escaped
+		"%d stored object(s) failed integrity verification and may need to be restored from a backup:<br>%s",
+		len(objects),
+		template.HTMLEscapeString(strings.Join(lines, ", ")),
+	))
+	subject := "Notification: Stored objects failed integrity verification"
+	if err := s.entra.SendEmail(ctx, subject, emails(admins...), content); err != nil {
+		log.Err(err).Msg("Failed to send stored objects failed verification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("%d stored object(s) failed integrity verification", len(objects)),
+		Body:  new(strings.Join(lines, "\n")),
+		Kind:  new(types.NotificationKindObjectIntegrity),
+	}
+	return s.createForAll(notification, admins)
+}
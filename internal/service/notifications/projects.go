@@ -1,7 +1,9 @@
 package notifications
 
 import (
+	"context"
 	"fmt"
+	"html/template"
 
 	"github.com/rs/zerolog/log"
 	"github.com/ucl-arc-tre/portal/internal/types"
@@ -15,3 +17,41 @@ func (s *Service) NotifyProjectDeployed(project types.Project, user types.User)
 	}
 	return s.create(notification, user)
 }
+
+func (s *Service) NotifyProjectSuspension(ctx context.Context, study types.Study, change types.ProjectTRESuspensionChangelog) error {
+	outcome := ""
+	switch change.Action {
+	case types.ProjectTRESuspensionActionSuspend:
+		outcome = "suspended"
+	case types.ProjectTRESuspensionActionReinstate:
+		outcome = "reinstated"
+	default:
+		return types.NewErrInvalidObjectF("cannot notify project suspension of action [%v]", change.Action)
+	}
+
+	projectName := change.ProjectTRE.Project.Name
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+	content := template.HTML(fmt.Sprintf( // #nosec G203 -- href is trusted and names are escaped
+		"The TRE project '%s' of the Study %s has been %s. Reason: %s. ",
+		template.HTMLEscapeString(projectName),
+		href,
+		outcome,
+		template.HTMLEscapeString(change.Reason),
+	))
+	if change.Action == types.ProjectTRESuspensionActionSuspend {
+		content += "Please sign in to the Portal to sign off the Study so that the project can be reinstated."
+	}
+
+	recipients := study.NotificationRecipients()
+	subject := fmt.Sprintf("Notification: Project '%s' has been %s", projectName, outcome)
+	if err := s.entra.SendEmail(ctx, subject, emails(recipients...), content); err != nil {
+		log.Err(err).Msg("Failed to send project suspension notification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("'%s' has been %s", projectName, outcome),
+		Body:  &change.Reason,
+		Href:  new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:  new(types.NotificationKindProjectSuspended),
+	}
+	return s.createForAll(notification, recipients)
+}
@@ -57,6 +57,22 @@ func (s *Service) NotifyStudyReview(ctx context.Context, study types.Study, igOp
 	return nil
 }
 
+func (s *Service) NotifyReviewerAssignment(ctx context.Context, study types.Study, reviewer types.User) error {
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+
+	content := template.HTML("You have been assigned to review the Study " + href + ".") // #nosec G203 -- href is trusted
+	subject := "Notification: Study review assignment"
+	if err := s.entra.SendEmail(ctx, subject, emails(reviewer), content); err != nil {
+		log.Err(err).Msg("Failed to send reviewer assignment notification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("You have been assigned to review '%s'", study.Title),
+		Href:  new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:  new(types.NotificationKindStudyReview),
+	}
+	return s.create(notification, reviewer)
+}
+
 func (s *Service) NotifyOwnerChange(ctx context.Context, study types.Study, igOpsStaff []types.User) error {
 	log.Debug().Any("oldOwner", study.Owner.Username).Msg("Notifying Study owner change")
 	notification := types.Notification{
@@ -68,6 +84,47 @@ func (s *Service) NotifyOwnerChange(ctx context.Context, study types.Study, igOp
 	return s.createForAll(notification, igOpsStaff)
 }
 
+// Notify the old and proposed owner of a study of the outcome of an owner change request
+func (s *Service) NotifyOwnerChangeOutcome(ctx context.Context, study types.Study, change types.StudyOwnerChangelog) error {
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+	outcome := ""
+	switch change.Action {
+	case types.StudyOwnerChangelogActionApprove:
+		outcome = "approved"
+	case types.StudyOwnerChangelogActionReject:
+		outcome = "rejected"
+	case types.StudyOwnerChangelogActionCancel:
+		outcome = "cancelled"
+	case types.StudyOwnerChangelogActionExpire:
+		outcome = "expired"
+	default:
+		return types.NewErrInvalidObjectF("cannot notify owner change outcome of action [%v]", change.Action)
+	}
+
+	content := template.HTML(fmt.Sprintf( // #nosec G203 -- href is trusted and usernames are escaped
+		"The request to change the owner of the Study %s from %s to %s has been %s.",
+		href,
+		template.HTMLEscapeString(string(change.FromUser.Username)),
+		template.HTMLEscapeString(string(change.ToUser.Username)),
+		outcome,
+	))
+	if change.Reason != nil {
+		content += template.HTML(" Reason: " + template.HTMLEscapeString(*change.Reason)) // #nosec G203 -- reason is escaped
+	}
+	recipients := []types.User{change.FromUser, change.ToUser}
+	subject := fmt.Sprintf("Notification: Study owner change %s", outcome)
+	if err := s.entra.SendEmail(ctx, subject, emails(recipients...), content); err != nil {
+		log.Err(err).Msg("Failed to send owner change outcome notification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("Owner change of '%s' to '%s' has been %s", study.Title, change.ToUser.Username, outcome),
+		Body:  change.Reason,
+		Href:  new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:  new(types.NotificationKindStudyOwnerChange),
+	}
+	return s.createForAll(notification, recipients)
+}
+
 func (s *Service) NotifyContractExpiry(ctx context.Context, contract types.Contract, study types.Study) error {
 	days := config.DaysUntilContractExpiry(contract)
 	if days == nil {
@@ -101,6 +158,39 @@ func (s *Service) NotifyContractExpiry(ctx context.Context, contract types.Contr
 	return s.createForAll(notification, recipients)
 }
 
+func (s *Service) NotifyRegulatoryApprovalExpiry(ctx context.Context, approval types.RegulatoryApproval, study types.Study) error {
+	days := config.DaysUntilRegulatoryApprovalExpiry(approval)
+	if days == nil {
+		return types.NewErrInvalidObject("cannot send expiry notification with nil days before expiry")
+	}
+
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+	content := template.HTML(fmt.Sprintf("The %s approval [%s] of the Study ", approval.Type, template.HTMLEscapeString(approval.Reference))) + href + " " // #nosec G203 -- reference is escaped
+	if *days < 0 {
+		content += "has expired. "
+	} else if *days == 0 {
+		content += "is due to expire today. "
+	} else if *days == 1 {
+		content += "is due to expire tomorrow. "
+	} else {
+		content += template.HTML("is due to expire in " + fmt.Sprintf("%d", *days) + " days. ") // #nosec G203 -- only int
+	}
+	content += "Please sign in to the Portal to record a renewed approval."
+
+	recipients := study.NotificationRecipients()
+	subject := fmt.Sprintf("Notification: Approval of '%s' is expiring", study.Title)
+	if err := s.entra.SendEmail(ctx, subject, emails(recipients...), content); err != nil {
+		log.Err(err).Msg("Failed to send approval expiry notification email")
+	}
+	notification := types.Notification{
+		Title:     fmt.Sprintf("Approval %s of '%s' expires in %d days", approval.Reference, study.Title, *days),
+		Href:      new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:      new(types.NotificationKindApprovalExpiry),
+		ExpiresAt: new(approval.ExpiryDate.Add(3 * config.Month)),
+	}
+	return s.createForAll(notification, recipients)
+}
+
 func (s *Service) NotifyIaaAssignment(ctx context.Context, iaa types.User, study types.Study) error {
 	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
 
@@ -144,9 +234,42 @@ func (s *Service) NotifyStudySignoffExpiry(ctx context.Context, study types.Stud
 		Kind:  new(types.NotificationKindStdyAffirmation),
 	}
 	if study.LastSignoff == nil {
-		notification.ExpiresAt = new(study.CreatedAt.Add(3 * config.Month))
+		notification.ExpiresAt = new(study.CreatedAt.Add(config.StudySignoffValidityOf(study)))
 	} else {
-		notification.ExpiresAt = new(study.LastSignoff.Add(3 * config.Month))
+		notification.ExpiresAt = config.StudySignoffExpiresAt(study)
+	}
+	return s.create(notification, study.Owner)
+}
+
+// Remind the study owner that the DPIA must be reviewed and signed off again
+func (s *Service) NotifyDpiaReview(ctx context.Context, dpia types.Dpia) error {
+	days := config.DaysUntilDpiaReview(dpia)
+	if days == nil {
+		return types.NewErrInvalidObject("cannot notify DPIA review without a review date")
+	}
+	study := dpia.Study
+
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+	content := "The Data Protection Impact Assessment (DPIA) of your Study " + href + " "
+	if *days < 0 {
+		content += "is overdue for review. "
+	} else if *days == 0 {
+		content += "is due for review today. "
+	} else if *days == 1 {
+		content += "is due for review tomorrow. "
+	} else {
+		content += template.HTML(fmt.Sprintf("is due for review in %d days. ", *days)) // #nosec G203 -- only int
+	}
+	content += "Please log in to the ARC Services Portal to review the DPIA and resubmit it for sign-off."
+	subject := "Notification: DPIA review"
+	if err := s.entra.SendEmail(ctx, subject, emails(study.Owner), content); err != nil {
+		log.Err(err).Msg("Failed to send DPIA review notification email")
+	}
+	notification := types.Notification{
+		Title:     fmt.Sprintf("The DPIA of '%s' is due for review", study.Title),
+		Href:      new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:      new(types.NotificationKindDpiaReview),
+		ExpiresAt: dpia.ReviewDate,
 	}
 	return s.create(notification, study.Owner)
 }
@@ -197,6 +320,65 @@ func (s *Service) NotifyAssetExpiry(ctx context.Context, assets []types.Asset, s
 	return s.createForAll(notification, recipients)
 }
 
+func (s *Service) NotifyAssetExpiryEnforced(ctx context.Context, study types.Study, review types.AssetExpiryReview) error {
+	href := htmlHref(fmt.Sprintf("'%s'", study.Title), fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String()))
+	content := template.HTML(fmt.Sprintf( // #nosec G203 -- href is trusted and titles are escaped
+		"The asset '%s' in your Study %s expired on %s and has been flagged as expired. ",
+		template.HTMLEscapeString(review.Asset.Title),
+		href,
+		review.ExpiresAt.Format(config.DateFormat),
+	))
+	if len(review.DetachedProjectIDs) > 0 {
+		content += template.HTML(fmt.Sprintf("It has been removed from %d TRE project(s). ", len(review.DetachedProjectIDs))) // #nosec G203 -- only int
+	}
+	content += "Please sign in to the Portal to confirm the asset will be destroyed, or extend its expiry with a justification."
+
+	subject := "Notification: Asset expired"
+	recipients := study.NotificationRecipients()
+	if err := s.entra.SendEmail(ctx, subject, emails(recipients...), content); err != nil {
+		log.Err(err).Msg("Failed to send asset expiry enforcement notification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("'%s' has expired and needs review", review.Asset.Title),
+		Href:  new(fmt.Sprintf("/studies/manage?studyId=%s", study.ID.String())),
+		Kind:  new(types.NotificationKindAssetExpiryReview),
+	}
+	return s.createForAll(notification, recipients)
+}
+
+// Notify IG ops staff that an uploaded contract object was infected and kept in quarantine
+func (s *Service) NotifyMalwareDetected(
+	ctx context.Context,
+	contract types.Contract,
+	object types.ContractObjectMetadata,
+	igOpsStaff []types.User,
+) error {
+	href := htmlHref(fmt.Sprintf("'%s'", contract.Study.Title), fmt.Sprintf("/studies/manage?studyId=%s", contract.StudyID.String()))
+	signature := ""
+	if object.ScanSignature != nil {
+		signature = *object.ScanSignature
+	}
+	content := template.HTML(fmt.Sprintf( // #nosec G203 -- href is trusted and the rest is escaped
+		"The file '%s' uploaded to the contract '%s' of the Study %s was found to contain malware (%s). "+
+			"It has been kept in quarantine and cannot be downloaded.",
+		template.HTMLEscapeString(object.Filename),
+		template.HTMLEscapeString(contract.Title),
+		href,
+		template.HTMLEscapeString(signature),
+	))
+	subject := "Notification: Malware detected in an uploaded file"
+	if err := s.entra.SendEmail(ctx, subject, emails(igOpsStaff...), content); err != nil {
+		log.Err(err).Msg("Failed to send malware detected notification email")
+	}
+	notification := types.Notification{
+		Title: fmt.Sprintf("Malware detected in '%s' of contract '%s'", object.Filename, contract.Title),
+		Body:  object.ScanSignature,
+		Href:  new(fmt.Sprintf("/studies/manage?studyId=%s", contract.StudyID.String())),
+		Kind:  new(types.NotificationKindMalwareDetected),
+	}
+	return s.createForAll(notification, igOpsStaff)
+}
+
 func emails(recipients ...types.User) []string {
 	recipientEmails := []string{}
 	for _, recipient := range recipients {
@@ -0,0 +1,129 @@
+//go:build integration
+
+package objects
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockcontrollers"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockdb"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mocknotifications"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockusers"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// To be called by each test within this package to AutoMigrate
+// only the models/tables required by this package
+func migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&types.User{},
+		&types.StoredObject{},
+	)
+}
+
+func TestIntegration_StoredObjectVerification(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	mockUsers := new(mockusers.MockUsers)
+	svc := &Service{db: db, s3: mockS3, users: mockUsers, notifications: &mocknotifications.MockNotifications{}}
+
+	admin := types.User{Username: "admin@testIntegration.com"}
+	require.NoError(t, db.Create(&admin).Error)
+	mockUsers.On("UsersWithConfigRole", rbac.Admin).Return([]types.User{admin}, nil)
+
+	mockS3.On("StoreObject", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		_, err := io.Copy(io.Discard, args.Get(2).(types.S3Object).Content) // as if uploaded
+		require.NoError(t, err)
+	}).Return(nil)
+
+	metadata := s3.ObjectMetadata{Id: uuid.New(), Kind: s3.ContractKind}
+	require.NoError(t, svc.StoreObject(ctx, metadata, mockcontrollers.MockS3Object("content")))
+	record, err := svc.storedObject(metadata)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("content"))
+	assert.Equal(t, hex.EncodeToString(digest[:]), record.Sha256)
+	assert.Equal(t, int64(len("content")), record.NumBytes)
+
+	readObject := func() (string, error) {
+		object, err := svc.GetObject(ctx, metadata)
+		if err != nil {
+			return "", err
+		}
+		content, err := io.ReadAll(object.Content)
+		return string(content), err
+	}
+
+	// Intact content is returned
+	mockS3.On("GetObject", mock.Anything, metadata).Return(mockcontrollers.MockS3Object("content"), nil).Once()
+	content, err := readObject()
+	assert.NoError(t, err)
+	assert.Equal(t, "content", content)
+
+	// Modified content of an unknown size fails before any is returned
+	mockS3.On("GetObject", mock.Anything, metadata).Return(types.S3Object{Content: io.NopCloser(strings.NewReader("CONTENT"))}, nil).Once()
+	_, err = svc.GetObject(ctx, metadata)
+	assert.ErrorIs(t, err, ErrCorrupt)
+	assert.ErrorIs(t, err, types.ErrServerError)
+
+	// As does content of the wrong size, before it is read
+	mockS3.On("GetObject", mock.Anything, metadata).Return(mockcontrollers.MockS3Object("content!"), nil).Once()
+	_, err = svc.GetObject(ctx, metadata)
+	assert.ErrorIs(t, err, ErrCorrupt)
+
+	// Objects stored before digests were recorded are returned unverified
+	legacy := s3.ObjectMetadata{Id: uuid.New(), Kind: s3.ContractKind}
+	mockS3.On("GetObject", mock.Anything, legacy).Return(mockcontrollers.MockS3Object("anything"), nil).Once()
+	object, err := svc.GetObject(ctx, legacy)
+	require.NoError(t, err)
+	legacyContent, err := io.ReadAll(object.Content)
+	assert.NoError(t, err)
+	assert.Equal(t, "anything", string(legacyContent))
+
+	// Periodic verification records corrupt and missing objects
+	missing := s3.ObjectMetadata{Id: uuid.New(), Kind: s3.StudyDocumentKind}
+	require.NoError(t, svc.StoreObject(ctx, missing, mockcontrollers.MockS3Object("document")))
+	mockS3.On("GetObject", mock.Anything, metadata).Return(mockcontrollers.MockS3Object("CONTENT"), nil).Once()
+	mockS3.On("GetObject", mock.Anything, missing).Return(types.S3Object{}, types.NewNotFoundError("no such key")).Once()
+	require.NoError(t, svc.VerifyStoredObjects(ctx))
+	record, err = svc.storedObject(metadata)
+	require.NoError(t, err)
+	assert.Equal(t, types.StoredObjectStatusCorrupt, record.Status)
+	assert.NotNil(t, record.VerifiedAt)
+	record, err = svc.storedObject(missing)
+	require.NoError(t, err)
+	assert.Equal(t, types.StoredObjectStatusMissing, record.Status)
+	mockUsers.AssertCalled(t, "UsersWithConfigRole", rbac.Admin)
+
+	// Restoring the content clears the failure on the next verification
+	mockS3.On("GetObject", mock.Anything, metadata).Return(mockcontrollers.MockS3Object("content"), nil).Once()
+	mockS3.On("GetObject", mock.Anything, missing).Return(mockcontrollers.MockS3Object("document"), nil).Once()
+	require.NoError(t, svc.VerifyStoredObjects(ctx))
+	record, err = svc.storedObject(metadata)
+	require.NoError(t, err)
+	assert.Equal(t, types.StoredObjectStatusOK, record.Status)
+
+	// Storing again replaces the record and deleting removes it
+	require.NoError(t, svc.StoreObject(ctx, metadata, mockcontrollers.MockS3Object("new content")))
+	record, err = svc.storedObject(metadata)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("new content")), record.NumBytes)
+	mockS3.On("DeleteObject", metadata).Return(nil)
+	require.NoError(t, svc.DeleteObject(metadata))
+	_, err = svc.storedObject(metadata)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+}
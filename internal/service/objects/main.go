@@ -0,0 +1,141 @@
+package objects
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/service/notifications"
+	"github.com/ucl-arc-tre/portal/internal/service/users"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Service stores objects in S3 while recording the SHA-256 digest and size of
+// their content, which is verified whenever they are read back. It satisfies
+// s3.Interface so can be used in place of the S3 controller
+type Service struct {
+	db            *gorm.DB
+	s3            s3.Interface
+	users         users.Interface
+	notifications notifications.Interface
+}
+
+func New() *Service {
+	return &Service{
+		db:            graceful.NewDB(),
+		s3:            s3.New(),
+		users:         users.New(),
+		notifications: notifications.New(),
+	}
+}
+
+// Store an object and record its digest. The record is written outside any
+// transaction of the caller, which must delete the object if that fails to commit
+func (s *Service) StoreObject(ctx context.Context, metadata s3.ObjectMetadata, obj types.S3Object) error {
+	reader := newDigestReader(obj.Content)
+	if err := s.s3.StoreObject(ctx, metadata, types.S3Object{Content: reader, NumBytes: obj.NumBytes}); err != nil {
+		return err
+	}
+	record := types.StoredObject{
+		Kind:     string(metadata.Kind),
+		ObjectID: metadata.Id,
+		Sha256:   reader.digest(),
+		NumBytes: reader.numBytes,
+		Status:   types.StoredObjectStatusOK,
+	}
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "kind"}, {Name: "object_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sha256", "num_bytes", "status", "verified_at", "updated_at"}),
+	}).Create(&record).Error
+	return types.NewErrFromGorm(err, "failed to record stored object")
+}
+
+// Get an object with content that has been verified against its digest, so
+// a mismatch is returned as an error before any of the content. Objects stored
+// before digests were recorded are returned unverified
+func (s *Service) GetObject(ctx context.Context, metadata s3.ObjectMetadata) (types.S3Object, error) {
+	record, err := s.storedObject(metadata)
+	if errors.Is(err, types.ErrNotFound) {
+		log.Debug().Any("metadata", metadata).Msg("No digest recorded for object - returning unverified")
+		return s.s3.GetObject(ctx, metadata)
+	} else if err != nil {
+		return types.S3Object{}, err
+	}
+	return s.verifiedObject(ctx, metadata, record)
+}
+
+// Objects are small enough to be read into memory to verify them in full
+func (s *Service) verifiedObject(ctx context.Context, metadata s3.ObjectMetadata, record types.StoredObject) (types.S3Object, error) {
+	object, err := s.s3.GetObject(ctx, metadata)
+	if err != nil {
+		return object, err
+	}
+	defer closeObject(object)
+	if object.NumBytes != nil && *object.NumBytes != record.NumBytes {
+		return types.S3Object{}, newErrCorrupt(metadata, "size [%d] does not match [%d]", *object.NumBytes, record.NumBytes)
+	}
+	content, err := readVerified(metadata, record, object.Content)
+	if err != nil {
+		return types.S3Object{}, err
+	}
+	return types.S3Object{Content: io.NopCloser(bytes.NewReader(content)), NumBytes: &record.NumBytes}, nil
+}
+
+func (s *Service) DeleteObject(metadata s3.ObjectMetadata) error {
+	if err := s.s3.DeleteObject(metadata); err != nil {
+		return err
+	}
+	err := s.db.Where("kind = ? AND object_id = ?", string(metadata.Kind), metadata.Id).
+		Delete(&types.StoredObject{}).Error
+	return types.NewErrFromGorm(err, "failed to delete stored object record")
+}
+
+func (s *Service) storedObject(metadata s3.ObjectMetadata) (types.StoredObject, error) {
+	record := types.StoredObject{}
+	err := s.db.Where("kind = ? AND object_id = ?", string(metadata.Kind), metadata.Id).First(&record).Error
+	return record, types.NewErrFromGorm(err, "failed to get stored object")
+}
+
+func closeObject(object types.S3Object) {
+	if object.Content == nil {
+		return
+	}
+	if err := object.Content.Close(); err != nil {
+		log.Err(err).Msg("Failed to close S3 object")
+	}
+}
+
+// Reader computing the SHA-256 digest and size of everything read through it
+type digestReader struct {
+	source   io.ReadCloser
+	hash     hash.Hash
+	numBytes int64
+}
+
+func newDigestReader(source io.ReadCloser) *digestReader {
+	return &digestReader{source: source, hash: sha256.New()}
+}
+
+func (r *digestReader) Read(p []byte) (int, error) {
+	n, err := r.source.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	r.numBytes += int64(n)
+	return n, err
+}
+
+func (r *digestReader) Close() error {
+	return r.source.Close()
+}
+
+func (r *digestReader) digest() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
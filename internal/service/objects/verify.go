@@ -0,0 +1,96 @@
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+// Content of a stored object does not match the digest or size recorded at upload
+var ErrCorrupt = errors.New("stored object is corrupt")
+
+func newErrCorrupt(metadata s3.ObjectMetadata, format string, objs ...any) error {
+	return fmt.Errorf("%w: %w: [%v] %s", types.ErrServerError, ErrCorrupt, metadata.Key(), fmt.Sprintf(format, objs...))
+}
+
+// Re-verify every stored object against its recorded digest, recording the
+// outcome and notifying admins of any which are corrupt or missing
+func (s *Service) VerifyStoredObjects(ctx context.Context) error {
+	records := []types.StoredObject{}
+	if err := s.db.Order("created_at").Find(&records).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get stored objects")
+	}
+
+	failed := []types.StoredObject{}
+	errs := []error{}
+	for _, record := range records {
+		status, err := s.verifyStoredObject(ctx, record)
+		if err != nil {
+			errs = append(errs, err) // e.g. S3 unavailable, so the outcome is unknown
+			continue
+		}
+		err = s.db.Model(&record).Updates(types.StoredObject{Status: status, VerifiedAt: new(time.Now())}).Error
+		if err != nil {
+			errs = append(errs, types.NewErrFromGorm(err, "failed to update stored object"))
+			continue
+		}
+		if status != types.StoredObjectStatusOK {
+			log.Error().Str("kind", record.Kind).Any("objectId", record.ObjectID).Any("status", status).Msg("Stored object failed verification")
+			record.Status = status
+			failed = append(failed, record)
+		}
+	}
+	log.Info().Int("numObjects", len(records)).Int("numFailed", len(failed)).Msg("Verified stored objects")
+
+	if len(failed) > 0 {
+		if err := s.notifyFailedVerification(ctx, failed); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) verifyStoredObject(ctx context.Context, record types.StoredObject) (types.StoredObjectStatus, error) {
+	metadata := s3.ObjectMetadata{Id: record.ObjectID, Kind: s3.ObjectKind(record.Kind)}
+	_, err := s.verifiedObject(ctx, metadata, record)
+	switch {
+	case err == nil:
+		return types.StoredObjectStatusOK, nil
+	case errors.Is(err, types.ErrNotFound):
+		return types.StoredObjectStatusMissing, nil
+	case errors.Is(err, ErrCorrupt), errors.Is(err, s3.ErrDecryption):
+		return types.StoredObjectStatusCorrupt, nil
+	default:
+		return "", err
+	}
+}
+
+func (s *Service) notifyFailedVerification(ctx context.Context, failed []types.StoredObject) error {
+	admins, err := s.users.UsersWithConfigRole(rbac.Admin)
+	if err != nil {
+		return err
+	}
+	return s.notifications.NotifyStoredObjectsFailedVerification(ctx, failed, admins)
+}
+
+// Read all of the content of a stored object, failing if it does not match
+// the digest and size recorded at upload
+func readVerified(metadata s3.ObjectMetadata, expected types.StoredObject, source io.ReadCloser) ([]byte, error) {
+	reader := newDigestReader(source)
+	content, err := io.ReadAll(io.LimitReader(reader, expected.NumBytes+1))
+	if err != nil {
+		return nil, err
+	} else if reader.numBytes != expected.NumBytes {
+		return nil, newErrCorrupt(metadata, "size [%d] does not match [%d]", reader.numBytes, expected.NumBytes)
+	} else if digest := reader.digest(); digest != expected.Sha256 {
+		return nil, newErrCorrupt(metadata, "sha256 [%v] does not match [%v]", digest, expected.Sha256)
+	}
+	return content, nil
+}
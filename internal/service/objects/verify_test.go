@@ -0,0 +1,33 @@
+package objects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestReadVerified(t *testing.T) {
+	digest := sha256.Sum256([]byte("content"))
+	expected := types.StoredObject{Sha256: hex.EncodeToString(digest[:]), NumBytes: int64(len("content"))}
+	read := func(content string) (string, error) {
+		metadata := s3.ObjectMetadata{Id: uuid.New(), Kind: s3.ContractKind}
+		data, err := readVerified(metadata, expected, io.NopCloser(strings.NewReader(content)))
+		return string(data), err
+	}
+
+	data, err := read("content")
+	assert.NoError(t, err)
+	assert.Equal(t, "content", data)
+
+	for _, content := range []string{"CONTENT", "conten", "content!", ""} {
+		_, err := read(content)
+		assert.ErrorIs(t, err, ErrCorrupt, content)
+	}
+}
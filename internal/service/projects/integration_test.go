@@ -33,6 +33,7 @@ func migrate(db *gorm.DB) error {
 		&types.Study{},
 		&types.StudyAdmin{},
 		&types.Asset{},
+		&types.AssetLocation{},
 		&types.Environment{},
 		&types.Project{},
 		&types.ProjectTRE{},
@@ -222,6 +223,12 @@ func TestIntegration_TestCreateProjectTRE(t *testing.T) {
 	assert.Equal(t, "proj123", project.Name)
 	assert.Equal(t, creator.ID, project.CreatorUserID)
 
+	// Attached assets are located in the project
+	var location types.AssetLocation
+	require.NoError(t, db.Where("asset_id = ? AND kind = ?", asset.ID, types.AssetLocationKindProject).First(&location).Error)
+	assert.Equal(t, project.ID, *location.ProjectID)
+	assert.Equal(t, "proj123", location.Location)
+
 	// Member with desktop config should have a Unix username
 	var userConfig types.ProjectTREUserConfig
 	require.NoError(t, db.Preload("User").
@@ -216,6 +216,14 @@ func (s *Service) validateAssets(assetIDs []string, studyUUID uuid.UUID, environ
 			return types.NewErrClientInvalidObjectF("Asset [%v] does not belong to the specified study", asset.Title)
 		}
 
+		if asset.IsDestroyed() {
+			return types.NewErrClientInvalidObjectF("Asset [%v] has been destroyed", asset.Title)
+		}
+
+		if asset.IsExpired() {
+			return types.NewErrClientInvalidObjectF("Asset [%v] has expired", asset.Title)
+		}
+
 		if asset.Tier > environmentTier {
 			return types.NewErrClientInvalidObjectF("Asset [%v] has tier %d which is incompatible with environment (max tier %d)", asset.Title, asset.Tier, environmentTier)
 		}
@@ -342,8 +350,8 @@ func (s *Service) genericProjectsQuery() *gorm.DB {
 }
 
 // Retrieve all active TRE projects together with role bindings and members.
-// Only projects with pending creation, deployed, or pending deletion status
-// are returned
+// Only projects with pending creation, deployed, suspended or pending deletion
+// status are returned
 func (s *Service) AllProjectTREs() ([]types.ProjectTRE, error) {
 	var projectTREs []types.ProjectTRE
 	err := s.db.
@@ -360,6 +368,7 @@ func (s *Service) AllProjectTREs() ([]types.ProjectTRE, error) {
 		Where("project_tres.status IN ?", []types.ProjectTREStatus{
 			types.ProjectTREStatusPendingCreation,
 			types.ProjectTREStatusDeployed,
+			types.ProjectTREStatusSuspended,
 			types.ProjectTREStatusPendingDeletion,
 		}).
 		Find(&projectTREs).Error
@@ -374,7 +383,8 @@ func (s *Service) ProjectTreById(projectId uuid.UUID) (*types.ProjectTRE, error)
 		Preload("Project.CreatorUser").
 		Preload("Project.Environment").
 		Preload("Project.Study").
-		Preload("Project.ProjectAssets.Asset").
+		Preload("Project.ProjectAssets.Asset.Locations").
+		Preload("Project.ProjectAssets.Asset.DataTypes").
 		Preload("TRERoleBindings.User").
 		Preload("UserConfigs.User").
 		Where("project_id = ?", projectId).
@@ -431,7 +441,36 @@ func (s *Service) createOrUpdateProjectAssets(tx *gorm.DB, projectUUID uuid.UUID
 		})
 	}
 
-	return graceful.UpdateManyExisting(tx, existingProjectAssets, requestedAssets)
+	if err := graceful.UpdateManyExisting(tx, existingProjectAssets, requestedAssets); err != nil {
+		return err
+	}
+	return syncProjectAssetLocations(tx, projectUUID, requestedAssetIDs)
+}
+
+// Record the project as a location of each asset attached to it, removing it
+// from the locations of assets no longer attached
+func syncProjectAssetLocations(tx *gorm.DB, projectUUID uuid.UUID, assetIDs []uuid.UUID) error {
+	project := types.Project{}
+	if err := tx.Where("id = ?", projectUUID).First(&project).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get project")
+	}
+
+	detached := tx.Where("kind = ? AND project_id = ?", types.AssetLocationKindProject, projectUUID)
+	if len(assetIDs) > 0 {
+		detached = detached.Where("asset_id NOT IN ?", assetIDs)
+	}
+	if err := detached.Delete(&types.AssetLocation{}).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to delete project asset locations")
+	}
+
+	for _, assetID := range assetIDs {
+		location := types.AssetLocation{AssetID: assetID, Kind: types.AssetLocationKindProject, ProjectID: &projectUUID}
+		err := tx.Where(&location).Attrs(types.AssetLocation{Location: project.Name}).FirstOrCreate(&location).Error
+		if err != nil {
+			return types.NewErrFromGorm(err, "failed to create project asset location")
+		}
+	}
+	return nil
 }
 
 func (s *Service) createOrUpdateProjectTRERoleBindings(tx *gorm.DB, projectTREID uuid.UUID, members []openapi.ProjectTREMember) error {
@@ -646,6 +685,12 @@ func (s *Service) DeleteProjectTRE(projectId uuid.UUID) error {
 		return types.NewErrFromGorm(err, "failed to delete project assets")
 	}
 
+	err = tx.Where("kind = ? AND project_id = ?", types.AssetLocationKindProject, projectId).Delete(&types.AssetLocation{}).Error
+	if err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete project asset locations")
+	}
+
 	// Soft delete the ProjectTRE record
 	err = tx.Delete(&projectTRE).Error
 	if err != nil {
@@ -723,6 +768,9 @@ func (s *Service) UpdateProjectTREDeployed(projectName string, data treopenapi.P
 		return types.NewErrFromGorm(err, "failed to update TRE project: TRE project get error")
 	}
 	currentStatus := projectTRE.Status
+	if currentStatus == types.ProjectTREStatusSuspended && status == types.ProjectTREStatusDeployed {
+		status = types.ProjectTREStatusSuspended // Deployed with access removed. Only a study signoff reinstates
+	}
 
 	result = s.db.Model(projectTRE).
 		Where("id = ?", projectTRE.ID).
@@ -0,0 +1,99 @@
+//go:build integration
+
+package search
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/testutils/mockdb"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+func migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&types.User{},
+		&types.Study{},
+		&types.Asset{},
+		&types.AssetLocation{},
+		&types.Contract{},
+		&types.Environment{},
+		&types.Project{},
+		&types.ProjectTRE{},
+		&types.ProjectDSH{},
+	)
+}
+
+func TestIntegration_Search(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	environment := types.Environment{Name: "ARC Trusted Research Environment", Tier: 3}
+	require.NoError(t, db.Create(&environment).Error)
+
+	study := types.Study{OwnerUserID: owner.ID, Title: "Cardiology outcomes", ApprovalStatus: types.StudyApprovalStatusApproved}
+	require.NoError(t, db.Create(&study).Error)
+	otherStudy := types.Study{OwnerUserID: owner.ID, Title: "Unrelated", ApprovalStatus: types.StudyApprovalStatusPending}
+	require.NoError(t, db.Create(&otherStudy).Error)
+
+	asset := types.Asset{
+		CreatorUserID: owner.ID, StudyID: study.ID, Title: "Echo images", Description: "Cardiology scans",
+		ClassificationImpact: "highly_confidential", Format: "electronic", Tier: 3, Status: types.AssetStatusActive,
+	}
+	require.NoError(t, db.Create(&asset).Error)
+	require.NoError(t, db.Create(&types.AssetLocation{AssetID: asset.ID, Location: "data_safe_haven"}).Error)
+	contract := types.Contract{StudyID: otherStudy.ID, CreatorUserID: owner.ID, Title: "DSA", ThirdPartyName: new("Cardiology Trust")}
+	require.NoError(t, db.Create(&contract).Error)
+	project := types.Project{Name: "cardiology", CreatorUserID: owner.ID, StudyID: study.ID, EnvironmentID: environment.ID}
+	require.NoError(t, db.Create(&project).Error)
+	require.NoError(t, db.Create(&types.ProjectTRE{ProjectID: project.ID, Status: types.ProjectTREStatusDeployed}).Error)
+
+	results, err := svc.Search(Query{Text: "cardiology", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 4, results.Total)
+	assert.Len(t, results.Items, 4)
+	assert.Len(t, results.Facets.EntityTypes, 4)
+	assert.Equal(t, []FacetCount{{Value: "3", Count: 1}}, results.Facets.Tiers)
+
+	// Asset locations are searched
+	results, err = svc.Search(Query{Text: "data_safe_haven", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, asset.ID, results.Items[0].ID)
+	assert.Equal(t, study.Title, results.Items[0].StudyTitle)
+
+	// Facets ignore their own filter so other entity types can still be chosen
+	results, err = svc.Search(Query{Text: "cardiology", EntityType: new(openapi.SearchEntityTypeProject), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, "cardiology", results.Items[0].Title)
+	assert.Equal(t, string(types.ProjectTREStatusDeployed), *results.Items[0].Status)
+	assert.Len(t, results.Facets.EntityTypes, 4)
+
+	// Restricted to the studies of the user
+	results, err = svc.Search(Query{Text: "cardiology", StudyIDs: []uuid.UUID{otherStudy.ID}, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, contract.ID, results.Items[0].ID)
+
+	results, err = svc.Search(Query{Text: "cardiology", Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Len(t, results.Items, 1)
+	assert.Equal(t, 4, results.Total)
+
+	// Caseref
+	require.NoError(t, db.First(&study, "id = ?", study.ID).Error)
+	results, err = svc.Search(Query{Text: fmt.Sprint(study.Caseref), EntityType: new(openapi.SearchEntityTypeStudy), Limit: 10})
+	require.NoError(t, err)
+	require.NotEmpty(t, results.Items)
+	assert.Equal(t, study.ID, results.Items[0].ID)
+}
@@ -0,0 +1,174 @@
+package search
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+const (
+	minQueryLength = 2
+	maxQueryLength = 100
+)
+
+var (
+	caserefPattern = regexp.MustCompile(`^[0-9]{1,5}$`)
+	likeEscaper    = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+)
+
+// Items matching the search text across all entity types. Short fields are matched by trigram
+// similarity using pg_trgm, longer ones by substring
+const matchesSQL = `
+SELECT 'study' AS entity_type, studies.id, studies.title, studies.approval_status AS status, NULL::integer AS tier, studies.id AS study_id,
+	GREATEST(similarity(studies.title, @text), CASE WHEN studies.caseref = @caseref THEN 1 ELSE 0 END) AS score
+FROM studies
+WHERE studies.deleted_at IS NULL
+	AND (studies.title % @text OR studies.title ILIKE @pattern OR studies.description ILIKE @pattern OR studies.caseref = @caseref)
+UNION ALL
+SELECT 'asset', assets.id, assets.title, assets.status, assets.tier, assets.study_id,
+	similarity(assets.title, @text)
+FROM assets
+WHERE assets.deleted_at IS NULL
+	AND (assets.title % @text OR assets.title ILIKE @pattern OR assets.description ILIKE @pattern OR EXISTS (
+		SELECT 1 FROM asset_locations
+		WHERE asset_locations.asset_id = assets.id AND asset_locations.deleted_at IS NULL
+			AND (asset_locations.location % @text OR asset_locations.location ILIKE @pattern)
+	))
+UNION ALL
+SELECT 'contract', contracts.id, contracts.title, NULLIF(contracts.status, ''), NULL, contracts.study_id,
+	GREATEST(similarity(contracts.title, @text), similarity(COALESCE(contracts.third_party_name, ''), @text))
+FROM contracts
+WHERE contracts.deleted_at IS NULL
+	AND (contracts.title % @text OR contracts.title ILIKE @pattern OR contracts.third_party_name % @text OR contracts.third_party_name ILIKE @pattern)
+UNION ALL
+SELECT 'project', projects.id, projects.name, COALESCE(project_tres.status, project_dshes.status), NULL, projects.study_id,
+	similarity(projects.name, @text)
+FROM projects
+LEFT JOIN project_tres ON project_tres.project_id = projects.id AND project_tres.deleted_at IS NULL
+LEFT JOIN project_dshes ON project_dshes.project_id = projects.id AND project_dshes.deleted_at IS NULL
+WHERE projects.deleted_at IS NULL
+	AND (projects.name % @text OR projects.name ILIKE @pattern)
+`
+
+type Service struct {
+	db *gorm.DB
+}
+
+func New() *Service {
+	return &Service{db: graceful.NewDB()}
+}
+
+// Search studies, assets, contracts and projects. Results are ordered by how closely they match
+func (s *Service) Search(query Query) (*Results, error) {
+	text := strings.TrimSpace(query.Text)
+	if len(text) < minQueryLength || len(text) > maxQueryLength {
+		return nil, types.NewErrClientInvalidObjectF("search query must be %d-%d characters", minQueryLength, maxQueryLength)
+	} else if query.EntityType != nil && !query.EntityType.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("invalid entity type [%v]", *query.EntityType)
+	}
+
+	results := Results{Items: []Result{}}
+	if query.StudyIDs != nil && len(query.StudyIDs) == 0 {
+		results.Facets = Facets{EntityTypes: []FacetCount{}, Statuses: []FacetCount{}, Tiers: []FacetCount{}}
+		return &results, nil
+	}
+
+	args := map[string]any{
+		"text":      text,
+		"pattern":   "%" + likeEscaper.Replace(text) + "%",
+		"caseref":   queryCaseref(text),
+		"study_ids": query.StudyIDs,
+		"limit":     query.Limit,
+		"offset":    query.Offset,
+	}
+	if query.EntityType != nil {
+		args["entity_type"] = string(*query.EntityType)
+	}
+	if query.Status != nil {
+		args["status"] = *query.Status
+	}
+	if query.Tier != nil {
+		args["tier"] = *query.Tier
+	}
+
+	err := s.db.Raw(`WITH matches AS (`+matchesSQL+`)
+		SELECT matches.*, studies.title AS study_title, studies.caseref AS study_caseref
+		FROM matches JOIN studies ON studies.id = matches.study_id AND studies.deleted_at IS NULL
+		WHERE `+query.conditions("")+`
+		ORDER BY matches.score DESC, matches.title ASC, matches.id ASC
+		LIMIT @limit OFFSET @offset`, args).Scan(&results.Items).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to search")
+	}
+
+	if err := s.count(query, args, &results.Total); err != nil {
+		return nil, err
+	}
+
+	facets := []struct {
+		filter string
+		value  string
+		counts *[]FacetCount
+	}{
+		{filter: "entity_type", value: "matches.entity_type", counts: &results.Facets.EntityTypes},
+		{filter: "status", value: "matches.status", counts: &results.Facets.Statuses},
+		{filter: "tier", value: "CAST(matches.tier AS text)", counts: &results.Facets.Tiers},
+	}
+	for _, facet := range facets {
+		*facet.counts = []FacetCount{}
+		err := s.db.Raw(`WITH matches AS (`+matchesSQL+`)
+			SELECT `+facet.value+` AS value, COUNT(*) AS count
+			FROM matches JOIN studies ON studies.id = matches.study_id AND studies.deleted_at IS NULL
+			WHERE `+query.conditions(facet.filter)+` AND `+facet.value+` IS NOT NULL
+			GROUP BY value
+			ORDER BY value ASC`, args).Scan(facet.counts).Error
+		if err != nil {
+			return nil, types.NewErrFromGorm(err, "failed to count search facet ["+facet.filter+"]")
+		}
+	}
+
+	return &results, nil
+}
+
+func (s *Service) count(query Query, args map[string]any, total *int) error {
+	err := s.db.Raw(`WITH matches AS (`+matchesSQL+`)
+		SELECT COUNT(*)
+		FROM matches JOIN studies ON studies.id = matches.study_id AND studies.deleted_at IS NULL
+		WHERE `+query.conditions(""), args).Scan(total).Error
+	return types.NewErrFromGorm(err, "failed to count search results")
+}
+
+// SQL conditions on the matches for the filters of a query, except a named filter
+func (q Query) conditions(except string) string {
+	conditions := []string{"TRUE"}
+	if q.StudyIDs != nil {
+		conditions = append(conditions, "matches.study_id IN @study_ids")
+	}
+	if q.EntityType != nil && except != "entity_type" {
+		conditions = append(conditions, "matches.entity_type = @entity_type")
+	}
+	if q.Status != nil && except != "status" {
+		conditions = append(conditions, "matches.status = @status")
+	}
+	if q.Tier != nil && except != "tier" {
+		conditions = append(conditions, "matches.tier = @tier")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// Caseref the text could refer to, with optional leading zeros. Caserefs are positive so
+// -1 matches nothing
+func queryCaseref(text string) int {
+	if !caserefPattern.MatchString(text) {
+		return -1
+	}
+	caseref, err := strconv.Atoi(text)
+	if err != nil {
+		return -1
+	}
+	return caseref
+}
@@ -0,0 +1,43 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+)
+
+func TestQueryCaseref(t *testing.T) {
+	assert.Equal(t, 10001, queryCaseref("10001"))
+	assert.Equal(t, 12, queryCaseref("00012"))
+	assert.Equal(t, -1, queryCaseref("123456"))
+	assert.Equal(t, -1, queryCaseref("study 1"))
+}
+
+func TestQueryConditions(t *testing.T) {
+	query := Query{}
+	assert.Equal(t, "TRUE", query.conditions(""))
+
+	query.StudyIDs = []uuid.UUID{uuid.New()}
+	query.EntityType = new(openapi.SearchEntityTypeAsset)
+	query.Tier = new(3)
+	assert.Equal(t, "TRUE AND matches.study_id IN @study_ids AND matches.entity_type = @entity_type AND matches.tier = @tier", query.conditions(""))
+
+	// Facets are counted without their own filter
+	assert.Equal(t, "TRUE AND matches.study_id IN @study_ids AND matches.tier = @tier", query.conditions("entity_type"))
+}
+
+func TestSearchInvalidQuery(t *testing.T) {
+	svc := &Service{}
+
+	_, err := svc.Search(Query{Text: " a "})
+	assert.Error(t, err)
+
+	_, err = svc.Search(Query{Text: "study", EntityType: new(openapi.SearchEntityType("user"))})
+	assert.Error(t, err)
+
+	results, err := svc.Search(Query{Text: "study", StudyIDs: []uuid.UUID{}})
+	assert.NoError(t, err)
+	assert.Empty(t, results.Items)
+}
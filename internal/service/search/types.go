@@ -0,0 +1,44 @@
+package search
+
+import (
+	"github.com/google/uuid"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+)
+
+type Query struct {
+	Text       string
+	EntityType *openapi.SearchEntityType
+	Status     *string
+	Tier       *int
+	StudyIDs   []uuid.UUID // Only search within these studies. Unrestricted if nil
+	Limit      int
+	Offset     int
+}
+
+type Result struct {
+	EntityType   openapi.SearchEntityType
+	ID           uuid.UUID
+	Title        string
+	Status       *string
+	Tier         *int
+	StudyID      uuid.UUID
+	StudyTitle   string
+	StudyCaseref int
+}
+
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+type Facets struct {
+	EntityTypes []FacetCount
+	Statuses    []FacetCount
+	Tiers       []FacetCount
+}
+
+type Results struct {
+	Items  []Result
+	Total  int // Across all pages
+	Facets Facets
+}
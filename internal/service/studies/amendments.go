@@ -0,0 +1,393 @@
+package studies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+const originalContractVersionReason = "Original terms"
+
+// Version history of a contract in version order. Contracts created before
+// versions were recorded have their current terms as version 1
+func (s *Service) ContractVersions(studyID uuid.UUID, contractID uuid.UUID) ([]types.ContractVersion, error) {
+	contract, err := s.GetContract(studyID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	versions := []types.ContractVersion{}
+	if err := preloadContractVersion(s.db).Where("contract_id = ?", contractID).Order("version").Find(&versions).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get contract versions")
+	} else if len(versions) == 0 {
+		if err := s.db.Where("id = ?", contract.CreatorUserID).First(&contract.CreatorUser).Error; err != nil {
+			return nil, types.NewErrFromGorm(err, "failed to get contract creator")
+		}
+		original := originalContractVersion(*contract)
+		original.RequesterUser = contract.CreatorUser
+		versions = append(versions, original)
+	}
+	return versions, nil
+}
+
+// Request an amendment of a contract with the complete amended terms. The
+// contract is unchanged until the amendment is approved and effective
+func (s *Service) RequestContractAmendment(
+	ctx context.Context,
+	requester types.User,
+	studyID uuid.UUID,
+	contractID uuid.UUID,
+	data openapi.ContractAmendmentRequest,
+) (*types.ContractVersion, error) {
+	reason := strings.TrimSpace(data.Reason)
+	if reason == "" {
+		return nil, types.NewErrClientInvalidObjectF("a reason for the amendment is required")
+	}
+	effectiveDate, err := time.Parse(config.DateFormat, data.EffectiveDate)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("effective date must be in %s format", config.DateFormat)
+	}
+
+	contract, err := s.GetContract(studyID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateContract(ctx, studyID, data.Terms); err != nil {
+		return nil, err
+	}
+
+	amended, err := contractFromBase(data.Terms)
+	if err != nil {
+		return nil, err
+	}
+	if data.Terms.OrganisationSignatory != nil {
+		signatory, err := s.persistedContractSignatory(ctx, *data.Terms.OrganisationSignatory)
+		if err != nil {
+			return nil, err
+		}
+		amended.SignatoryUserId = &signatory.ID
+	}
+	if amended.ExpiryDate != nil && !effectiveDate.Before(*amended.ExpiryDate) {
+		return nil, types.NewErrClientInvalidObjectF("effective date must be before the amended expiry date")
+	}
+
+	version := contractVersionTerms(*amended)
+	version.ChangedFields = changedContractFields(contractVersionTerms(*contract), version)
+	if len(version.ChangedFields) == 0 {
+		return nil, types.NewErrClientInvalidObjectF("the amended terms do not differ from the current terms")
+	}
+	version.ContractID = contractID
+	version.Status = types.ContractVersionStatusPending
+	version.EffectiveDate = effectiveDate
+	version.Reason = reason
+	version.RequesterUserID = requester.ID
+
+	var numOpen int64
+	err = s.db.Model(&types.ContractVersion{}).
+		Where("contract_id = ?", contractID).
+		Where("status = ? OR (status = ? AND applied_at IS NULL)", types.ContractVersionStatusPending, types.ContractVersionStatusApproved).
+		Count(&numOpen).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to check for open amendments")
+	} else if numOpen > 0 {
+		return nil, types.NewErrClientInvalidObjectF("an amendment of this contract is already pending or awaiting its effective date")
+	}
+
+	log.Debug().Any("contractID", contractID).Strs("changedFields", version.ChangedFields).Msg("Requesting contract amendment")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := ensureOriginalContractVersion(tx, *contract); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Model(&types.ContractVersion{}).Where("contract_id = ?", contractID).
+		Select("COALESCE(MAX(version), 0) + 1").Scan(&version.Version).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to get next contract version")
+	}
+	if err := tx.Create(&version).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create contract amendment")
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return contractVersion(s.db, studyID, contractID, version.ID)
+}
+
+// Approve a pending amendment. Its terms are applied to the contract now if
+// already effective, otherwise by ApplyDueContractAmendments
+func (s *Service) ApproveContractAmendment(
+	reviewer types.User,
+	studyID uuid.UUID,
+	contractID uuid.UUID,
+	versionID uuid.UUID,
+	data openapi.ContractAmendmentReview,
+) (*types.ContractVersion, error) {
+	return s.reviewContractAmendment(reviewer, studyID, contractID, versionID, data, types.ContractVersionStatusApproved)
+}
+
+// Reject a pending amendment, leaving the contract unchanged
+func (s *Service) RejectContractAmendment(
+	reviewer types.User,
+	studyID uuid.UUID,
+	contractID uuid.UUID,
+	versionID uuid.UUID,
+	data openapi.ContractAmendmentReview,
+) (*types.ContractVersion, error) {
+	return s.reviewContractAmendment(reviewer, studyID, contractID, versionID, data, types.ContractVersionStatusRejected)
+}
+
+// Apply approved amendments whose effective date has passed, in version order,
+// so the contracts hold their latest effective terms
+func (s *Service) ApplyDueContractAmendments() error {
+	versions := []types.ContractVersion{}
+	err := s.db.Where("status = ? AND applied_at IS NULL AND effective_date <= ?", types.ContractVersionStatusApproved, time.Now()).
+		Order("contract_id, version").
+		Find(&versions).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get due contract amendments")
+	}
+
+	errs := []error{}
+	failedContractIDs := map[uuid.UUID]bool{}
+	for _, version := range versions {
+		if failedContractIDs[version.ContractID] {
+			continue // later versions must not be applied over an earlier one
+		}
+		if err := s.applyDueContractAmendment(version); err != nil {
+			failedContractIDs[version.ContractID] = true
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) applyDueContractAmendment(version types.ContractVersion) error {
+	log.Info().Any("contractID", version.ContractID).Int("version", version.Version).Msg("Applying contract amendment")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := applyContractVersion(tx, version); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return commitTransaction(tx)
+}
+
+func (s *Service) reviewContractAmendment(
+	reviewer types.User,
+	studyID uuid.UUID,
+	contractID uuid.UUID,
+	versionID uuid.UUID,
+	data openapi.ContractAmendmentReview,
+	status types.ContractVersionStatus,
+) (*types.ContractVersion, error) {
+	version, err := contractVersion(s.db, studyID, contractID, versionID)
+	if err != nil {
+		return nil, err
+	} else if version.Status != types.ContractVersionStatusPending {
+		return nil, types.NewErrClientInvalidObjectF("amendment is %s, not pending", version.Status)
+	} else if version.RequesterUserID == reviewer.ID {
+		return nil, types.NewErrClientInvalidObjectF("an amendment cannot be reviewed by its requester")
+	}
+
+	update := types.ContractVersion{
+		Status:         status,
+		ReviewerUserID: &reviewer.ID,
+		ReviewedAt:     new(time.Now()),
+	}
+	if data.Comments != nil && strings.TrimSpace(*data.Comments) != "" {
+		update.ReviewerComments = new(strings.TrimSpace(*data.Comments))
+	}
+
+	log.Debug().Any("versionID", versionID).Any("status", status).Msg("Reviewing contract amendment")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Model(version).Updates(update).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to review contract amendment")
+	}
+
+	if status == types.ContractVersionStatusApproved && !version.EffectiveDate.After(time.Now()) {
+		if err := applyContractVersion(tx, *version); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return contractVersion(s.db, studyID, contractID, versionID)
+}
+
+// Make the terms of a version the current terms of its contract
+func applyContractVersion(tx *gorm.DB, version types.ContractVersion) error {
+	err := tx.Model(&types.Contract{}).Where("id = ?", version.ContractID).Updates(map[string]any{
+		"title":             version.Title,
+		"signatory_user_id": version.SignatoryUserID,
+		"third_party_name":  version.ThirdPartyName,
+		"other_signatories": version.OtherSignatories,
+		"status":            version.ContractStatus,
+		"start_date":        version.StartDate,
+		"expiry_date":       version.ExpiryDate,
+		"version":           version.Version,
+	}).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to apply contract amendment")
+	}
+
+	contract := types.Contract{}
+	contract.ID = version.ContractID
+	assets := []types.Asset{}
+	for _, assetID := range version.AssetIDs {
+		asset := types.Asset{}
+		asset.ID = assetID
+		assets = append(assets, asset)
+	}
+	if err := tx.Model(&contract).Association("Assets").Replace(assets); err != nil {
+		return types.NewErrFromGorm(err, "failed to update contract assets")
+	}
+
+	if err := closeRenewedContract(tx, version.ContractID); err != nil {
+		return err
+	}
+
+	err = tx.Model(&types.ContractVersion{}).Where("id = ?", version.ID).Update("applied_at", time.Now()).Error
+	return types.NewErrFromGorm(err, "failed to mark contract amendment as applied")
+}
+
+// Record the current terms of a contract as version 1 if it has no versions
+func ensureOriginalContractVersion(tx *gorm.DB, contract types.Contract) error {
+	var numVersions int64
+	if err := tx.Model(&types.ContractVersion{}).Where("contract_id = ?", contract.ID).Count(&numVersions).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to count contract versions")
+	} else if numVersions > 0 {
+		return nil
+	}
+	original := originalContractVersion(contract)
+	return types.NewErrFromGorm(tx.Create(&original).Error, "failed to create original contract version")
+}
+
+// Version 1 of a contract with its current terms, effective from its start
+func originalContractVersion(contract types.Contract) types.ContractVersion {
+	version := contractVersionTerms(contract)
+	version.ContractID = contract.ID
+	version.Version = 1
+	version.Status = types.ContractVersionStatusApproved
+	version.EffectiveDate = contract.CreatedAt
+	if contract.StartDate != nil {
+		version.EffectiveDate = *contract.StartDate
+	}
+	version.Reason = originalContractVersionReason
+	version.ChangedFields = []string{}
+	version.RequesterUserID = contract.CreatorUserID
+	version.AppliedAt = &contract.CreatedAt
+	version.CreatedAt = contract.CreatedAt
+	return version
+}
+
+// Terms of a contract as an unsaved version
+func contractVersionTerms(contract types.Contract) types.ContractVersion {
+	version := types.ContractVersion{
+		Title:            contract.Title,
+		SignatoryUserID:  contract.SignatoryUserId,
+		ThirdPartyName:   contract.ThirdPartyName,
+		OtherSignatories: contract.OtherSignatories,
+		ContractStatus:   contract.Status,
+		StartDate:        contract.StartDate,
+		ExpiryDate:       contract.ExpiryDate,
+		AssetIDs:         []uuid.UUID{},
+	}
+	for _, asset := range contract.Assets {
+		version.AssetIDs = append(version.AssetIDs, asset.ID)
+	}
+	return version
+}
+
+// Names of the terms which differ between two versions, as in the API
+func changedContractFields(previous types.ContractVersion, next types.ContractVersion) []string {
+	changed := []string{}
+	if previous.Title != next.Title {
+		changed = append(changed, "title")
+	}
+	if !equalPointers(previous.SignatoryUserID, next.SignatoryUserID) {
+		changed = append(changed, "organisation_signatory")
+	}
+	if !equalPointers(previous.ThirdPartyName, next.ThirdPartyName) {
+		changed = append(changed, "third_party_name")
+	}
+	if !equalPointers(previous.OtherSignatories, next.OtherSignatories) {
+		changed = append(changed, "other_signatories")
+	}
+	if previous.ContractStatus != next.ContractStatus {
+		changed = append(changed, "status")
+	}
+	if !equalDates(previous.StartDate, next.StartDate) {
+		changed = append(changed, "start_date")
+	}
+	if !equalDates(previous.ExpiryDate, next.ExpiryDate) {
+		changed = append(changed, "expiry_date")
+	}
+	previousAssetIDs := slices.SortedFunc(slices.Values(previous.AssetIDs), compareUUIDs)
+	nextAssetIDs := slices.SortedFunc(slices.Values(next.AssetIDs), compareUUIDs)
+	if !slices.Equal(slices.Compact(previousAssetIDs), slices.Compact(nextAssetIDs)) {
+		changed = append(changed, "asset_ids")
+	}
+	return changed
+}
+
+func equalPointers[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalDates(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Format(config.DateFormat) == b.Format(config.DateFormat)
+}
+
+func compareUUIDs(a uuid.UUID, b uuid.UUID) int {
+	return strings.Compare(a.String(), b.String())
+}
+
+// Version of a contract within a study
+func contractVersion(db *gorm.DB, studyID uuid.UUID, contractID uuid.UUID, versionID uuid.UUID) (*types.ContractVersion, error) {
+	version := types.ContractVersion{}
+	result := preloadContractVersion(db).
+		Where("id = ? AND contract_id = ?", versionID, contractID).
+		Where("contract_id IN (?)", db.Model(&types.Contract{}).Select("id").Where("study_id = ?", studyID)).
+		Limit(1).
+		Find(&version)
+	if result.Error != nil {
+		return nil, types.NewErrFromGorm(result.Error, "failed to get contract version")
+	} else if result.RowsAffected == 0 {
+		return nil, types.NewNotFoundError(fmt.Errorf("contract version [%v] not found", versionID))
+	}
+	return &version, nil
+}
+
+func preloadContractVersion(db *gorm.DB) *gorm.DB {
+	return db.Preload("RequesterUser").Preload("ReviewerUser").Preload("SignatoryUser").Preload("Objects")
+}
@@ -0,0 +1,33 @@
+package studies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestChangedContractFields(t *testing.T) {
+	assetID := uuid.New()
+	otherAssetID := uuid.New()
+	expiry := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	previous := types.ContractVersion{
+		Title:          "agreement",
+		ContractStatus: types.ContractStatusActive,
+		ExpiryDate:     &expiry,
+		AssetIDs:       []uuid.UUID{assetID, otherAssetID},
+	}
+
+	next := previous
+	next.ExpiryDate = new(expiry.Add(time.Hour)) // same date
+	next.AssetIDs = []uuid.UUID{otherAssetID, assetID}
+	assert.Empty(t, changedContractFields(previous, next))
+
+	next.Title = "amended agreement"
+	next.ThirdPartyName = new("third party")
+	next.ExpiryDate = new(expiry.AddDate(1, 0, 0))
+	next.AssetIDs = []uuid.UUID{assetID}
+	assert.Equal(t, []string{"title", "third_party_name", "expiry_date", "asset_ids"}, changedContractFields(previous, next))
+}
@@ -0,0 +1,232 @@
+package studies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"github.com/ucl-arc-tre/portal/internal/validation"
+)
+
+// Ethics and regulatory approvals of a study, most recently granted first
+func (s *Service) StudyApprovals(studyID uuid.UUID) ([]types.RegulatoryApproval, error) {
+	approvals := []types.RegulatoryApproval{}
+	err := s.db.Where("study_id = ?", studyID).
+		Order("granted_date DESC, created_at DESC").
+		Find(&approvals).Error
+	return approvals, types.NewErrFromGorm(err, "failed to get study approvals")
+}
+
+func (s *Service) GetApproval(studyID uuid.UUID, approvalID uuid.UUID) (*types.RegulatoryApproval, error) {
+	approval := types.RegulatoryApproval{}
+	err := s.db.Where("id = ? AND study_id = ?", approvalID, studyID).First(&approval).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get approval")
+	}
+	return &approval, nil
+}
+
+func (s *Service) CreateApproval(
+	ctx context.Context,
+	studyID uuid.UUID,
+	data openapi.RegulatoryApprovalBase,
+	creator types.User,
+) (*types.RegulatoryApproval, error) {
+	approval, err := approvalFromBase(data)
+	if err != nil {
+		return nil, err
+	}
+	approval.StudyID = studyID
+	approval.CreatorUserID = creator.ID
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Create(approval).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create approval")
+	}
+
+	// A new approval may replace one which lapsed
+	study, reinstated, err := reinstateStudyProjectsOnApprovalChange(tx, studyID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	s.notifyProjectsSuspension(ctx, *study, reinstated)
+	return approval, nil
+}
+
+func (s *Service) UpdateApproval(
+	ctx context.Context,
+	studyID uuid.UUID,
+	approvalID uuid.UUID,
+	data openapi.RegulatoryApprovalBase,
+) (*types.RegulatoryApproval, error) {
+	update, err := approvalFromBase(data)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := s.GetApproval(studyID, approvalID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	// Select all so that optional fields may be cleared
+	err = tx.Model(approval).
+		Select("type", "reference", "issuing_body", "granted_date", "expiry_date", "conditions").
+		Updates(update).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to update approval")
+	}
+
+	// e.g. the expiry was extended
+	study, reinstated, err := reinstateStudyProjectsOnApprovalChange(tx, studyID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	s.notifyProjectsSuspension(ctx, *study, reinstated)
+	return s.GetApproval(studyID, approvalID)
+}
+
+func (s *Service) DeleteApproval(studyID uuid.UUID, approvalID uuid.UUID) error {
+	log.Debug().Any("approvalID", approvalID).Msg("Deleting approval")
+
+	approval, err := s.GetApproval(studyID, approvalID)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Delete(approval).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete approval")
+	}
+
+	if approval.LetterFilename != nil {
+		if err := s.s3.DeleteObject(approvalLetterMetadata(approval.ID)); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return commitTransaction(tx)
+}
+
+// Store the letter of an approval, replacing any previously uploaded letter
+func (s *Service) StoreApprovalLetter(
+	ctx context.Context,
+	studyID uuid.UUID,
+	approvalID uuid.UUID,
+	filename string,
+	obj types.S3Object,
+) (*types.RegulatoryApproval, error) {
+	if !validation.IsValidContractFilename(filename) {
+		return nil, types.NewErrInvalidObject("filename was invalid")
+	}
+
+	approval, err := s.GetApproval(studyID, approvalID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Str("approvalID", approval.ID.String()).Msg("Storing approval letter")
+	isReplacement := approval.LetterFilename != nil
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Model(approval).Update("letter_filename", filename).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to update approval letter filename")
+	}
+
+	if err := s.s3.StoreObject(ctx, approvalLetterMetadata(approval.ID), obj); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if isReplacement {
+		return approval, commitTransaction(tx) // the previous letter is already overwritten
+	}
+	return approval, s.commitTransactionWithObject(tx, approvalLetterMetadata(approval.ID))
+}
+
+func (s *Service) GetApprovalLetter(ctx context.Context, studyID uuid.UUID, approvalID uuid.UUID) (types.S3Object, error) {
+	approval, err := s.GetApproval(studyID, approvalID)
+	if err != nil {
+		return types.S3Object{}, err
+	} else if approval.LetterFilename == nil {
+		return types.S3Object{}, types.NewNotFoundError(fmt.Errorf("approval [%v] has no letter", approvalID))
+	}
+	return s.s3.GetObject(ctx, approvalLetterMetadata(approval.ID))
+}
+
+func approvalLetterMetadata(approvalID uuid.UUID) s3.ObjectMetadata {
+	return s3.ObjectMetadata{
+		Id:   approvalID,
+		Kind: s3.ApprovalLetterKind,
+	}
+}
+
+func approvalFromBase(data openapi.RegulatoryApprovalBase) (*types.RegulatoryApproval, error) {
+	if !data.Type.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("Invalid approval type [%v]", data.Type)
+	}
+
+	if !validation.ApprovalReferencePattern.MatchString(data.Reference) {
+		return nil, types.NewErrClientInvalidObjectF("Reference must be between 2 and 100 characters")
+	}
+
+	if !validation.ApprovalReferencePattern.MatchString(data.IssuingBody) {
+		return nil, types.NewErrClientInvalidObjectF("Issuing body must be between 2 and 100 characters")
+	}
+
+	if data.Conditions != nil && !validation.ApprovalConditionsPattern.MatchString(*data.Conditions) {
+		return nil, types.NewErrClientInvalidObjectF("Conditions must be at most 1000 characters")
+	}
+
+	grantedDate, err := time.Parse(config.DateFormat, data.GrantedDate)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("Invalid granted date format")
+	}
+
+	approval := types.RegulatoryApproval{
+		Type:        types.RegulatoryApprovalType(data.Type),
+		Reference:   data.Reference,
+		IssuingBody: data.IssuingBody,
+		GrantedDate: grantedDate,
+		Conditions:  data.Conditions,
+	}
+
+	if data.ExpiryDate != nil {
+		expiryDate, err := time.Parse(config.DateFormat, *data.ExpiryDate)
+		if err != nil {
+			return nil, types.NewErrClientInvalidObjectF("Invalid expiry date format")
+		} else if !grantedDate.Before(expiryDate) {
+			return nil, types.NewErrClientInvalidObjectF("Granted date must be before expiry date")
+		}
+		approval.ExpiryDate = &expiryDate
+	}
+
+	return &approval, nil
+}
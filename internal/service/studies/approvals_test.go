@@ -0,0 +1,49 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestApprovalFromBase(t *testing.T) {
+	valid := openapi.RegulatoryApprovalBase{
+		Type:        openapi.RegulatoryApprovalTypeEthics,
+		Reference:   "24/LO/0001",
+		IssuingBody: "London REC",
+		GrantedDate: "2024-01-01",
+		ExpiryDate:  new("2026-01-01"),
+	}
+	approval, err := approvalFromBase(valid)
+	assert.NoError(t, err)
+	assert.Equal(t, "ethics", approval.Type)
+	assert.Equal(t, 2026, approval.ExpiryDate.Year())
+
+	noExpiry := valid
+	noExpiry.ExpiryDate = nil
+	approval, err = approvalFromBase(noExpiry)
+	assert.NoError(t, err)
+	assert.Nil(t, approval.ExpiryDate)
+
+	invalidType := valid
+	invalidType.Type = "unknown"
+	_, err = approvalFromBase(invalidType)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	shortReference := valid
+	shortReference.Reference = "a"
+	_, err = approvalFromBase(shortReference)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	invalidGrantedDate := valid
+	invalidGrantedDate.GrantedDate = "01/01/2024"
+	_, err = approvalFromBase(invalidGrantedDate)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	expiryBeforeGranted := valid
+	expiryBeforeGranted.ExpiryDate = new("2023-01-01")
+	_, err = approvalFromBase(expiryBeforeGranted)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+}
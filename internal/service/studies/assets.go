@@ -32,9 +32,16 @@ func (s *Service) validateAssetData(data openapi.AssetBase) error {
 		return types.NewErrClientInvalidObjectF("tier must be between 0 and 4")
 	}
 
-	if len(data.Locations) == 0 {
+	if len(data.Locations) == 0 && (data.LocationReferences == nil || len(*data.LocationReferences) == 0) {
 		return types.NewErrClientInvalidObjectF("at least one location must be specified")
 	}
+	if data.LocationReferences != nil {
+		for _, reference := range *data.LocationReferences {
+			if err := validateLocationReference(reference); err != nil {
+				return err
+			}
+		}
+	}
 
 	isPersonal := slices.Contains(data.DataTypes, openapi.AssetBaseDataTypesPersonal)
 	isSpecialCategoryPersonal := slices.Contains(data.DataTypes, openapi.AssetBaseDataTypesSpecialCategoryPersonal)
@@ -78,6 +85,19 @@ func (s *Service) validateAssetData(data openapi.AssetBase) error {
 		}
 	}
 
+	return validateAssetTier(data)
+}
+
+// Assets are only destroyed by an approved destruction request, after which
+// they cannot be modified
+func validateAssetStatusChange(existing *types.Asset, data openapi.AssetBase) error {
+	if existing != nil && (existing.IsDestroyed() || existing.IsTransferred()) {
+		return types.NewErrClientInvalidObjectF("%s assets cannot be modified", existing.Status)
+	} else if data.Status == openapi.AssetBaseStatusDestroyed {
+		return types.NewErrClientInvalidObjectF("assets can only be destroyed by an approved destruction request")
+	} else if data.Status == openapi.AssetBaseStatusTransferred {
+		return types.NewErrClientInvalidObjectF("assets can only be transferred to another study by a transfer request")
+	}
 	return nil
 }
 
@@ -119,6 +139,14 @@ func assetFromBase(data openapi.AssetBase) (*types.Asset, error) {
 	return asset, nil
 }
 
+// Expiry date of an asset, empty if it has none
+func expiryDate(asset types.Asset) string {
+	if asset.ExpiresAt == nil {
+		return ""
+	}
+	return asset.ExpiresAt.Format(config.DateFormat)
+}
+
 func (s *Service) checkAssetExists(studyID uuid.UUID, assetID uuid.UUID) error {
 	exists := false
 	err := s.db.Model(&types.Asset{}).
@@ -138,6 +166,8 @@ func (s *Service) CreateAsset(creator types.User, assetData openapi.AssetBase, s
 
 	if err := s.validateAssetData(assetData); err != nil {
 		return err
+	} else if err := validateAssetStatusChange(nil, assetData); err != nil {
+		return err
 	}
 
 	asset, err := assetFromBase(assetData)
@@ -155,11 +185,12 @@ func (s *Service) CreateAsset(creator types.User, assetData openapi.AssetBase, s
 		return types.NewErrFromGorm(err, "failed to create asset")
 	}
 
-	for _, locationStr := range assetData.Locations {
-		assetLocation := types.AssetLocation{
-			AssetID:  asset.ID,
-			Location: locationStr,
-		}
+	locations, err := assetLocationsFromBase(tx, *asset, assetData)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, assetLocation := range locations {
 		if err := tx.Create(&assetLocation).Error; err != nil {
 			tx.Rollback()
 			return types.NewErrFromGorm(err, "failed to create asset location")
@@ -177,6 +208,16 @@ func (s *Service) CreateAsset(creator types.User, assetData openapi.AssetBase, s
 		}
 	}
 
+	if err := updateStudyRisk(tx, studyID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := ensureStudyDpia(tx, *asset); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return commitTransaction(tx)
 }
 
@@ -192,15 +233,25 @@ func (s *Service) UpdateAsset(assetData openapi.AssetBase, studyID uuid.UUID, as
 	if err != nil {
 		return nil, err
 	}
+	if err := validateAssetStatusChange(&existingAsset, assetData); err != nil {
+		return nil, err
+	}
 
 	asset, err := assetFromBase(assetData)
 	if err != nil {
 		return nil, err
 	}
 	asset.ID = assetID
+	if err := validateLineageTiers(s.db, *asset); err != nil {
+		return nil, err
+	}
 	asset.StudyID = studyID
+	if existingAsset.IsExpired() && expiryDate(existingAsset) != expiryDate(*asset) {
+		return nil, types.NewErrClientInvalidObjectF("the expiry of an expired asset can only be extended through its expiry review")
+	}
 	asset.CreatorUserID = existingAsset.CreatorUserID
 	asset.CreatedAt = existingAsset.CreatedAt
+	asset.ExpiredAt = existingAsset.ExpiredAt
 	asset.UpdatedAt = time.Now()
 
 	tx := s.db.Begin()
@@ -218,12 +269,10 @@ func (s *Service) UpdateAsset(assetData openapi.AssetBase, studyID uuid.UUID, as
 		tx.Rollback()
 		return nil, types.NewErrFromGorm(err, "failed to list existing asset locations")
 	}
-	newLocations := []types.AssetLocation{}
-	for _, locationStr := range assetData.Locations {
-		newLocations = append(newLocations, types.AssetLocation{
-			AssetID:  assetID,
-			Location: locationStr,
-		})
+	newLocations, err := assetLocationsFromBase(tx, *asset, assetData)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 	if err := graceful.UpdateManyExisting(tx, existingLocations, newLocations); err != nil {
 		tx.Rollback()
@@ -249,6 +298,16 @@ func (s *Service) UpdateAsset(assetData openapi.AssetBase, studyID uuid.UUID, as
 		return nil, types.NewErrFromGorm(err, "failed to update asset data types")
 	}
 
+	if err := updateStudyRisk(tx, studyID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := ensureStudyDpia(tx, *asset); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if err := commitTransaction(tx); err != nil {
 		return nil, err
 	}
@@ -273,13 +332,29 @@ func (s *Service) DeleteAsset(studyID uuid.UUID, assetID uuid.UUID) error {
 		return err
 	}
 
+	if asset.IsDestroyed() {
+		return types.NewErrClientInvalidObjectF("destroyed assets cannot be deleted")
+	}
+
 	if len(asset.Contracts) > 0 {
 		return types.NewErrClientInvalidObjectF("cannot delete asset that is linked to one or more contracts, please unlink the asset from all contracts before deleting")
 	}
 
+	var numDerivedAssets int64
+	if err := s.db.Model(&types.AssetLink{}).Where("parent_asset_id = ?", assetID).Count(&numDerivedAssets).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to count derived assets")
+	} else if numDerivedAssets > 0 {
+		return types.NewErrClientInvalidObjectF("cannot delete asset that other assets are derived from, please unlink the derived assets before deleting")
+	}
+
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
 
+	if err := tx.Where("child_asset_id = ?", assetID).Delete(&types.AssetLink{}).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete asset links")
+	}
+
 	if err := tx.Where("asset_id = ?", assetID).Delete(&types.AssetLocation{}).Error; err != nil {
 		tx.Rollback()
 		return types.NewErrFromGorm(err, "failed to delete asset locations")
@@ -290,21 +365,34 @@ func (s *Service) DeleteAsset(studyID uuid.UUID, assetID uuid.UUID) error {
 		return types.NewErrFromGorm(err, "failed to delete asset")
 	}
 
+	if err := updateStudyRisk(tx, studyID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return commitTransaction(tx)
 }
 
 // retrieves all assets for a study
 func (s *Service) Assets(studyID uuid.UUID) ([]types.Asset, error) {
 	assets := []types.Asset{}
-	err := s.db.Preload("Locations").Preload("DataTypes").Preload("Contracts.Assets").Where("study_id = ?", studyID).Find(&assets).Error
-	return assets, types.NewErrFromGorm(err, "failed to get assets")
+	err := s.db.Preload("Locations.Environment").Preload("Locations.Project").Preload("DataTypes").Preload("Contracts.Assets").Where("study_id = ?", studyID).Find(&assets).Error
+	if err != nil {
+		return assets, types.NewErrFromGorm(err, "failed to get assets")
+	}
+	return assets, s.setInheritedContracts(studyID, assets)
 }
 
 // retrieves a specific asset within a study
 func (s *Service) AssetById(studyID uuid.UUID, assetID uuid.UUID) (types.Asset, error) {
 	asset := types.Asset{}
-	err := s.db.Preload("Locations").Preload("DataTypes").Preload("Contracts.Assets").Where("study_id = ? AND id = ?", studyID, assetID).First(&asset).Error
-	return asset, types.NewErrFromGorm(err, "failed to get asset by id")
+	err := s.db.Preload("Locations.Environment").Preload("Locations.Project").Preload("DataTypes").Preload("Contracts.Assets").Where("study_id = ? AND id = ?", studyID, assetID).First(&asset).Error
+	if err != nil {
+		return asset, types.NewErrFromGorm(err, "failed to get asset by id")
+	}
+	assets := []types.Asset{asset}
+	err = s.setInheritedContracts(studyID, assets)
+	return assets[0], err
 }
 
 // retrieves all contracts for a specific asset within a study
@@ -187,6 +187,23 @@ func TestValidateAssetData(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "location references only",
+			modify: func(a *openapi.AssetBase) {
+				a.Locations = []string{}
+				a.LocationReferences = &[]openapi.AssetLocationReference{
+					{Kind: openapi.AssetLocationKindExternalSystem, Name: new("REDCap")},
+				}
+			},
+			wantError: false,
+		},
+		{
+			name: "invalid location reference",
+			modify: func(a *openapi.AssetBase) {
+				a.LocationReferences = &[]openapi.AssetLocationReference{{Kind: openapi.AssetLocationKindProject}}
+			},
+			wantError: true,
+		},
 	}
 
 	for _, curTest := range tests {
@@ -205,3 +222,29 @@ func TestValidateAssetData(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAssetStatusChange(t *testing.T) {
+	active := validAssetBase()
+	destroyed := validAssetBase()
+	destroyed.Status = openapi.AssetBaseStatusDestroyed
+	transferred := validAssetBase()
+	transferred.Status = openapi.AssetBaseStatusTransferred
+
+	assert.NoError(t, validateAssetStatusChange(nil, active))
+	assert.NoError(t, validateAssetStatusChange(&types.Asset{Status: types.AssetStatusActive}, active))
+
+	err := validateAssetStatusChange(nil, destroyed)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	err = validateAssetStatusChange(&types.Asset{Status: types.AssetStatusActive}, destroyed)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	err = validateAssetStatusChange(&types.Asset{Status: types.AssetStatusDestroyed}, active)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	err = validateAssetStatusChange(&types.Asset{Status: types.AssetStatusActive}, transferred)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	err = validateAssetStatusChange(&types.Asset{Status: types.AssetStatusTransferred}, active)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+}
@@ -0,0 +1,619 @@
+package studies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Names of the sheets of a bulk import workbook, or of its CSV files
+const (
+	BulkImportStudiesSheet   = "studies"
+	BulkImportAssetsSheet    = "assets"
+	BulkImportContractsSheet = "contracts"
+)
+
+const bulkImportListSeparator = ";"
+
+// Asset and contract rows are joined to the study with the same caseref. Assets may also
+// list their data types, which are required to import some tier 2 assets
+type bulkImportAssetRow struct {
+	Caseref   int      `json:"caseref"`
+	DataTypes []string `json:"data_types,omitempty"`
+	openapi.AssetImport
+}
+
+type bulkImportContractRow struct {
+	Caseref int `json:"caseref"`
+	openapi.ContractImport
+}
+
+type bulkImportRow[T any] struct {
+	number int
+	data   T
+}
+
+type bulkImportStudy struct {
+	row           int
+	imported      importedStudy
+	ownerUsername types.Username
+	adminUsername *types.Username
+}
+
+type bulkImportAsset struct {
+	row       int
+	caseref   int
+	asset     *types.Asset
+	locations []string
+	dataTypes []string
+}
+
+type bulkImportContract struct {
+	row       int
+	caseref   int
+	contract  *types.Contract
+	signatory *types.Username
+}
+
+type bulkImportPlan struct {
+	studies   []bulkImportStudy
+	assets    []bulkImportAsset
+	contracts []bulkImportContract
+}
+
+// Import studies, assets and contracts from the sheets of a workbook. Every row is validated
+// before any is imported and either all rows are imported or none are. A dry run validates
+// and imports the rows but rolls back rather than committing
+func (s *Service) BulkImport(ctx context.Context, sheets []spreadsheet.Sheet, dryRun bool) (*BulkImportReport, error) {
+	if !slices.ContainsFunc(sheets, func(sheet spreadsheet.Sheet) bool {
+		return slices.Contains([]string{BulkImportStudiesSheet, BulkImportAssetsSheet, BulkImportContractsSheet}, sheetName(sheet))
+	}) {
+		return nil, types.NewErrClientInvalidObjectF("workbook must have a %s, %s or %s sheet",
+			BulkImportStudiesSheet, BulkImportAssetsSheet, BulkImportContractsSheet)
+	}
+
+	report := BulkImportReport{DryRun: dryRun, Errors: []BulkImportError{}}
+	studyRows := decodeBulkImportSheet[openapi.StudyImport](sheets, BulkImportStudiesSheet, &report)
+	assetRows := decodeBulkImportSheet[bulkImportAssetRow](sheets, BulkImportAssetsSheet, &report)
+	contractRows := decodeBulkImportSheet[bulkImportContractRow](sheets, BulkImportContractsSheet, &report)
+
+	plan, err := s.planBulkImport(ctx, studyRows, assetRows, contractRows, &report)
+	if err != nil {
+		return nil, err
+	} else if len(report.Errors) > 0 {
+		return &report, nil
+	}
+
+	imported, err := s.runBulkImport(*plan, &report)
+	if err != nil {
+		return nil, err
+	} else if len(report.Errors) > 0 || dryRun {
+		return &report, nil
+	}
+
+	report.Committed = true
+	log.Info().Int("studies", report.Studies).Int("assets", report.Assets).Int("contracts", report.Contracts).Msg("Bulk imported studies")
+
+	// Roles are added after the import is committed so they are not left behind by a failed
+	// import. As adding roles is idempotent a failure can be fixed by importing again
+	for _, study := range imported {
+		if err := addImportedStudyRoles(study.study, study.imported); err != nil {
+			return nil, err
+		}
+	}
+	return &report, nil
+}
+
+// Validate all rows, adding an error to the report for each invalid row
+func (s *Service) planBulkImport(
+	ctx context.Context,
+	studyRows []bulkImportRow[openapi.StudyImport],
+	assetRows []bulkImportRow[bulkImportAssetRow],
+	contractRows []bulkImportRow[bulkImportContractRow],
+	report *BulkImportReport,
+) (*bulkImportPlan, error) {
+	existingCaserefs, err := s.existingCaserefs(studyRows, assetRows, contractRows)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := bulkImportPlan{}
+	importedCaserefs := map[int]bool{}
+	titles := map[string]bool{}
+	for _, row := range studyRows {
+		study, err := s.planBulkImportStudy(ctx, row.data, existingCaserefs[row.data.Caseref])
+		if err == nil && importedCaserefs[row.data.Caseref] {
+			err = types.NewErrClientInvalidObjectF("caseref [%d] is imported more than once", row.data.Caseref)
+		} else if err == nil && titles[strings.ToLower(row.data.Title)] {
+			err = types.NewErrClientInvalidObjectF("title [%v] is imported more than once", row.data.Title)
+		}
+		if err != nil {
+			if err := addBulkImportError(report, BulkImportStudiesSheet, row.number, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		study.row = row.number
+		plan.studies = append(plan.studies, *study)
+		importedCaserefs[row.data.Caseref] = true
+		titles[strings.ToLower(row.data.Title)] = true
+	}
+
+	knownCaseref := func(caseref int) error {
+		if !importedCaserefs[caseref] && !existingCaserefs[caseref] {
+			return types.NewErrClientInvalidObjectF("no study with caseref [%d]", caseref)
+		}
+		return nil
+	}
+
+	assetTitles := map[string]bool{}
+	for _, row := range assetRows {
+		key := fmt.Sprintf("%d/%s", row.data.Caseref, strings.ToLower(row.data.Title))
+		err := knownCaseref(row.data.Caseref)
+		if err == nil && assetTitles[key] {
+			err = types.NewErrClientInvalidObjectF("asset [%v] is imported more than once for caseref [%d]", row.data.Title, row.data.Caseref)
+		}
+		var asset *bulkImportAsset
+		if err == nil {
+			asset, err = s.planBulkImportAsset(row.data)
+		}
+		if err != nil {
+			if err := addBulkImportError(report, BulkImportAssetsSheet, row.number, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		asset.row = row.number
+		plan.assets = append(plan.assets, *asset)
+		assetTitles[key] = true
+	}
+
+	contractTitles := map[string]bool{}
+	for _, row := range contractRows {
+		key := fmt.Sprintf("%d/%s", row.data.Caseref, strings.ToLower(row.data.Title))
+		err := knownCaseref(row.data.Caseref)
+		if err == nil && contractTitles[key] {
+			err = types.NewErrClientInvalidObjectF("contract [%v] is imported more than once for caseref [%d]", row.data.Title, row.data.Caseref)
+		}
+		var contract *bulkImportContract
+		if err == nil {
+			contract, err = s.planBulkImportContract(ctx, row.data)
+		}
+		if err != nil {
+			if err := addBulkImportError(report, BulkImportContractsSheet, row.number, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		contract.row = row.number
+		plan.contracts = append(plan.contracts, *contract)
+		contractTitles[key] = true
+	}
+	return &plan, nil
+}
+
+func (s *Service) planBulkImportStudy(ctx context.Context, data openapi.StudyImport, exists bool) (*bulkImportStudy, error) {
+	if data.Caseref < 0 {
+		return nil, types.NewErrClientInvalidObjectF("caseref must not be negative")
+	}
+	imported, err := studyFromImport(data)
+	if err != nil {
+		return nil, err
+	}
+
+	study := bulkImportStudy{imported: *imported, ownerUsername: types.Username(data.OwnerUsername)}
+	if !study.ownerUsername.IsValid() {
+		return nil, types.NewErrClientInvalidObjectF("owner username [%v] invalid", data.OwnerUsername)
+	}
+	if data.AdditionalStudyAdminUsername != nil {
+		study.adminUsername = new(types.Username(*data.AdditionalStudyAdminUsername))
+		if !study.adminUsername.IsValid() {
+			return nil, types.NewErrClientInvalidObjectF("study admin username [%v] invalid", *data.AdditionalStudyAdminUsername)
+		}
+	}
+
+	if err := s.validateStudyData(ctx, studyRequestFromImport(data), exists, study.ownerUsername); err != nil {
+		return nil, err
+	}
+	return &study, nil
+}
+
+func (s *Service) planBulkImportAsset(data bulkImportAssetRow) (*bulkImportAsset, error) {
+	asset, err := assetFromImport(data.AssetImport)
+	if err != nil {
+		return nil, err
+	} else if slices.Contains(data.Locations, "") {
+		return nil, types.NewErrClientInvalidObjectF("locations must not be empty")
+	}
+	assetBase := assetBaseFromImport(data.AssetImport, *asset)
+	for _, dataType := range data.DataTypes {
+		assetBase.DataTypes = append(assetBase.DataTypes, openapi.AssetBaseDataTypes(dataType))
+	}
+	if err := s.validateAssetData(assetBase); err != nil {
+		return nil, err
+	}
+	return &bulkImportAsset{caseref: data.Caseref, asset: asset, locations: data.Locations, dataTypes: data.DataTypes}, nil
+}
+
+func (s *Service) planBulkImportContract(ctx context.Context, data bulkImportContractRow) (*bulkImportContract, error) {
+	contract, err := contractFromImport(data.ContractImport)
+	if err != nil {
+		return nil, err
+	}
+	planned := bulkImportContract{caseref: data.Caseref, contract: contract}
+	if data.OrganisationSignatory != nil {
+		signatory, err := s.contractSignatoryUsername(ctx, *data.OrganisationSignatory)
+		if err != nil {
+			return nil, err
+		}
+		planned.signatory = &signatory
+	}
+	return &planned, nil
+}
+
+// Import all rows of a validated plan in a single transaction, committing unless a dry run
+func (s *Service) runBulkImport(plan bulkImportPlan, report *BulkImportReport) ([]bulkImportStudyResult, error) {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	users := map[types.Username]types.User{}
+	persistedUser := func(username types.Username) (types.User, error) {
+		if user, exists := users[username]; exists {
+			return user, nil
+		}
+		user, err := firstOrCreateUser(tx, username)
+		users[username] = user
+		return user, err
+	}
+
+	imported := []bulkImportStudyResult{}
+	studies := map[int]types.Study{}
+	for _, row := range plan.studies {
+		owner, err := persistedUser(row.ownerUsername)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		var admin *types.User
+		if row.adminUsername != nil {
+			user, err := persistedUser(*row.adminUsername)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			admin = &user
+		}
+
+		study, err := importStudy(tx, row.imported, owner, admin)
+		if err != nil {
+			tx.Rollback()
+			return nil, addBulkImportError(report, BulkImportStudiesSheet, row.row, err)
+		}
+		studies[study.Caseref] = *study
+		imported = append(imported, bulkImportStudyResult{study: *study, imported: row.imported})
+		report.Studies++
+	}
+
+	studyOfCaseref := func(caseref int) (types.Study, error) {
+		if study, exists := studies[caseref]; exists {
+			return study, nil
+		}
+		study := types.Study{}
+		if err := tx.Where("caseref = ?", caseref).First(&study).Error; err != nil {
+			return study, types.NewErrFromGorm(err, "failed to get study")
+		}
+		studies[caseref] = study
+		return study, nil
+	}
+
+	for _, row := range plan.assets {
+		study, err := studyOfCaseref(row.caseref)
+		if err == nil {
+			err = importAsset(tx, study, row.asset, row.locations, row.dataTypes)
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, addBulkImportError(report, BulkImportAssetsSheet, row.row, err)
+		}
+		report.Assets++
+	}
+
+	for _, row := range plan.contracts {
+		study, err := studyOfCaseref(row.caseref)
+		if err == nil && row.signatory != nil {
+			var signatory types.User
+			signatory, err = persistedUser(*row.signatory)
+			row.contract.SignatoryUserId = &signatory.ID
+			row.contract.SignatoryUser = signatory
+		}
+		if err == nil {
+			err = importContract(tx, study, row.contract)
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, addBulkImportError(report, BulkImportContractsSheet, row.row, err)
+		}
+		report.Contracts++
+	}
+
+	if report.DryRun {
+		tx.Rollback()
+		return imported, nil
+	}
+	return imported, commitTransaction(tx)
+}
+
+type bulkImportStudyResult struct {
+	study    types.Study
+	imported importedStudy
+}
+
+// Caserefs of the rows which already exist
+func (s *Service) existingCaserefs(
+	studyRows []bulkImportRow[openapi.StudyImport],
+	assetRows []bulkImportRow[bulkImportAssetRow],
+	contractRows []bulkImportRow[bulkImportContractRow],
+) (map[int]bool, error) {
+	caserefs := []int{}
+	for _, row := range studyRows {
+		caserefs = append(caserefs, row.data.Caseref)
+	}
+	for _, row := range assetRows {
+		caserefs = append(caserefs, row.data.Caseref)
+	}
+	for _, row := range contractRows {
+		caserefs = append(caserefs, row.data.Caseref)
+	}
+	slices.Sort(caserefs)
+	caserefs = slices.Compact(caserefs)
+
+	existing := map[int]bool{}
+	if len(caserefs) == 0 {
+		return existing, nil
+	}
+	found := []int{}
+	if err := s.db.Model(&types.Study{}).Where("caseref IN ?", caserefs).Pluck("caseref", &found).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get existing caserefs")
+	}
+	for _, caseref := range found {
+		existing[caseref] = true
+	}
+	return existing, nil
+}
+
+// Get or create a user within a transaction. Unlike a persisted user from the users service,
+// users created by an import are not notified to complete their profile
+func firstOrCreateUser(tx *gorm.DB, username types.Username) (types.User, error) {
+	user := types.User{}
+	err := tx.Where("username = ?", username).
+		Attrs(types.User{Username: username, Model: types.Model{CreatedAt: time.Now()}}).
+		FirstOrCreate(&user).Error
+	return user, types.NewErrFromGorm(err, "failed to get or create user")
+}
+
+func studyRequestFromImport(data openapi.StudyImport) openapi.StudyRequest {
+	studyRequest := openapi.StudyRequest{
+		AdditionalStudyAdminUsernames:    []string{},
+		CagReference:                     data.CagReference,
+		DataControllerOrganisation:       data.DataControllerOrganisation,
+		DataProtectionNumber:             data.DataProtectionNumber,
+		Description:                      data.Description,
+		InvolvesCag:                      data.InvolvesCag,
+		InvolvesDataProcessingOutsideEea: data.InvolvesDataProcessingOutsideEea,
+		InvolvesEthicsApproval:           data.InvolvesEthicsApproval,
+		InvolvesExternalUsers:            data.InvolvesExternalUsers,
+		InvolvesHraApproval:              data.InvolvesHraApproval,
+		InvolvesIndirectDataCollection:   data.InvolvesIndirectDataCollection,
+		InvolvesMnca:                     data.InvolvesMnca,
+		InvolvesNhsEngland:               data.InvolvesNhsEngland,
+		InvolvesParticipantConsent:       data.InvolvesParticipantConsent,
+		InvolvesThirdParty:               data.InvolvesThirdParty,
+		InvolvesUclSponsorship:           data.InvolvesUclSponsorship,
+		IrasId:                           data.IrasId,
+		IsDataProtectionOfficeRegistered: data.IsDataProtectionOfficeRegistered,
+		IsNhsAssociated:                  data.IsNhsAssociated,
+		NhsEnglandReference:              data.NhsEnglandReference,
+		OwnerUsername:                    &data.OwnerUsername,
+		RequiresDbs:                      data.RequiresDbs,
+		RequiresDspt:                     data.RequiresDspt,
+		Title:                            data.Title,
+	}
+	if data.AdditionalStudyAdminUsername != nil {
+		studyRequest.AdditionalStudyAdminUsernames = append(studyRequest.AdditionalStudyAdminUsernames, *data.AdditionalStudyAdminUsername)
+	}
+	return studyRequest
+}
+
+func assetBaseFromImport(data openapi.AssetImport, asset types.Asset) openapi.AssetBase {
+	assetBase := openapi.AssetBase{
+		ClassificationImpact: openapi.AssetBaseClassificationImpact(asset.ClassificationImpact),
+		DataTypes:            []openapi.AssetBaseDataTypes{},
+		Description:          data.Description,
+		Format:               openapi.AssetBaseFormat(data.Format),
+		HasDspt:              data.HasDspt,
+		Locations:            data.Locations,
+		RequiresContract:     data.RequiresContract,
+		Status:               openapi.AssetBaseStatus(data.Status),
+		StoredOutsideUkEea:   data.StoredOutsideUkEea,
+		Tier:                 data.Tier,
+		Title:                data.Title,
+	}
+	if data.Protection != nil {
+		assetBase.Protection = new(openapi.AssetBaseProtection(*data.Protection))
+	}
+	if data.LegalBasis != nil {
+		assetBase.LegalBasis = new(openapi.AssetBaseLegalBasis(*data.LegalBasis))
+	}
+	if asset.ExpiresAt != nil {
+		assetBase.ExpiresAt = new(asset.ExpiresAt.Format(config.DateFormat))
+	}
+	return assetBase
+}
+
+// Add an error to the report if it was caused by the content of a row. Any other error is returned
+func addBulkImportError(report *BulkImportReport, sheet string, row int, err error) error {
+	if err == nil {
+		return nil
+	}
+	message := ""
+	if clientErr, ok := errors.AsType[*types.ErrClientInvalidObject](err); ok && clientErr != nil {
+		message = clientErr.ClientReadableReason
+	} else if errors.Is(err, types.ErrInvalidObject) || errors.Is(err, types.ErrNotFound) {
+		message = err.Error()
+	} else {
+		return err
+	}
+	report.Errors = append(report.Errors, BulkImportError{Sheet: sheet, Row: row, Message: message})
+	return nil
+}
+
+func sheetName(sheet spreadsheet.Sheet) string {
+	return strings.ToLower(strings.TrimSpace(sheet.Name))
+}
+
+// Decode the rows of a named sheet into values. The first row names the columns with the
+// JSON names of the fields. Rows which cannot be decoded are added to the report
+func decodeBulkImportSheet[T any](sheets []spreadsheet.Sheet, name string, report *BulkImportReport) []bulkImportRow[T] {
+	rows := []bulkImportRow[T]{}
+	index := slices.IndexFunc(sheets, func(sheet spreadsheet.Sheet) bool { return sheetName(sheet) == name })
+	if index < 0 || len(sheets[index].Rows) == 0 {
+		return rows
+	}
+	sheet := sheets[index]
+
+	columns, err := bulkImportColumns[T](sheet.Rows[0])
+	if err != nil {
+		report.Errors = append(report.Errors, BulkImportError{Sheet: name, Row: 1, Message: err.Error()})
+		return rows
+	}
+
+	for i, values := range sheet.Rows[1:] {
+		if !slices.ContainsFunc(values, func(value string) bool { return strings.TrimSpace(value) != "" }) {
+			continue
+		}
+		row := bulkImportRow[T]{number: i + 2}
+		if err := decodeBulkImportRow(columns, values, &row.data); err != nil {
+			report.Errors = append(report.Errors, BulkImportError{Sheet: name, Row: row.number, Message: err.Error()})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+type bulkImportColumn struct {
+	name     string
+	field    reflect.StructField
+	required bool
+}
+
+// Columns of a header row, which must name each required field and no unknown fields
+func bulkImportColumns[T any](header []string) ([]*bulkImportColumn, error) {
+	fields := map[string]*bulkImportColumn{}
+	for _, field := range reflect.VisibleFields(reflect.TypeFor[T]()) {
+		tag := field.Tag.Get("json")
+		if field.Anonymous || tag == "" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		fields[name] = &bulkImportColumn{name: name, field: field, required: options != "omitempty"}
+	}
+
+	columns := []*bulkImportColumn{}
+	for _, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		column, exists := fields[name]
+		if name == "" {
+			columns = append(columns, nil)
+			continue
+		} else if !exists {
+			return nil, fmt.Errorf("unknown column [%v]", name)
+		} else if slices.Contains(columns, column) {
+			return nil, fmt.Errorf("column [%v] is repeated", name)
+		}
+		columns = append(columns, column)
+	}
+
+	for name, column := range fields {
+		if column.required && !slices.Contains(columns, column) {
+			return nil, fmt.Errorf("missing column [%v]", name)
+		}
+	}
+	return columns, nil
+}
+
+// Decode cell values into the fields of their columns. Booleans may be true/false or yes/no
+// and lists are separated by semicolons
+func decodeBulkImportRow(columns []*bulkImportColumn, values []string, out any) error {
+	target := reflect.ValueOf(out).Elem()
+	for i, column := range columns {
+		if column == nil {
+			continue
+		}
+		value := ""
+		if i < len(values) {
+			value = strings.TrimSpace(values[i])
+		}
+		field := target.FieldByIndex(column.field.Index)
+		if value == "" {
+			if column.required && field.Kind() != reflect.Bool && field.Kind() != reflect.Slice {
+				return fmt.Errorf("%v is required", column.name)
+			}
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			field.Set(reflect.New(field.Type().Elem()))
+			field = field.Elem()
+		}
+		if err := setBulkImportValue(field, value); err != nil {
+			return fmt.Errorf("%v [%v] invalid: %w", column.name, value, err)
+		}
+	}
+	return nil
+}
+
+func setBulkImportValue(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New("not an integer")
+		}
+		field.SetInt(int64(number))
+	case reflect.Bool:
+		switch strings.ToLower(value) {
+		case "true", "yes", "y", "1":
+			field.SetBool(true)
+		case "false", "no", "n", "0":
+			field.SetBool(false)
+		default:
+			return errors.New("not true or false")
+		}
+	case reflect.Slice:
+		items := []string{}
+		for item := range strings.SplitSeq(value, bulkImportListSeparator) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type [%v]", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+var bulkImportAssetHeader = []string{
+	"caseref", "title", "description", "tier", "format", "status", "locations", "created_at",
+	"expires_at", "has_dspt", "requires_contract", "stored_outside_uk_eea", "protection",
+}
+
+func TestDecodeBulkImportSheet(t *testing.T) {
+	sheets := []spreadsheet.Sheet{{Name: " Assets ", Rows: [][]string{
+		bulkImportAssetHeader,
+		{"12", "Survey", "Survey data", "2", "electronic", "active", "UK; EU;", "2020-01-01T00:00:00Z", "2030-01-01T00:00:00Z", "yes", "FALSE", "", "anonymisation"},
+		{"", "", ""},
+		{"12", "Interviews", "Interview data", "two"},
+		{"", "Notes", "Notes data", "1"},
+	}}}
+	report := BulkImportReport{}
+	rows := decodeBulkImportSheet[bulkImportAssetRow](sheets, BulkImportAssetsSheet, &report)
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, 2, rows[0].number)
+	assert.Equal(t, 12, rows[0].data.Caseref)
+	assert.Equal(t, 2, rows[0].data.Tier)
+	assert.Equal(t, []string{"UK", "EU"}, rows[0].data.Locations)
+	assert.True(t, rows[0].data.HasDspt)
+	assert.False(t, rows[0].data.RequiresContract)
+	assert.Equal(t, new("anonymisation"), rows[0].data.Protection)
+	assert.Nil(t, rows[0].data.LegalBasis)
+
+	assert.Equal(t, []BulkImportError{
+		{Sheet: BulkImportAssetsSheet, Row: 4, Message: "tier [two] invalid: not an integer"},
+		{Sheet: BulkImportAssetsSheet, Row: 5, Message: "caseref is required"},
+	}, report.Errors)
+}
+
+func TestBulkImportColumns(t *testing.T) {
+	_, err := bulkImportColumns[bulkImportAssetRow](append(bulkImportAssetHeader, "colour"))
+	assert.ErrorContains(t, err, "unknown column [colour]")
+
+	_, err = bulkImportColumns[bulkImportAssetRow](append(bulkImportAssetHeader, "Title"))
+	assert.ErrorContains(t, err, "column [title] is repeated")
+
+	_, err = bulkImportColumns[bulkImportAssetRow](bulkImportAssetHeader[1:])
+	assert.ErrorContains(t, err, "missing column [caseref]")
+
+	columns, err := bulkImportColumns[bulkImportAssetRow](append(bulkImportAssetHeader, ""))
+	assert.NoError(t, err)
+	assert.Nil(t, columns[len(columns)-1])
+}
+
+func TestAddBulkImportError(t *testing.T) {
+	report := BulkImportReport{}
+	assert.NoError(t, addBulkImportError(&report, BulkImportStudiesSheet, 2, nil))
+	assert.NoError(t, addBulkImportError(&report, BulkImportStudiesSheet, 3, types.NewErrClientInvalidObjectF("title invalid")))
+	assert.NoError(t, addBulkImportError(&report, BulkImportStudiesSheet, 4, types.NewErrInvalidObject("invalid tier impact")))
+	assert.Error(t, addBulkImportError(&report, BulkImportStudiesSheet, 5, types.NewErrServerError("failed")))
+
+	assert.Equal(t, []BulkImportError{
+		{Sheet: BulkImportStudiesSheet, Row: 3, Message: "title invalid"},
+		{Sheet: BulkImportStudiesSheet, Row: 4, Message: "invalid object: invalid tier impact"},
+	}, report.Errors)
+}
+
+func TestAssetBaseFromImport(t *testing.T) {
+	data := openapi.AssetImport{
+		Title:       "Survey",
+		Description: "Survey data",
+		Tier:        1,
+		Format:      "electronic",
+		Status:      "active",
+		Locations:   []string{"UK"},
+		CreatedAt:   "2020-01-01T00:00:00Z",
+		ExpiresAt:   "2030-06-01T00:00:00Z",
+		LegalBasis:  new("consent"),
+	}
+	asset, err := assetFromImport(data)
+	require.NoError(t, err)
+
+	assetBase := assetBaseFromImport(data, *asset)
+	assert.Equal(t, openapi.AssetBaseClassificationImpactConfidential, assetBase.ClassificationImpact)
+	assert.Equal(t, new("2030-06-01"), assetBase.ExpiresAt)
+	assert.Equal(t, new(openapi.AssetBaseLegalBasisConsent), assetBase.LegalBasis)
+	assert.Nil(t, assetBase.Protection)
+	assert.NoError(t, (&Service{}).validateAssetData(assetBase))
+
+	data.Tier = 5
+	_, err = assetFromImport(data)
+	assert.ErrorIs(t, err, types.ErrInvalidObject)
+}
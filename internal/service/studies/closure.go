@@ -0,0 +1,183 @@
+package studies
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Items of a study which are yet to be wound down
+type StudyClosure struct {
+	Study                types.Study
+	OutstandingAssets    []types.Asset    // Neither destroyed nor transferred to another organisation
+	OutstandingContracts []types.Contract // Not closed
+	OutstandingProjects  []types.Project  // TRE projects not yet deleted
+}
+
+// Whether the study can move from closing to closed. Outstanding projects do not block
+// closure as their deletion is handled by the TRE
+func (c StudyClosure) CanComplete() bool {
+	return c.Study.ApprovalStatus == types.StudyApprovalStatusClosing &&
+		len(c.OutstandingAssets) == 0 &&
+		len(c.OutstandingContracts) == 0
+}
+
+func (s *Service) StudyClosure(studyID uuid.UUID) (*StudyClosure, error) {
+	return studyClosure(s.db, studyID)
+}
+
+func studyClosure(db *gorm.DB, studyID uuid.UUID) (*StudyClosure, error) {
+	closure := StudyClosure{}
+	if err := db.Where("id = ?", studyID).First(&closure.Study).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get study")
+	}
+
+	err := db.Where("study_id = ? AND status NOT IN ?", studyID, []types.AssetStatus{
+		types.AssetStatusDestroyed,
+		types.AssetStatusTransferred,
+	}).Order("created_at ASC").Find(&closure.OutstandingAssets).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get outstanding assets")
+	}
+
+	err = db.Where("study_id = ? AND (status IS NULL OR status != ?)", studyID, types.ContractStatusClosed).
+		Order("created_at ASC").
+		Find(&closure.OutstandingContracts).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get outstanding contracts")
+	}
+
+	err = db.Model(&types.Project{}).
+		Joins("JOIN project_tres ON project_tres.project_id = projects.id AND project_tres.deleted_at IS NULL").
+		Where("projects.study_id = ? AND project_tres.status != ?", studyID, types.ProjectTREStatusDeleted).
+		Order("projects.created_at ASC").
+		Find(&closure.OutstandingProjects).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get outstanding projects")
+	}
+
+	return &closure, nil
+}
+
+// Start closing an approved study. Requests deletion of all its TRE projects and
+// retires all its DSH shares
+func (s *Service) StartStudyClosure(studyID uuid.UUID) error {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	study := types.Study{}
+	if err := tx.Where("id = ?", studyID).First(&study).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to get study")
+	} else if study.ApprovalStatus != types.StudyApprovalStatusApproved {
+		tx.Rollback()
+		return types.NewErrClientInvalidObjectF("only approved studies can be closed. study is [%v]", study.ApprovalStatus)
+	}
+
+	if err := tx.Model(&study).Update("approval_status", types.StudyApprovalStatusClosing).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to update study status")
+	}
+
+	studyProjectIDs := tx.Model(&types.Project{}).Select("id").Where("study_id = ?", studyID)
+
+	// Projects which were never deployed have nothing to delete
+	err := tx.Model(&types.ProjectTRE{}).
+		Where("project_id IN (?) AND status IN ?", studyProjectIDs, []types.ProjectTREStatus{
+			types.ProjectTREStatusIncomplete,
+			types.ProjectTREStatusPendingApproval,
+		}).
+		Update("status", types.ProjectTREStatusDeleted).Error
+	if err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete undeployed TRE projects")
+	}
+
+	// Bump the requested version so the deployer applies the change
+	err = tx.Model(&types.ProjectTRE{}).
+		Where("project_id IN (?) AND status IN ?", studyProjectIDs, []types.ProjectTREStatus{
+			types.ProjectTREStatusPendingCreation,
+			types.ProjectTREStatusDeployed,
+			types.ProjectTREStatusSuspended,
+		}).
+		Updates(types.ProjectTRE{
+			Status:                    types.ProjectTREStatusPendingDeletion,
+			RequestedVersionUpdatedAt: new(time.Now()),
+		}).Error
+	if err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to request deletion of TRE projects")
+	}
+
+	err = tx.Model(&types.ProjectDSH{}).
+		Where("project_id IN (?)", studyProjectIDs).
+		Update("status", types.ProjectDSHStatusRetired).Error
+	if err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to retire DSH shares")
+	}
+
+	return commitTransaction(tx)
+}
+
+// Close a study once every asset has been destroyed or transferred and every contract closed
+func (s *Service) CompleteStudyClosure(studyID uuid.UUID) error {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	closure, err := studyClosure(tx, studyID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if closure.Study.ApprovalStatus != types.StudyApprovalStatusClosing {
+		tx.Rollback()
+		return types.NewErrClientInvalidObjectF("study closure has not been started. study is [%v]", closure.Study.ApprovalStatus)
+	} else if !closure.CanComplete() {
+		tx.Rollback()
+		return types.NewErrClientInvalidObjectF("cannot close study: %v", closure.outstandingSummary())
+	}
+
+	if err := tx.Model(&closure.Study).Update("approval_status", types.StudyApprovalStatusClosed).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to update study status")
+	}
+	return commitTransaction(tx)
+}
+
+func (s *Service) ArchiveStudy(studyID uuid.UUID) error {
+	result := s.db.Model(&types.Study{}).
+		Where("id = ? AND approval_status = ?", studyID, types.StudyApprovalStatusClosed).
+		Update("approval_status", types.StudyApprovalStatusArchived)
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to archive study")
+	} else if result.RowsAffected > 0 {
+		return nil
+	}
+
+	study := types.Study{}
+	if err := s.db.Where("id = ?", studyID).First(&study).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get study")
+	}
+	return types.NewErrClientInvalidObjectF("only closed studies can be archived. study is [%v]", study.ApprovalStatus)
+}
+
+func (c StudyClosure) outstandingSummary() string {
+	items := []string{}
+	for _, asset := range c.OutstandingAssets {
+		items = append(items, fmt.Sprintf("asset [%v] must be destroyed or transferred", asset.Title))
+	}
+	for _, contract := range c.OutstandingContracts {
+		items = append(items, fmt.Sprintf("contract [%v] must be closed", contract.Title))
+	}
+	return strings.Join(items, "; ")
+}
+
+func isStudyClosureStatus(status string) bool {
+	return slices.Contains(types.StudyClosureApprovalStatuses, status)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -48,7 +49,7 @@ func (s *Service) validateContract(ctx context.Context, studyId uuid.UUID, data
 	}
 
 	if !data.Status.Valid() {
-		return types.NewErrClientInvalidObjectF("Status must be active, or closed")
+		return types.NewErrClientInvalidObjectF("Status must be pending, active, or closed")
 	}
 
 	if data.StartDate == nil {
@@ -115,12 +116,21 @@ func (s *Service) CreateContract(
 		contract.SignatoryUser = signatory
 	}
 
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
 	// Store the contract metadata in the database first to generate an ID
-	if err := s.db.Create(&contract).Error; err != nil {
+	if err := tx.Create(&contract).Error; err != nil {
+		tx.Rollback()
 		return nil, types.NewErrFromGorm(err, "failed to create contract metadata")
 	}
 
-	return contract, nil
+	if err := ensureOriginalContractVersion(tx, *contract); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return contract, commitTransaction(tx)
 }
 
 func (s *Service) GetContract(studyID uuid.UUID, contractID uuid.UUID) (*types.Contract, error) {
@@ -129,6 +139,7 @@ func (s *Service) GetContract(studyID uuid.UUID, contractID uuid.UUID) (*types.C
 		Preload("Objects").
 		Preload("Assets").
 		Preload("SignatoryUser").
+		Preload("Successor").
 		Where("id = ? AND study_id = ?", contractID, studyID).
 		First(&contract)
 	return &contract, types.NewErrFromGorm(result.Error, "failed to get contract")
@@ -143,12 +154,27 @@ func (s *Service) CreateContractObject(
 		return nil, err
 	}
 
+	if obj.Meta.ContractVersionID != nil {
+		version, err := contractVersion(s.db, studyID, obj.Meta.ContractID, *obj.Meta.ContractVersionID)
+		if err != nil {
+			return nil, err
+		} else if version.Status != types.ContractVersionStatusPending {
+			return nil, types.NewErrClientInvalidObjectF("objects can only be added to pending amendments")
+		}
+	}
+
 	if !validation.IsValidContractFilename(obj.Meta.Filename) {
 		return nil, types.NewErrInvalidObject("filename was invalid")
 	}
 
 	log.Debug().Str("contractID", obj.Meta.ContractID.String()).Msg("Storing contract")
 
+	// Objects are quarantined until scanned clean, if scanning is enabled
+	obj.Meta.ScanStatus = types.MalwareScanStatusSkipped
+	if s.scanner != nil {
+		obj.Meta.ScanStatus = types.MalwareScanStatusPending
+	}
+
 	// Start a database transaction
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
@@ -160,34 +186,58 @@ func (s *Service) CreateContractObject(
 	}
 
 	// Store the PDF file in S3 using the generated primary key ID from the database
-	metadata := s3.ObjectMetadata{
-		Id:   obj.Meta.ID,
-		Kind: s3.ContractKind,
-	}
-	if err := s.s3.StoreObject(ctx, metadata, obj.Object); err != nil {
+	if err := s.s3.StoreObject(ctx, contractObjectS3Metadata(obj.Meta), obj.Object); err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
-	return &obj.Meta, commitTransaction(tx)
+	if err := s.commitTransactionWithObject(tx, contractObjectS3Metadata(obj.Meta)); err != nil {
+		return nil, err
+	}
+
+	if obj.Meta.IsQuarantined() {
+		if err := s.scanContractObject(ctx, &obj.Meta); err != nil {
+			log.Err(err).Any("contractObjectID", obj.Meta.ID).Msg("Failed to scan contract object. It remains quarantined")
+		}
+	}
+	return &obj.Meta, nil
 }
 
+// Get the content of a contract object for a user to download. PDFs are
+// watermarked for the user and every download is recorded
 func (s *Service) GetContractObject(ctx context.Context,
+	user types.User,
 	studyID uuid.UUID,
 	contractID uuid.UUID,
 	contractObjectID uuid.UUID,
 ) (types.S3Object, error) {
 	log.Debug().Any("contractID", contractID).Msg("Getting contract")
 
-	if err := s.checkContractObjectExists(studyID, contractID, contractObjectID); err != nil {
+	object, err := s.contractObject(studyID, contractID, contractObjectID)
+	if err != nil {
 		return types.S3Object{}, err
 	}
 
-	metadata := s3.ObjectMetadata{
-		Id:   contractObjectID,
-		Kind: s3.ContractKind,
+	switch object.ScanStatus {
+	case types.MalwareScanStatusPending:
+		return types.S3Object{}, types.NewErrClientInvalidObjectF("contract object has not yet been scanned for malware")
+	case types.MalwareScanStatusInfected:
+		return types.S3Object{}, types.NewErrClientInvalidObjectF("contract object contains malware and cannot be downloaded")
 	}
-	return s.s3.GetObject(ctx, metadata)
+	content, err := s.s3.GetObject(ctx, contractObjectS3Metadata(*object))
+	if err != nil {
+		return types.S3Object{}, err
+	}
+	if object.IsPDF() {
+		if content, err = s.watermarkContractObject(user, studyID, content); err != nil {
+			return types.S3Object{}, err
+		}
+	}
+	if err := s.recordContractObjectDownload(user, *object, object.IsPDF()); err != nil {
+		closeS3Object(content)
+		return types.S3Object{}, err
+	}
+	return content, nil
 }
 
 func (s *Service) DeleteContractObject(ctx context.Context,
@@ -195,7 +245,8 @@ func (s *Service) DeleteContractObject(ctx context.Context,
 	contractID uuid.UUID,
 	contractObjectID uuid.UUID,
 ) error {
-	if err := s.checkContractObjectExists(studyID, contractID, contractObjectID); err != nil {
+	object, err := s.contractObject(studyID, contractID, contractObjectID)
+	if err != nil {
 		return err
 	}
 
@@ -209,11 +260,7 @@ func (s *Service) DeleteContractObject(ctx context.Context,
 		return types.NewErrFromGorm(err, "Failed to delete contract object metadata")
 	}
 
-	metadata := s3.ObjectMetadata{
-		Id:   contractObjectID,
-		Kind: s3.ContractKind,
-	}
-	if err := s.s3.DeleteObject(metadata); err != nil {
+	if err := s.s3.DeleteObject(contractObjectS3Metadata(*object)); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -242,16 +289,17 @@ func (s *Service) DeleteContract(studyID uuid.UUID, contractID uuid.UUID) error
 	}
 
 	for _, obj := range contract.Objects {
-		metadata := s3.ObjectMetadata{
-			Id:   obj.ID,
-			Kind: s3.ContractKind,
-		}
-		if err := s.s3.DeleteObject(metadata); err != nil {
+		if err := s.s3.DeleteObject(contractObjectS3Metadata(obj)); err != nil {
 			tx.Rollback()
 			return err
 		}
 	}
 
+	if err := tx.Where("contract_id = ?", contractID).Delete(&types.ContractVersion{}).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete contract versions")
+	}
+
 	if err := tx.Where("id = ? AND study_id = ?", contractID, studyID).Delete(&types.Contract{}).Error; err != nil {
 		tx.Rollback()
 		return types.NewErrFromGorm(err, "failed to delete contract")
@@ -268,7 +316,8 @@ func (s *Service) UpdateContract(
 ) (*types.Contract, error) {
 	log.Debug().Any("contractId", contractID).Msg("Updating contract")
 
-	if err := s.checkContractExists(studyID, contractID); err != nil {
+	current, err := s.GetContract(studyID, contractID)
+	if err != nil {
 		return nil, err
 	}
 
@@ -292,6 +341,15 @@ func (s *Service) UpdateContract(
 		contract.SignatoryUser = signatory
 	}
 
+	// Terms in force may only be changed by an approved amendment, so the
+	// original terms are kept. Contracts not yet in force may be corrected
+	if current.Status != types.ContractStatusPending {
+		changed := changedContractFields(contractVersionTerms(*current), contractVersionTerms(*contract))
+		if len(changed) > 0 {
+			return nil, types.NewErrClientInvalidObjectF("Changes to [%s] must be requested as an amendment", strings.Join(changed, ", "))
+		}
+	}
+
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
 
@@ -310,11 +368,27 @@ func (s *Service) UpdateContract(
 		return nil, types.NewErrFromGorm(err, "failed to update contract assets")
 	}
 
-	if err := tx.Preload("Assets").Preload("Objects").Preload("SignatoryUser").First(contract, contractID).Error; err != nil {
+	if err := closeRenewedContract(tx, contractID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Preload("Assets").Preload("Objects").Preload("SignatoryUser").Preload("Successor").First(contract, contractID).Error; err != nil {
 		tx.Rollback()
 		return nil, types.NewErrFromGorm(result.Error, "failed to get updated contract")
 	}
 
+	// Corrections to the current terms are kept in their version
+	terms := contractVersionTerms(*contract)
+	result = tx.Model(&types.ContractVersion{}).
+		Where("contract_id = ? AND version = ?", contractID, contract.Version).
+		Select("Title", "SignatoryUserID", "ThirdPartyName", "OtherSignatories", "ContractStatus", "StartDate", "ExpiryDate", "AssetIDs").
+		Updates(terms)
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(result.Error, "failed to update current contract version")
+	}
+
 	return contract, commitTransaction(tx)
 }
 
@@ -332,38 +406,47 @@ func (s *Service) checkContractExists(studyID uuid.UUID, contractID uuid.UUID) e
 	return nil
 }
 
-func (s *Service) checkContractObjectExists(studyID uuid.UUID, contractID uuid.UUID, contractObjectID uuid.UUID) error {
-	exists := false
-	err := s.db.Model(&types.ContractObjectMetadata{}).
+func (s *Service) contractObject(studyID uuid.UUID, contractID uuid.UUID, contractObjectID uuid.UUID) (*types.ContractObjectMetadata, error) {
+	object := types.ContractObjectMetadata{}
+	result := s.db.Model(&types.ContractObjectMetadata{}).
+		Select("contract_object_metadata.*").
 		Joins("INNER JOIN contracts on contracts.id = contract_object_metadata.contract_id").
-		Select("count(*) > 0").
 		Where("study_id = ? AND contract_id = ? AND contract_object_metadata.id = ?", studyID, contractID, contractObjectID).
-		Find(&exists).Error
-	if err != nil {
-		return types.NewErrFromGorm(err, "failed check if contract exists")
-	} else if !exists {
-		return types.NewNotFoundError(fmt.Errorf("contract object did not exist"))
+		Limit(1).
+		Find(&object)
+	if result.Error != nil {
+		return nil, types.NewErrFromGorm(result.Error, "failed check if contract exists")
+	} else if result.RowsAffected == 0 {
+		return nil, types.NewNotFoundError(fmt.Errorf("contract object did not exist"))
 	}
-	return nil
+	return &object, nil
 }
 
 // retrieves all contracts within a study
 func (s *Service) StudyContracts(studyID uuid.UUID) ([]types.Contract, error) {
 	contracts := []types.Contract{}
-	err := s.db.Preload("Assets").Preload("Objects").Preload("SignatoryUser").Where("study_id = ?", studyID).
+	err := s.db.Preload("Assets").Preload("Objects").Preload("SignatoryUser").Preload("Successor").Where("study_id = ?", studyID).
 		Order("created_at DESC").
 		Find(&contracts).Error
 	return contracts, types.NewErrFromGorm(err, "failed to get asset contracts")
 }
 
 func (s *Service) persistedContractSignatory(ctx context.Context, signatory string) (types.User, error) {
-	signatoryUsernames, err := s.entra.FindUsernames(ctx, signatory)
+	signatoryUsername, err := s.contractSignatoryUsername(ctx, signatory)
 	if err != nil {
 		return types.User{}, err
+	}
+	return s.users.PersistedUser(signatoryUsername)
+}
+
+func (s *Service) contractSignatoryUsername(ctx context.Context, signatory string) (types.Username, error) {
+	signatoryUsernames, err := s.entra.FindUsernames(ctx, signatory)
+	if err != nil {
+		return "", err
 	} else if len(signatoryUsernames) != 1 {
-		return types.User{}, types.NewErrInvalidObject("failed to find single user signatory in entra")
+		return "", types.NewErrInvalidObject("failed to find single user signatory in entra")
 	}
-	return s.users.PersistedUser(signatoryUsernames[0])
+	return signatoryUsernames[0], nil
 }
 
 func contractFromBase(contractBase openapi.ContractBase) (*types.Contract, error) {
@@ -410,3 +493,16 @@ func commitTransaction(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// Commit a transaction which stored a new object as its last step. If the
+// commit fails the object, along with its digest record, is deleted so neither
+// outlives the rows which would have referenced it
+func (s *Service) commitTransactionWithObject(tx *gorm.DB, metadata s3.ObjectMetadata) error {
+	if err := commitTransaction(tx); err != nil {
+		if err := s.s3.DeleteObject(metadata); err != nil {
+			log.Err(err).Str("key", metadata.Key()).Msg("Failed to delete object of uncommitted transaction")
+		}
+		return err
+	}
+	return nil
+}
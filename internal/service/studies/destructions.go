@@ -0,0 +1,229 @@
+package studies
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"github.com/ucl-arc-tre/portal/internal/validation"
+	"gorm.io/gorm"
+)
+
+// Destruction requests of an asset, most recent first
+func (s *Service) AssetDestructions(studyID uuid.UUID, assetID uuid.UUID) ([]types.AssetDestruction, error) {
+	if err := s.checkAssetExists(studyID, assetID); err != nil {
+		return nil, err
+	}
+	destructions := []types.AssetDestruction{}
+	err := preloadAssetDestruction(s.db).
+		Where("asset_id = ?", assetID).
+		Order("created_at DESC").
+		Find(&destructions).Error
+	return destructions, types.NewErrFromGorm(err, "failed to get asset destructions")
+}
+
+// Destruction requests awaiting approval across all studies, oldest first
+func (s *Service) PendingAssetDestructions() ([]types.AssetDestruction, error) {
+	destructions := []types.AssetDestruction{}
+	err := preloadAssetDestruction(s.db).
+		Where("status = ?", types.AssetDestructionStatusPending).
+		Order("created_at").
+		Find(&destructions).Error
+	return destructions, types.NewErrFromGorm(err, "failed to get pending asset destructions")
+}
+
+// Request that an asset is marked as destroyed, storing the certificate of
+// destruction. The asset is unchanged until the request is approved
+func (s *Service) RequestAssetDestruction(
+	ctx context.Context,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	requester types.User,
+	method openapi.AssetDestructionMethod,
+	destroyedAt string,
+	upload StudyDocumentUpload,
+) (*types.AssetDestruction, error) {
+	if !method.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("method must be one of: secure_deletion, cryptographic_erasure, physical_destruction, other")
+	} else if !validation.IsValidContractFilename(upload.Filename) {
+		return nil, types.NewErrInvalidObject("filename was invalid")
+	}
+	destroyedDate, err := time.Parse(config.DateFormat, destroyedAt)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("destruction date must be in %s format", config.DateFormat)
+	} else if destroyedDate.After(time.Now()) {
+		return nil, types.NewErrClientInvalidObjectF("destruction date cannot be in the future")
+	}
+
+	asset, err := s.AssetById(studyID, assetID)
+	if err != nil {
+		return nil, err
+	} else if asset.IsDestroyed() {
+		return nil, types.NewErrClientInvalidObjectF("asset has already been destroyed")
+	}
+
+	var numPending int64
+	err = s.db.Model(&types.AssetDestruction{}).
+		Where("asset_id = ? AND status = ?", assetID, types.AssetDestructionStatusPending).
+		Count(&numPending).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to check for pending destructions")
+	} else if numPending > 0 {
+		return nil, types.NewErrClientInvalidObjectF("a destruction of this asset is already pending")
+	}
+
+	log.Debug().Any("studyID", studyID).Any("assetID", assetID).Msg("Requesting asset destruction")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	destruction := types.AssetDestruction{
+		AssetID:             assetID,
+		RequesterUserID:     requester.ID,
+		Method:              types.AssetDestructionMethod(method),
+		DestroyedAt:         destroyedDate,
+		CertificateFilename: upload.Filename,
+		Status:              types.AssetDestructionStatusPending,
+	}
+	if err := tx.Create(&destruction).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create asset destruction")
+	}
+
+	if err := s.s3.StoreObject(ctx, destructionCertificateMetadata(destruction.ID), upload.Object); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.commitTransactionWithObject(tx, destructionCertificateMetadata(destruction.ID)); err != nil {
+		return nil, err
+	}
+	return assetDestruction(s.db, studyID, assetID, destruction.ID)
+}
+
+func (s *Service) GetDestructionCertificate(
+	ctx context.Context,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	destructionID uuid.UUID,
+) (types.S3Object, error) {
+	destruction, err := assetDestruction(s.db, studyID, assetID, destructionID)
+	if err != nil {
+		return types.S3Object{}, err
+	}
+	return s.s3.GetObject(ctx, destructionCertificateMetadata(destruction.ID))
+}
+
+// Approve a pending destruction request. The asset is marked as destroyed and
+// removed from all projects, after which it cannot be modified
+func (s *Service) ApproveAssetDestruction(
+	reviewer types.User,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	destructionID uuid.UUID,
+	data openapi.AssetDestructionReview,
+) (*types.AssetDestruction, error) {
+	log.Debug().Any("destructionID", destructionID).Any("reviewer", reviewer.Username).Msg("Approving asset destruction")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := reviewAssetDestruction(tx, reviewer, studyID, assetID, destructionID, types.AssetDestructionStatusApproved, data); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(&types.Asset{}).Where("id = ?", assetID).Update("status", types.AssetStatusDestroyed).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to update asset status")
+	}
+
+	if _, err := detachAssetFromProjects(tx, assetID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := updateStudyRisk(tx, studyID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return assetDestruction(s.db, studyID, assetID, destructionID)
+}
+
+// Reject a pending destruction request. The asset is unchanged
+func (s *Service) RejectAssetDestruction(
+	reviewer types.User,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	destructionID uuid.UUID,
+	data openapi.AssetDestructionReview,
+) (*types.AssetDestruction, error) {
+	log.Debug().Any("destructionID", destructionID).Any("reviewer", reviewer.Username).Msg("Rejecting asset destruction")
+
+	err := reviewAssetDestruction(s.db, reviewer, studyID, assetID, destructionID, types.AssetDestructionStatusRejected, data)
+	if err != nil {
+		return nil, err
+	}
+	return assetDestruction(s.db, studyID, assetID, destructionID)
+}
+
+func reviewAssetDestruction(
+	tx *gorm.DB,
+	reviewer types.User,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	destructionID uuid.UUID,
+	status types.AssetDestructionStatus,
+	data openapi.AssetDestructionReview,
+) error {
+	destruction, err := assetDestruction(tx, studyID, assetID, destructionID)
+	if err != nil {
+		return err
+	} else if destruction.Status != types.AssetDestructionStatusPending {
+		return types.NewErrClientInvalidObjectF("destruction is %s, not pending", destruction.Status)
+	} else if destruction.RequesterUserID == reviewer.ID {
+		return types.NewErrClientInvalidObjectF("a destruction must be reviewed by a different user to the requester")
+	}
+
+	err = tx.Model(destruction).Updates(types.AssetDestruction{
+		Status:           status,
+		ReviewerUserID:   &reviewer.ID,
+		ReviewerComments: data.Comments,
+		ReviewedAt:       new(time.Now()),
+	}).Error
+	return types.NewErrFromGorm(err, "failed to review asset destruction")
+}
+
+// Destruction request of an asset within a study
+func assetDestruction(db *gorm.DB, studyID uuid.UUID, assetID uuid.UUID, destructionID uuid.UUID) (*types.AssetDestruction, error) {
+	destruction := types.AssetDestruction{}
+	err := preloadAssetDestruction(db).
+		Where("id = ? AND asset_id = ?", destructionID, assetID).
+		Where("asset_id IN (?)", db.Model(&types.Asset{}).Select("id").Where("study_id = ?", studyID)).
+		First(&destruction).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset destruction")
+	}
+	return &destruction, nil
+}
+
+func preloadAssetDestruction(db *gorm.DB) *gorm.DB {
+	return db.Preload("Asset").Preload("RequesterUser").Preload("ReviewerUser")
+}
+
+func destructionCertificateMetadata(destructionID uuid.UUID) s3.ObjectMetadata {
+	return s3.ObjectMetadata{
+		Id:   destructionID,
+		Kind: s3.DestructionCertificateKind,
+	}
+}
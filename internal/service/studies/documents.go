@@ -0,0 +1,234 @@
+package studies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"github.com/ucl-arc-tre/portal/internal/validation"
+	"gorm.io/gorm"
+)
+
+// Is the user the owner or an administrator of the study
+func (s *Service) IsStudyOwnerOrAdmin(user types.User, studyID uuid.UUID) (bool, error) {
+	isMember := false
+	err := s.db.Model(&types.Study{}).
+		Select("count(*) > 0").
+		Where("id = ? AND (owner_user_id = ? OR id IN (?))", studyID, user.ID,
+			s.db.Model(&types.StudyAdmin{}).Select("study_id").Where("user_id = ?", user.ID),
+		).
+		Find(&isMember).Error
+	return isMember, types.NewErrFromGorm(err, "failed to check study membership")
+}
+
+// Evidence documents of a study, most recently created first
+func (s *Service) StudyDocuments(studyID uuid.UUID) ([]types.StudyDocument, error) {
+	documents := []types.StudyDocument{}
+	err := preloadStudyDocumentVersions(s.db).
+		Where("study_id = ?", studyID).
+		Order("created_at DESC").
+		Find(&documents).Error
+	return documents, types.NewErrFromGorm(err, "failed to get study documents")
+}
+
+func (s *Service) GetStudyDocument(studyID uuid.UUID, documentID uuid.UUID) (*types.StudyDocument, error) {
+	return studyDocument(s.db, studyID, documentID)
+}
+
+// Create a document with the upload as its first version
+func (s *Service) CreateStudyDocument(
+	ctx context.Context,
+	studyID uuid.UUID,
+	creator types.User,
+	category openapi.StudyDocumentCategory,
+	title string,
+	upload StudyDocumentUpload,
+) (*types.StudyDocument, error) {
+	if !category.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("Invalid document category [%v]", category)
+	} else if !validation.StudyDocumentTitlePattern.MatchString(title) {
+		return nil, types.NewErrClientInvalidObjectF("Title must be between 2 and 100 characters")
+	} else if !validation.IsValidContractFilename(upload.Filename) {
+		return nil, types.NewErrInvalidObject("filename was invalid")
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	document := types.StudyDocument{
+		StudyID:       studyID,
+		CreatorUserID: creator.ID,
+		Category:      types.StudyDocumentCategory(category),
+		Title:         title,
+	}
+	if err := tx.Create(&document).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create study document")
+	}
+
+	version, err := s.storeStudyDocumentVersion(ctx, tx, document, creator, upload)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.commitTransactionWithObject(tx, studyDocumentVersionMetadata(version.ID)); err != nil {
+		return nil, err
+	}
+	return s.GetStudyDocument(studyID, document.ID)
+}
+
+// Upload a new version of an existing document
+func (s *Service) AddStudyDocumentVersion(
+	ctx context.Context,
+	studyID uuid.UUID,
+	documentID uuid.UUID,
+	creator types.User,
+	upload StudyDocumentUpload,
+) (*types.StudyDocument, error) {
+	if !validation.IsValidContractFilename(upload.Filename) {
+		return nil, types.NewErrInvalidObject("filename was invalid")
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	document, err := studyDocument(tx, studyID, documentID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Touch the document so it reflects the latest upload
+	if err := tx.Model(document).Update("updated_at", gorm.Expr("NOW()")).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to update study document")
+	}
+
+	version, err := s.storeStudyDocumentVersion(ctx, tx, *document, creator, upload)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.commitTransactionWithObject(tx, studyDocumentVersionMetadata(version.ID)); err != nil {
+		return nil, err
+	}
+	return s.GetStudyDocument(studyID, documentID)
+}
+
+func (s *Service) GetStudyDocumentVersion(
+	ctx context.Context,
+	studyID uuid.UUID,
+	documentID uuid.UUID,
+	versionID uuid.UUID,
+) (types.S3Object, error) {
+	exists := false
+	err := s.db.Model(&types.StudyDocumentVersion{}).
+		Joins("INNER JOIN study_documents ON study_documents.id = study_document_versions.document_id").
+		Select("count(*) > 0").
+		Where("study_documents.study_id = ? AND study_documents.deleted_at IS NULL", studyID).
+		Where("study_document_versions.document_id = ? AND study_document_versions.id = ?", documentID, versionID).
+		Find(&exists).Error
+	if err != nil {
+		return types.S3Object{}, types.NewErrFromGorm(err, "failed to check if document version exists")
+	} else if !exists {
+		return types.S3Object{}, types.NewNotFoundError(fmt.Errorf("document version did not exist for study [%v]", studyID))
+	}
+
+	return s.s3.GetObject(ctx, studyDocumentVersionMetadata(versionID))
+}
+
+// Delete a document including the stored content of all its versions
+func (s *Service) DeleteStudyDocument(studyID uuid.UUID, documentID uuid.UUID) error {
+	log.Debug().Any("documentID", documentID).Msg("Deleting study document")
+
+	document, err := s.GetStudyDocument(studyID, documentID)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Where("document_id = ?", documentID).Delete(&types.StudyDocumentVersion{}).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete study document versions")
+	}
+
+	if err := tx.Delete(document).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to delete study document")
+	}
+
+	for _, version := range document.Versions {
+		if err := s.s3.DeleteObject(studyDocumentVersionMetadata(version.ID)); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return commitTransaction(tx)
+}
+
+// Create the next version of a document and store its content
+func (s *Service) storeStudyDocumentVersion(
+	ctx context.Context,
+	tx *gorm.DB,
+	document types.StudyDocument,
+	creator types.User,
+	upload StudyDocumentUpload,
+) (*types.StudyDocumentVersion, error) {
+	latestVersion := 0
+	err := tx.Model(&types.StudyDocumentVersion{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("document_id = ?", document.ID).
+		Scan(&latestVersion).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get latest study document version")
+	}
+
+	version := types.StudyDocumentVersion{
+		DocumentID:    document.ID,
+		Version:       latestVersion + 1,
+		Filename:      upload.Filename,
+		CreatorUserID: creator.ID,
+	}
+	if err := tx.Create(&version).Error; err != nil { // unique index rejects concurrent uploads of the same version
+		return nil, types.NewErrFromGorm(err, "failed to create study document version")
+	}
+
+	log.Debug().Str("documentID", document.ID.String()).Int("version", version.Version).Msg("Storing study document")
+	if err := s.s3.StoreObject(ctx, studyDocumentVersionMetadata(version.ID), upload.Object); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func studyDocument(db *gorm.DB, studyID uuid.UUID, documentID uuid.UUID) (*types.StudyDocument, error) {
+	document := types.StudyDocument{}
+	err := preloadStudyDocumentVersions(db).
+		Where("id = ? AND study_id = ?", documentID, studyID).
+		First(&document).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get study document")
+	}
+	return &document, nil
+}
+
+func preloadStudyDocumentVersions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Versions", func(db *gorm.DB) *gorm.DB { return db.Order("version DESC") }).
+		Preload("Versions.CreatorUser")
+}
+
+func studyDocumentVersionMetadata(versionID uuid.UUID) s3.ObjectMetadata {
+	return s3.ObjectMetadata{
+		Id:   versionID,
+		Kind: s3.StudyDocumentKind,
+	}
+}
@@ -0,0 +1,66 @@
+package studies
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/pdf"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Downloads of the objects of a contract, most recent first
+func (s *Service) ContractObjectDownloads(studyID uuid.UUID, contractID uuid.UUID) ([]types.ContractObjectDownload, error) {
+	if _, err := s.GetContract(studyID, contractID); err != nil {
+		return nil, err
+	}
+	downloads := []types.ContractObjectDownload{}
+	err := s.db.Preload("User").
+		Preload("ContractObject", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }). // including deleted objects
+		Where("contract_id = ?", contractID).
+		Order("created_at DESC").
+		Find(&downloads).Error
+	return downloads, types.NewErrFromGorm(err, "failed to get contract object downloads")
+}
+
+// Stamp each page of a PDF contract object with the user downloading it, the
+// time and the caseref of the study
+func (s *Service) watermarkContractObject(user types.User, studyID uuid.UUID, object types.S3Object) (types.S3Object, error) {
+	defer closeS3Object(object)
+	content, err := io.ReadAll(object.Content)
+	if err != nil {
+		return types.S3Object{}, types.NewErrServerError(err)
+	}
+	study := types.Study{}
+	if err := s.db.Select("caseref").Where("id = ?", studyID).First(&study).Error; err != nil {
+		return types.S3Object{}, types.NewErrFromGorm(err, "failed to get study caseref")
+	}
+	text := fmt.Sprintf("Downloaded by %s at %s - Caseref %d",
+		user.Username,
+		time.Now().UTC().Format(config.TimeFormat),
+		study.Caseref,
+	)
+	watermarked, err := pdf.Watermark(content, text)
+	if err != nil {
+		return types.S3Object{}, err
+	}
+	return types.S3Object{
+		Content:  io.NopCloser(bytes.NewReader(watermarked)),
+		NumBytes: new(int64(len(watermarked))),
+	}, nil
+}
+
+func (s *Service) recordContractObjectDownload(user types.User, object types.ContractObjectMetadata, watermarked bool) error {
+	download := types.ContractObjectDownload{
+		ContractID:       object.ContractID,
+		ContractObjectID: object.ID,
+		UserID:           user.ID,
+		Watermarked:      watermarked,
+	}
+	err := s.db.Create(&download).Error
+	return types.NewErrFromGorm(err, "failed to record contract object download")
+}
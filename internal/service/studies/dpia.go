@@ -0,0 +1,224 @@
+package studies
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"github.com/ucl-arc-tre/portal/internal/validation"
+	"gorm.io/gorm"
+)
+
+type DpiaQuestion struct {
+	Key    string
+	Prompt string
+}
+
+// Questions of a DPIA, in the order they are presented. Based on the ICO DPIA template
+var DpiaQuestions = []DpiaQuestion{
+	{Key: "processing_description", Prompt: "Describe the nature, scope, context and purposes of the processing"},
+	{Key: "necessity_proportionality", Prompt: "Why is the processing necessary and proportionate to the purposes of the study?"},
+	{Key: "data_subjects", Prompt: "Who are the data subjects and how will they be informed about the processing?"},
+	{Key: "consultation", Prompt: "Who has been consulted, e.g. data subjects, information security or processors?"},
+	{Key: "international_transfers", Prompt: "Will data be transferred outside the UK/EEA and, if so, under what safeguards?"},
+	{Key: "retention", Prompt: "How long will the data be retained and how will it be destroyed?"},
+}
+
+const dpiaVoluntaryTriggerReason = "started by the study team"
+
+func (s *Service) StudyDpia(studyID uuid.UUID) (*types.Dpia, error) {
+	return studyDpia(s.db, studyID)
+}
+
+func studyDpia(db *gorm.DB, studyID uuid.UUID) (*types.Dpia, error) {
+	dpia := types.Dpia{}
+	err := db.Preload("Answers").
+		Preload("Risks", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("SignedOffByUser").
+		Where("study_id = ?", studyID).
+		First(&dpia).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get DPIA")
+	}
+	return &dpia, nil
+}
+
+// Create or replace the answers and risks of the DPIA of a study. As the DPO signed off
+// the previous content any update returns the DPIA to draft
+func (s *Service) UpdateDpia(studyID uuid.UUID, data openapi.DpiaUpdate) (*types.Dpia, error) {
+	if err := validateDpiaUpdate(data); err != nil {
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	study := types.Study{}
+	if err := tx.Where("id = ?", studyID).First(&study).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to get study")
+	} else if study.IsClosed() {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("cannot update the DPIA of a closed study")
+	}
+
+	dpia := types.Dpia{}
+	err := tx.Where("study_id = ?", studyID).
+		Attrs(types.Dpia{TriggerReason: dpiaVoluntaryTriggerReason}).
+		FirstOrCreate(&dpia).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to get or create DPIA")
+	}
+
+	err = tx.Model(&dpia).Select("status", "signed_off_by_user_id", "signed_off_at", "review_date").Updates(types.Dpia{
+		Status: types.DpiaStatusDraft,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to update DPIA status")
+	}
+
+	if err := tx.Where("dpia_id = ?", dpia.ID).Delete(&types.DpiaAnswer{}).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to delete DPIA answers")
+	}
+	for _, answer := range data.Answers {
+		dpiaAnswer := types.DpiaAnswer{DpiaID: dpia.ID, Question: answer.Question, Answer: answer.Answer}
+		if err := tx.Create(&dpiaAnswer).Error; err != nil {
+			tx.Rollback()
+			return nil, types.NewErrFromGorm(err, "failed to create DPIA answer")
+		}
+	}
+
+	if err := tx.Where("dpia_id = ?", dpia.ID).Delete(&types.DpiaRisk{}).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to delete DPIA risks")
+	}
+	for _, risk := range data.Risks {
+		dpiaRisk := types.DpiaRisk{
+			DpiaID:       dpia.ID,
+			Description:  risk.Description,
+			Likelihood:   string(risk.Likelihood),
+			Severity:     string(risk.Severity),
+			Mitigation:   risk.Mitigation,
+			ResidualRisk: string(risk.ResidualRisk),
+		}
+		if err := tx.Create(&dpiaRisk).Error; err != nil {
+			tx.Rollback()
+			return nil, types.NewErrFromGorm(err, "failed to create DPIA risk")
+		}
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return s.StudyDpia(studyID)
+}
+
+// Submit a complete draft DPIA for sign-off by the DPO
+func (s *Service) SubmitDpia(studyID uuid.UUID) error {
+	dpia, err := s.StudyDpia(studyID)
+	if err != nil {
+		return err
+	} else if dpia.Status != types.DpiaStatusDraft {
+		return types.NewErrClientInvalidObjectF("only draft DPIAs can be submitted. DPIA is [%v]", dpia.Status)
+	} else if err := validateDpiaComplete(*dpia); err != nil {
+		return err
+	}
+
+	err = s.db.Model(dpia).Update("status", types.DpiaStatusSubmitted).Error
+	return types.NewErrFromGorm(err, "failed to submit DPIA")
+}
+
+// Sign off a submitted DPIA as the DPO, until it must next be reviewed
+func (s *Service) SignOffDpia(dpo types.User, studyID uuid.UUID, data openapi.DpiaSignoff) error {
+	reviewDate, err := time.Parse(config.DateFormat, data.ReviewDate)
+	if err != nil {
+		return types.NewErrClientInvalidObjectF("review date must be in %s format", config.DateFormat)
+	} else if !reviewDate.After(time.Now()) {
+		return types.NewErrClientInvalidObjectF("review date must be in the future")
+	}
+
+	dpia, err := s.StudyDpia(studyID)
+	if err != nil {
+		return err
+	} else if dpia.Status != types.DpiaStatusSubmitted {
+		return types.NewErrClientInvalidObjectF("only submitted DPIAs can be signed off. DPIA is [%v]", dpia.Status)
+	}
+
+	log.Info().Any("studyID", studyID).Any("dpo", dpo.Username).Msg("Signing off DPIA")
+	err = s.db.Model(dpia).Updates(types.Dpia{
+		Status:            types.DpiaStatusSignedOff,
+		SignedOffByUserID: &dpo.ID,
+		SignedOffAt:       new(time.Now()),
+		ReviewDate:        &reviewDate,
+	}).Error
+	return types.NewErrFromGorm(err, "failed to sign off DPIA")
+}
+
+// Create the DPIA of the study of an asset if the asset requires one and it does not already exist
+func ensureStudyDpia(tx *gorm.DB, asset types.Asset) error {
+	reason := dpiaTriggerReason(asset)
+	if reason == "" {
+		return nil
+	}
+	dpia := types.Dpia{}
+	err := tx.Where("study_id = ?", asset.StudyID).
+		Attrs(types.Dpia{TriggerReason: reason}).
+		FirstOrCreate(&dpia).Error
+	return types.NewErrFromGorm(err, fmt.Sprintf("failed to create DPIA for study [%v]", asset.StudyID))
+}
+
+func dpiaTriggerReason(asset types.Asset) string {
+	if asset.LegalBasisSpecial != nil {
+		return fmt.Sprintf("asset [%v] contains special category personal data", asset.Title)
+	} else if asset.StoredOutsideUkEea {
+		return fmt.Sprintf("asset [%v] is stored outside the UK/EEA", asset.Title)
+	}
+	return ""
+}
+
+func validateDpiaUpdate(data openapi.DpiaUpdate) error {
+	questions := []string{}
+	for _, answer := range data.Answers {
+		if !slices.ContainsFunc(DpiaQuestions, func(q DpiaQuestion) bool { return q.Key == answer.Question }) {
+			return types.NewErrClientInvalidObjectF("unknown DPIA question [%v]", answer.Question)
+		} else if slices.Contains(questions, answer.Question) {
+			return types.NewErrClientInvalidObjectF("DPIA question [%v] answered more than once", answer.Question)
+		} else if answer.Answer != "" && !validation.DpiaTextPattern.MatchString(answer.Answer) {
+			return types.NewErrClientInvalidObjectF("DPIA answers must be at most 1000 characters")
+		}
+		questions = append(questions, answer.Question)
+	}
+
+	for _, risk := range data.Risks {
+		if !validation.DpiaTextPattern.MatchString(risk.Description) || !validation.DpiaTextPattern.MatchString(risk.Mitigation) {
+			return types.NewErrClientInvalidObjectF("DPIA risk description and mitigation must be 1-1000 characters")
+		}
+		for _, level := range []openapi.DpiaRiskLevel{risk.Likelihood, risk.Severity, risk.ResidualRisk} {
+			if !level.Valid() {
+				return types.NewErrClientInvalidObjectF("invalid DPIA risk level [%v]", level)
+			}
+		}
+	}
+	return nil
+}
+
+func validateDpiaComplete(dpia types.Dpia) error {
+	for _, question := range DpiaQuestions {
+		if !slices.ContainsFunc(dpia.Answers, func(a types.DpiaAnswer) bool { return a.Question == question.Key && a.Answer != "" }) {
+			return types.NewErrClientInvalidObjectF("DPIA question [%v] must be answered", question.Key)
+		}
+	}
+	if len(dpia.Risks) == 0 {
+		return types.NewErrClientInvalidObjectF("DPIA must identify at least one risk")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package studies
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func validDpiaRisk() openapi.DpiaRisk {
+	return openapi.DpiaRisk{
+		Description:  "Re-identification of participants",
+		Likelihood:   openapi.Medium,
+		Severity:     openapi.High,
+		Mitigation:   "Data is pseudonymised before analysis",
+		ResidualRisk: openapi.Low,
+	}
+}
+
+func TestValidateDpiaUpdate(t *testing.T) {
+	valid := openapi.DpiaUpdate{
+		Answers: []openapi.DpiaAnswerUpdate{{Question: "retention", Answer: "5 years"}},
+		Risks:   []openapi.DpiaRisk{validDpiaRisk()},
+	}
+	assert.NoError(t, validateDpiaUpdate(valid))
+
+	unknownQuestion := valid
+	unknownQuestion.Answers = []openapi.DpiaAnswerUpdate{{Question: "unknown", Answer: "a"}}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaUpdate(unknownQuestion))
+
+	duplicateQuestion := valid
+	duplicateQuestion.Answers = []openapi.DpiaAnswerUpdate{{Question: "retention", Answer: "a"}, {Question: "retention", Answer: "b"}}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaUpdate(duplicateQuestion))
+
+	longAnswer := valid
+	longAnswer.Answers = []openapi.DpiaAnswerUpdate{{Question: "retention", Answer: strings.Repeat("a", 1001)}}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaUpdate(longAnswer))
+
+	invalidLevel := valid
+	invalidLevel.Risks = []openapi.DpiaRisk{validDpiaRisk()}
+	invalidLevel.Risks[0].ResidualRisk = "extreme"
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaUpdate(invalidLevel))
+
+	emptyMitigation := valid
+	emptyMitigation.Risks = []openapi.DpiaRisk{validDpiaRisk()}
+	emptyMitigation.Risks[0].Mitigation = ""
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaUpdate(emptyMitigation))
+}
+
+func TestValidateDpiaComplete(t *testing.T) {
+	dpia := types.Dpia{}
+	for _, question := range DpiaQuestions {
+		dpia.Answers = append(dpia.Answers, types.DpiaAnswer{Question: question.Key, Answer: "answer"})
+	}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaComplete(dpia))
+
+	dpia.Risks = []types.DpiaRisk{{Description: "risk"}}
+	assert.NoError(t, validateDpiaComplete(dpia))
+
+	dpia.Answers[0].Answer = ""
+	assert.IsType(t, &types.ErrClientInvalidObject{}, validateDpiaComplete(dpia))
+}
+
+func TestDpiaTriggerReason(t *testing.T) {
+	asset := types.Asset{Title: "asset"}
+	assert.Empty(t, dpiaTriggerReason(asset))
+
+	asset.StoredOutsideUkEea = true
+	assert.Contains(t, dpiaTriggerReason(asset), "outside the UK/EEA")
+
+	asset.LegalBasisSpecial = new(string(openapi.AssetLegalBasisSpecialHealth))
+	assert.Contains(t, dpiaTriggerReason(asset), "special category")
+}
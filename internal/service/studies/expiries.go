@@ -0,0 +1,230 @@
+package studies
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Enforce the expiry of active assets which have been expired for longer than
+// the grace period of the enforcement policy. Each asset is flagged as expired,
+// detached from its projects if the policy requires it, and an expiry review
+// raised for the owner of its study
+func (s *Service) EnforceAssetExpiries(ctx context.Context) error {
+	policy := config.AssetExpiryEnforcement()
+	if !policy.Enabled {
+		return nil
+	}
+
+	assets := []types.Asset{}
+	err := s.db.Preload("Study.Owner").Preload("Study.StudyAdmins.User").
+		Where("status = ? AND expires_at IS NOT NULL AND expired_at IS NULL", types.AssetStatusActive).
+		Find(&assets).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get assets")
+	}
+
+	errs := []error{}
+	for _, asset := range assets {
+		if !config.ShouldEnforceAssetExpiry(asset, policy.EnforcementPolicy) {
+			continue
+		}
+		if err := s.enforceAndNotifyAssetExpiry(ctx, asset, policy.Action); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) enforceAndNotifyAssetExpiry(ctx context.Context, asset types.Asset, action config.AssetExpiryAction) error {
+	log.Info().Str("asset", asset.Title).Any("action", action).Msg("Enforcing asset expiry")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	review, err := enforceAssetExpiry(tx, asset, action)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	if err := s.notifications.NotifyAssetExpiryEnforced(ctx, asset.Study, *review); err != nil {
+		log.Err(err).Msg("Failed to notify asset expiry enforcement") // not fatal
+	}
+	return nil
+}
+
+// Expiry reviews of the assets of a study, pending first then most recent first
+func (s *Service) AssetExpiryReviews(studyID uuid.UUID) ([]types.AssetExpiryReview, error) {
+	reviews := []types.AssetExpiryReview{}
+	err := preloadAssetExpiryReview(s.db).
+		Where("asset_id IN (?)", s.db.Model(&types.Asset{}).Select("id").Where("study_id = ?", studyID)).
+		Order(gorm.Expr("status = ? DESC, created_at DESC", types.AssetExpiryReviewStatusPending)).
+		Find(&reviews).Error
+	return reviews, types.NewErrFromGorm(err, "failed to get asset expiry reviews")
+}
+
+// Resolve a pending expiry review, recording the outcome. Extending the expiry
+// clears the expired flag of the asset, while confirming destruction leaves it
+// flagged until the asset is destroyed
+func (s *Service) ResolveAssetExpiryReview(
+	resolver types.User,
+	studyID uuid.UUID,
+	reviewID uuid.UUID,
+	data openapi.AssetExpiryResolution,
+) (*types.AssetExpiryReview, error) {
+	if !data.Outcome.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("outcome must be one of: destroy, extend")
+	}
+	justification := strings.TrimSpace(data.Justification)
+
+	review, err := assetExpiryReview(s.db, studyID, reviewID)
+	if err != nil {
+		return nil, err
+	} else if review.Status != types.AssetExpiryReviewStatusPending {
+		return nil, types.NewErrClientInvalidObjectF("expiry review is %s, not pending", review.Status)
+	}
+
+	update := types.AssetExpiryReview{
+		Status:         types.AssetExpiryReviewStatusDestructionConfirmed,
+		ResolverUserID: &resolver.ID,
+		ResolvedAt:     new(time.Now()),
+	}
+	if justification != "" {
+		update.Justification = &justification
+	}
+	if data.Outcome == openapi.Extend {
+		expiresAt, err := extendedAssetExpiry(justification, data.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		update.Status = types.AssetExpiryReviewStatusExtended
+		update.ExtendedExpiresAt = expiresAt
+	}
+
+	log.Debug().Any("reviewID", reviewID).Any("status", update.Status).Msg("Resolving asset expiry review")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Model(review).Updates(update).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to resolve asset expiry review")
+	}
+
+	if update.ExtendedExpiresAt != nil {
+		err := tx.Model(&types.Asset{}).Where("id = ?", review.AssetID).Updates(map[string]any{
+			"expires_at": *update.ExtendedExpiresAt,
+			"expired_at": nil,
+		}).Error
+		if err != nil {
+			tx.Rollback()
+			return nil, types.NewErrFromGorm(err, "failed to extend asset expiry")
+		}
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return assetExpiryReview(s.db, studyID, reviewID)
+}
+
+// Flag an asset as expired and raise a review within a transaction, detaching
+// it from its projects for the detach action
+func enforceAssetExpiry(tx *gorm.DB, asset types.Asset, action config.AssetExpiryAction) (*types.AssetExpiryReview, error) {
+	if err := tx.Model(&types.Asset{}).Where("id = ?", asset.ID).Update("expired_at", time.Now()).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to flag asset as expired")
+	}
+
+	review := types.AssetExpiryReview{
+		AssetID:            asset.ID,
+		ExpiresAt:          *asset.ExpiresAt,
+		DetachedProjectIDs: []uuid.UUID{},
+		Status:             types.AssetExpiryReviewStatusPending,
+	}
+	if action == config.AssetExpiryActionDetach {
+		projectIDs, err := detachAssetFromProjects(tx, asset.ID)
+		if err != nil {
+			return nil, err
+		}
+		review.DetachedProjectIDs = projectIDs
+	}
+
+	if err := tx.Create(&review).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to create asset expiry review")
+	}
+	review.Asset = asset
+	return &review, nil
+}
+
+// Remove an asset from all projects and their locations, returning the IDs of
+// the projects it was removed from
+func detachAssetFromProjects(tx *gorm.DB, assetID uuid.UUID) ([]uuid.UUID, error) {
+	projectIDs := []uuid.UUID{}
+	if err := tx.Model(&types.ProjectAsset{}).Where("asset_id = ?", assetID).Pluck("project_id", &projectIDs).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset projects")
+	} else if len(projectIDs) == 0 {
+		return projectIDs, nil
+	}
+
+	if err := tx.Where("asset_id = ?", assetID).Delete(&types.ProjectAsset{}).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to remove asset from projects")
+	}
+
+	err := tx.Where("asset_id = ? AND kind = ? AND project_id IN ?", assetID, types.AssetLocationKindProject, projectIDs).
+		Delete(&types.AssetLocation{}).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to delete project asset locations")
+	}
+
+	// Bump the requested version so the deployer applies the change
+	err = tx.Model(&types.ProjectTRE{}).Where("project_id IN ?", projectIDs).
+		Update("requested_version_updated_at", time.Now()).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to update TRE projects")
+	}
+	return projectIDs, nil
+}
+
+// New expiry of an asset being extended, which must be justified and in the future
+func extendedAssetExpiry(justification string, expiresAt *string) (*time.Time, error) {
+	if justification == "" {
+		return nil, types.NewErrClientInvalidObjectF("a justification is required to extend the expiry")
+	} else if expiresAt == nil {
+		return nil, types.NewErrClientInvalidObjectF("a new expiry date is required to extend the expiry")
+	}
+	expiry, err := time.Parse(config.DateFormat, *expiresAt)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("expiry date must be in %s format", config.DateFormat)
+	} else if !expiry.After(time.Now()) {
+		return nil, types.NewErrClientInvalidObjectF("extended expiry date must be in the future")
+	}
+	return &expiry, nil
+}
+
+// Expiry review of an asset within a study
+func assetExpiryReview(db *gorm.DB, studyID uuid.UUID, reviewID uuid.UUID) (*types.AssetExpiryReview, error) {
+	review := types.AssetExpiryReview{}
+	err := preloadAssetExpiryReview(db).
+		Where("id = ?", reviewID).
+		Where("asset_id IN (?)", db.Model(&types.Asset{}).Select("id").Where("study_id = ?", studyID)).
+		First(&review).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset expiry review")
+	}
+	return &review, nil
+}
+
+func preloadAssetExpiryReview(db *gorm.DB) *gorm.DB {
+	return db.Preload("Asset").Preload("ResolverUser")
+}
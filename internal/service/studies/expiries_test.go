@@ -0,0 +1,33 @@
+package studies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestExtendedAssetExpiry(t *testing.T) {
+	nextYear := time.Now().AddDate(1, 0, 0).Format(config.DateFormat)
+	yesterday := time.Now().AddDate(0, 0, -1).Format(config.DateFormat)
+
+	expiry, err := extendedAssetExpiry("still needed", &nextYear)
+	require.NoError(t, err)
+	assert.Equal(t, nextYear, expiry.Format(config.DateFormat))
+
+	for _, invalid := range []struct {
+		justification string
+		expiresAt     *string
+	}{
+		{"", &nextYear},
+		{"still needed", nil},
+		{"still needed", new("next year")},
+		{"still needed", &yesterday},
+	} {
+		_, err := extendedAssetExpiry(invalid.justification, invalid.expiresAt)
+		assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+	}
+}
@@ -12,11 +12,54 @@ import (
 	"github.com/ucl-arc-tre/portal/internal/rbac"
 	"github.com/ucl-arc-tre/portal/internal/service/agreements"
 	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
 )
 
+// Study parsed from an import along with when its owner and admin agreed to the study agreement
+type importedStudy struct {
+	study         types.Study
+	ownerAgreedAt *time.Time
+	adminAgreedAt *time.Time
+}
+
 func (s *Service) ImportStudy(data openapi.StudyImport) (*types.Study, error) {
 	// NOTE: this deliberately doesn't do strong validation of the object
 
+	imported, err := studyFromImport(data)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.users.PersistedUser(types.Username(data.OwnerUsername))
+	if err != nil {
+		return nil, err
+	}
+	var admin *types.User
+	if data.AdditionalStudyAdminUsername != nil {
+		user, err := s.users.PersistedUser(types.Username(*data.AdditionalStudyAdminUsername))
+		if err != nil {
+			return nil, err
+		}
+		admin = &user
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	study, err := importStudy(tx, *imported, owner, admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	log.Debug().Any("id", study.ID).Msg("Imported study")
+	return study, addImportedStudyRoles(*study, *imported)
+}
+
+func studyFromImport(data openapi.StudyImport) (*importedStudy, error) {
 	study := types.Study{
 		Caseref:                          data.Caseref,
 		Title:                            data.Title,
@@ -49,12 +92,10 @@ func (s *Service) ImportStudy(data openapi.StudyImport) (*types.Study, error) {
 		study.ApprovalStatus = data.ApprovalStatus
 	}
 
-	if data.LastSignoff != nil {
-		if lastSignoff, err := time.Parse(config.TimeFormat, *data.LastSignoff); err != nil {
-			return nil, types.NewErrInvalidObject(err)
-		} else {
-			study.LastSignoff = &lastSignoff
-		}
+	if lastSignoff, err := parseOptionalImportTime(data.LastSignoff); err != nil {
+		return nil, err
+	} else {
+		study.LastSignoff = lastSignoff
 	}
 
 	if createdAt, err := time.Parse(config.TimeFormat, data.CreatedAt); err != nil {
@@ -69,111 +110,109 @@ func (s *Service) ImportStudy(data openapi.StudyImport) (*types.Study, error) {
 		study.UpdatedAt = updatedAt
 	}
 
-	owner, err := s.users.PersistedUser(types.Username(data.OwnerUsername))
-	if err != nil {
+	imported := importedStudy{study: study}
+	if ownerAgreedAt, err := parseOptionalImportTime(data.OwnerAgreedAt); err != nil {
+		return nil, err
+	} else {
+		imported.ownerAgreedAt = ownerAgreedAt
+	}
+	if adminAgreedAt, err := parseOptionalImportTime(data.AdminAgreedAt); err != nil {
 		return nil, err
 	} else {
-		study.OwnerUserID = owner.ID
-		study.Owner = owner
+		imported.adminAgreedAt = adminAgreedAt
 	}
+	return &imported, nil
+}
 
-	tx := s.db.Begin()
-	defer graceful.RollbackTransactionOnPanic(tx)
+// Create or update a study by caseref within a transaction. Roles are added once committed
+func importStudy(tx *gorm.DB, imported importedStudy, owner types.User, admin *types.User) (*types.Study, error) {
+	study := imported.study
+	study.OwnerUserID = owner.ID
+	study.Owner = owner
 
 	result := tx.Model(&types.Study{}).
 		Assign(study). // update all fields
-		Where("caseref = ?", data.Caseref).
+		Where("caseref = ?", study.Caseref).
 		FirstOrCreate(&study)
 	if result.Error != nil {
-		tx.Rollback()
 		return nil, types.NewErrFromGorm(result.Error, "failed to create study")
 	}
 
-	if _, err := rbac.AddStudyOwnerRole(owner, study.ID); err != nil {
-		tx.Rollback()
-		return nil, err
-	}
-
 	agreemeent := types.Agreement{}
-	if err := s.db.Where("type = ?", agreements.StudyOwnerType).Order("created_at desc").Limit(1).First(&agreemeent).Error; err != nil {
-		tx.Rollback()
+	if err := tx.Where("type = ?", agreements.StudyOwnerType).Order("created_at desc").Limit(1).First(&agreemeent).Error; err != nil {
 		return nil, types.NewErrFromGorm(err, "failed to get agreement")
 	}
 
-	if data.OwnerAgreedAt != nil {
-		agreedAt, err := time.Parse(config.TimeFormat, *data.OwnerAgreedAt)
-		if err != nil {
-			tx.Rollback()
-			return nil, types.NewErrInvalidObject(err)
-		}
+	if imported.ownerAgreedAt != nil {
 		ownerSignature := types.StudyAgreementSignature{
-			Model:       types.Model{CreatedAt: agreedAt},
+			Model:       types.Model{CreatedAt: *imported.ownerAgreedAt},
 			UserID:      owner.ID,
 			StudyID:     study.ID,
 			AgreementID: agreemeent.ID,
 		}
 		if err := tx.Where(&ownerSignature).FirstOrCreate(&ownerSignature).Error; err != nil {
-			tx.Rollback()
 			return nil, types.NewErrFromGorm(err, "failed to agree owner agreement")
 		}
-		if _, err := rbac.AddRole(owner, rbac.InformationAssetOwner); err != nil {
-			tx.Rollback()
-			return nil, types.NewErrFromGorm(err, "failed to add IAO role")
-		}
 	}
 
-	if data.AdditionalStudyAdminUsername != nil {
-		admin, err := s.users.PersistedUser(types.Username(*data.AdditionalStudyAdminUsername))
-		if err != nil {
-			tx.Rollback()
-			return nil, err
-		}
-
+	if admin != nil {
 		studyAdmin := types.StudyAdmin{StudyID: study.ID, UserID: admin.ID}
 		if err := tx.Model(&studyAdmin).Where("user_id = ? AND study_id = ?", admin.ID, study.ID).Assign(studyAdmin).FirstOrCreate(&studyAdmin).Error; err != nil {
-			tx.Rollback()
 			return nil, types.NewErrFromGorm(err, "failed to create study admin")
 		}
 
-		adminRole := rbac.StudyRole{StudyID: study.ID, Name: rbac.StudyOwner}
-		if _, err := rbac.AddRole(admin, adminRole.RoleName()); err != nil {
-			tx.Rollback()
-			return nil, err
-		}
-
-		if data.AdminAgreedAt != nil {
-			agreedAt, err := time.Parse(config.TimeFormat, *data.AdminAgreedAt)
-			if err != nil {
-				tx.Rollback()
-				return nil, types.NewErrInvalidObject(err)
-			}
+		if imported.adminAgreedAt != nil {
 			adminSignature := types.StudyAgreementSignature{
-				Model:       types.Model{CreatedAt: agreedAt},
+				Model:       types.Model{CreatedAt: *imported.adminAgreedAt},
 				UserID:      admin.ID,
 				StudyID:     study.ID,
 				AgreementID: agreemeent.ID,
 			}
 			if err := tx.Where(&adminSignature).FirstOrCreate(&adminSignature).Error; err != nil {
-				tx.Rollback()
 				return nil, types.NewErrFromGorm(err, "failed to agree admin agreement")
 			}
-			if _, err := rbac.AddRole(admin, rbac.InformationAssetAdministrator); err != nil {
-				tx.Rollback()
-				return nil, types.NewErrFromGorm(err, "failed to add IAA role")
-			}
 		}
 
-		studyAdmin.User = admin
+		studyAdmin.User = *admin
 		study.StudyAdmins = append(study.StudyAdmins, studyAdmin)
 	}
 
-	log.Debug().Any("id", study.ID).Msg("Imported study")
-	return &study, commitTransaction(tx)
+	return &study, nil
+}
+
+// Add the roles of the owner and admins of an imported study. Adding a role is idempotent
+func addImportedStudyRoles(study types.Study, imported importedStudy) error {
+	if _, err := rbac.AddStudyOwnerRole(study.Owner, study.ID); err != nil {
+		return err
+	}
+	if imported.ownerAgreedAt != nil {
+		if _, err := rbac.AddRole(study.Owner, rbac.InformationAssetOwner); err != nil {
+			return types.NewErrFromGorm(err, "failed to add IAO role")
+		}
+	}
+
+	for _, studyAdmin := range study.StudyAdmins {
+		adminRole := rbac.StudyRole{StudyID: study.ID, Name: rbac.StudyOwner}
+		if _, err := rbac.AddRole(studyAdmin.User, adminRole.RoleName()); err != nil {
+			return err
+		}
+		if imported.adminAgreedAt != nil {
+			if _, err := rbac.AddRole(studyAdmin.User, rbac.InformationAssetAdministrator); err != nil {
+				return types.NewErrFromGorm(err, "failed to add IAA role")
+			}
+		}
+	}
+	return nil
 }
 
 func (s *Service) ImportAsset(studyId uuid.UUID, data openapi.AssetImport) (*types.Asset, error) {
 	// NOTE: this deliberately doesn't do strong validation of the object
 
+	asset, err := assetFromImport(data)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
 
@@ -183,12 +222,19 @@ func (s *Service) ImportAsset(studyId uuid.UUID, data openapi.AssetImport) (*typ
 		return nil, types.NewErrFromGorm(err, "failed to get study")
 	}
 
+	if err := importAsset(tx, study, asset, data.Locations, nil); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return asset, commitTransaction(tx)
+}
+
+func assetFromImport(data openapi.AssetImport) (*types.Asset, error) {
 	asset := types.Asset{
 		Title:              data.Title,
 		Description:        data.Description,
 		Tier:               data.Tier,
-		CreatorUserID:      study.OwnerUserID,
-		StudyID:            studyId,
 		Protection:         data.Protection,
 		LegalBasis:         data.LegalBasis,
 		Format:             data.Format,
@@ -197,48 +243,60 @@ func (s *Service) ImportAsset(studyId uuid.UUID, data openapi.AssetImport) (*typ
 		StoredOutsideUkEea: data.StoredOutsideUkEea,
 		Status:             data.Status,
 	}
-	switch data.Tier {
-	case 0:
-		asset.ClassificationImpact = string(openapi.AssetClassificationImpactPublic)
-	case 1:
-		asset.ClassificationImpact = string(openapi.AssetBaseClassificationImpactConfidential)
-	case 2, 3, 4:
-		asset.ClassificationImpact = string(openapi.AssetClassificationImpactHighlyConfidential)
-	default:
-		tx.Rollback()
+	if classificationImpact, ok := classificationImpactOfTier(data.Tier); !ok {
 		return nil, types.NewErrInvalidObject("invalid tier impact")
+	} else {
+		asset.ClassificationImpact = string(classificationImpact)
 	}
 
 	if createdAt, err := time.Parse(config.TimeFormat, data.CreatedAt); err != nil {
-		tx.Rollback()
 		return nil, types.NewErrInvalidObject("failed to parse created at")
 	} else {
 		asset.CreatedAt = createdAt
 	}
 	if expiresAt, err := time.Parse(config.TimeFormat, data.ExpiresAt); err != nil {
-		tx.Rollback()
 		return nil, types.NewErrInvalidObject("failed to parse expires at")
 	} else {
 		asset.ExpiresAt = &expiresAt
 	}
+	return &asset, nil
+}
 
-	if err := tx.Where("title = ? AND study_id = ?", asset.Title, studyId).Assign(asset).FirstOrCreate(&asset).Error; err != nil {
-		tx.Rollback()
-		return nil, types.NewErrFromGorm(err, "failed to create asset")
+// Classification impact of assets imported from a tier, which is all that legacy assets record
+func classificationImpactOfTier(tier int) (openapi.AssetBaseClassificationImpact, bool) {
+	switch tier {
+	case 0:
+		return openapi.AssetBaseClassificationImpactPublic, true
+	case 1:
+		return openapi.AssetBaseClassificationImpactConfidential, true
+	case 2, 3, 4:
+		return openapi.AssetBaseClassificationImpactHighlyConfidential, true
+	default:
+		return "", false
 	}
+}
 
-	if err := tx.Where("asset_id = ?", asset.ID).Delete(&types.AssetLocation{}).Error; err != nil {
-		tx.Rollback()
-		return nil, types.NewErrFromGorm(err, "failed to delete asset locations")
+// Create or update an asset of a study by title within a transaction, replacing its locations.
+// Data types are replaced unless nil, as legacy assets may not record them
+func importAsset(tx *gorm.DB, study types.Study, asset *types.Asset, locations []string, dataTypes []string) error {
+	asset.StudyID = study.ID
+	asset.CreatorUserID = study.OwnerUserID
+
+	if err := tx.Where("title = ? AND study_id = ?", asset.Title, study.ID).Assign(*asset).FirstOrCreate(asset).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to create asset")
+	}
+
+	if err := tx.Where("asset_id = ? AND kind = ?", asset.ID, types.AssetLocationKindOther).Delete(&types.AssetLocation{}).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to delete asset locations")
 	}
 
-	for _, locationStr := range data.Locations {
+	for _, locationStr := range locations {
 		if locationStr == "" {
-			tx.Rollback()
-			return nil, types.NewErrInvalidObject("empty location string")
+			return types.NewErrInvalidObject("empty location string")
 		}
 		assetLocation := types.AssetLocation{
 			AssetID:  asset.ID,
+			Kind:     types.AssetLocationKindOther,
 			Location: locationStr,
 		}
 		asset.Locations = append(asset.Locations, assetLocation)
@@ -246,22 +304,44 @@ func (s *Service) ImportAsset(studyId uuid.UUID, data openapi.AssetImport) (*typ
 
 	existingLocations := []types.AssetLocation{}
 	if err := tx.Unscoped().
-		Where("asset_id = ?", asset.ID).
+		Where("asset_id = ? AND kind = ?", asset.ID, types.AssetLocationKindOther).
 		Find(&existingLocations).Error; err != nil {
-		tx.Rollback()
-		return nil, types.NewErrFromGorm(err, "failed to list existing asset locations")
+		return types.NewErrFromGorm(err, "failed to list existing asset locations")
 	}
 	if err := graceful.UpdateManyExisting(tx, existingLocations, asset.Locations); err != nil {
-		tx.Rollback()
-		return nil, types.NewErrFromGorm(err, "failed to update asset locations")
+		return types.NewErrFromGorm(err, "failed to update asset locations")
+	}
+
+	if dataTypes != nil {
+		existingDataTypes := []types.AssetDataType{}
+		if err := tx.Unscoped().
+			Where("asset_id = ?", asset.ID).
+			Find(&existingDataTypes).Error; err != nil {
+			return types.NewErrFromGorm(err, "failed to list existing asset data types")
+		}
+		for _, dataType := range dataTypes {
+			asset.DataTypes = append(asset.DataTypes, types.AssetDataType{AssetID: asset.ID, Name: dataType})
+		}
+		if err := graceful.UpdateManyExisting(tx, existingDataTypes, asset.DataTypes); err != nil {
+			return types.NewErrFromGorm(err, "failed to update asset data types")
+		}
 	}
 
-	return &asset, commitTransaction(tx)
+	if err := updateStudyRisk(tx, study.ID); err != nil {
+		return err
+	}
+
+	return ensureStudyDpia(tx, *asset)
 }
 
 func (s *Service) ImportContract(studyId uuid.UUID, data openapi.ContractImport) (*types.Contract, error) {
 	// NOTE: this deliberately doesn't do strong validation of the object
 
+	contract, err := contractFromImport(data)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
 
@@ -271,14 +351,6 @@ func (s *Service) ImportContract(studyId uuid.UUID, data openapi.ContractImport)
 		return nil, types.NewErrFromGorm(err, "failed to get study")
 	}
 
-	contract := types.Contract{
-		StudyID:        studyId,
-		CreatorUserID:  study.OwnerUserID,
-		Title:          data.Title,
-		ThirdPartyName: data.ThirdPartyName,
-		Status:         data.Status,
-	}
-
 	if data.OrganisationSignatory != nil {
 		signatory, err := s.persistedContractSignatory(context.Background(), *data.OrganisationSignatory)
 		if err != nil {
@@ -289,9 +361,23 @@ func (s *Service) ImportContract(studyId uuid.UUID, data openapi.ContractImport)
 		contract.SignatoryUser = signatory
 	}
 
+	if err := importContract(tx, study, contract); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return contract, commitTransaction(tx)
+}
+
+func contractFromImport(data openapi.ContractImport) (*types.Contract, error) {
+	contract := types.Contract{
+		Title:          data.Title,
+		ThirdPartyName: data.ThirdPartyName,
+		Status:         data.Status,
+	}
+
 	if data.StartAt != nil {
 		if startDate, err := time.Parse(config.TimeFormat, *data.StartAt); err != nil {
-			tx.Rollback()
 			return nil, types.NewErrInvalidObject("invalid date")
 		} else {
 			contract.StartDate = &startDate
@@ -299,24 +385,39 @@ func (s *Service) ImportContract(studyId uuid.UUID, data openapi.ContractImport)
 	}
 	if data.ExpiryAt != nil {
 		if expiryDate, err := time.Parse(config.TimeFormat, *data.ExpiryAt); err != nil {
-			tx.Rollback()
 			return nil, types.NewErrInvalidObject("invalid date")
 		} else {
 			contract.ExpiryDate = &expiryDate
 		}
 	}
 	if createdAt, err := time.Parse(config.TimeFormat, data.CreatedAt); err != nil {
-		tx.Rollback()
 		return nil, types.NewErrInvalidObject("invalid created at date")
 	} else {
 		contract.CreatedAt = createdAt
 	}
+	return &contract, nil
+}
 
-	if err := tx.Where("title = ? AND study_id = ?", contract.Title, studyId).Assign(contract).FirstOrCreate(&contract).Error; err != nil {
-		tx.Rollback()
-		return nil, types.NewErrFromGorm(err, "failed to create contract")
+// Create or update a contract of a study by title within a transaction
+func importContract(tx *gorm.DB, study types.Study, contract *types.Contract) error {
+	contract.StudyID = study.ID
+	contract.CreatorUserID = study.OwnerUserID
+
+	if err := tx.Where("title = ? AND study_id = ?", contract.Title, study.ID).Assign(*contract).FirstOrCreate(contract).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to create contract")
 	}
 
 	contract.Study = study
-	return &contract, commitTransaction(tx)
+	return nil
+}
+
+func parseOptionalImportTime(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	parsed, err := time.Parse(config.TimeFormat, *value)
+	if err != nil {
+		return nil, types.NewErrInvalidObject(err)
+	}
+	return &parsed, nil
 }
@@ -14,8 +14,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ucl-arc-tre/portal/internal/config"
 	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/controller/scanner"
 	"github.com/ucl-arc-tre/portal/internal/graceful"
 	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/service/agreements"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
 	"github.com/ucl-arc-tre/portal/internal/testutils/mockcontrollers"
 	"github.com/ucl-arc-tre/portal/internal/testutils/mockdb"
 	"github.com/ucl-arc-tre/portal/internal/testutils/mocknotifications"
@@ -34,13 +37,40 @@ func migrate(db *gorm.DB) error {
 	// Run migrations, only the models/tables required by this package
 	err := db.AutoMigrate(
 		&types.User{},
+		&types.Agreement{},
+		&types.StudyAgreementSignature{},
 		&types.Study{},
 		&types.StudyAdmin{},
 		&types.StudyOwnerChangelog{},
+		&types.StudySignoff{},
+		&types.StudyRevision{},
+		&types.StudyRevisionChange{},
+		&types.StudyReviewThread{},
+		&types.StudyReviewComment{},
+		&types.Dpia{},
+		&types.DpiaAnswer{},
+		&types.DpiaRisk{},
 		&types.Asset{},
 		&types.AssetLocation{},
+		&types.AssetDataType{},
+		&types.AssetLink{},
+		&types.AssetDestruction{},
+		&types.AssetTransfer{},
+		&types.AssetRegisterExport{},
+		&types.AssetExpiryReview{},
 		&types.Contract{},
 		&types.ContractObjectMetadata{},
+		&types.ContractObjectDownload{},
+		&types.ContractVersion{},
+		&types.RegulatoryApproval{},
+		&types.StudyDocument{},
+		&types.StudyDocumentVersion{},
+		&types.Environment{},
+		&types.Project{},
+		&types.ProjectTRE{},
+		&types.ProjectTRESuspensionChangelog{},
+		&types.ProjectDSH{},
+		&types.ProjectAsset{},
 	)
 	if err != nil {
 		return err
@@ -687,3 +717,1507 @@ func TestIntegration_CreateStudy(t *testing.T) {
 	assert.Contains(t, adminIDs, admin2.ID)
 
 }
+
+func TestIntegration_StudyRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	graceful.SetDBForTesting(db)
+	rbac.Init()
+
+	service := &Service{
+		db:            db,
+		entra:         new(mockcontrollers.MockEntra),
+		users:         new(mockusers.MockUsers),
+		notifications: new(mocknotifications.MockNotifications),
+	}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+
+	studyData := openapi.StudyRequest{
+		Title:                      "Revision Study",
+		DataControllerOrganisation: "Org",
+	}
+	require.NoError(t, service.CreateStudy(ctx, owner, studyData))
+
+	study := types.Study{}
+	require.NoError(t, db.Where("owner_user_id = ?", owner.ID).First(&study).Error)
+
+	studyData.Description = new("Updated description")
+	require.NoError(t, service.UpdateStudy(ctx, owner, study.ID, studyData))
+	require.NoError(t, service.UpdateStudy(ctx, owner, study.ID, studyData)) // no changes so no revision
+
+	revisions, err := service.StudyRevisions(study.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, owner.Username, revisions[1].User.Username)
+	require.Len(t, revisions[1].Changes, 1)
+	assert.Equal(t, "description", revisions[1].Changes[0].Field)
+
+	changes, err := service.StudyRevisionsDiff(study.ID, revisions[0].ID, revisions[1].ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Nil(t, changes[0].OldValue)
+	assert.Equal(t, "Updated description", *changes[0].NewValue)
+
+	_, err = service.StudyRevisionsDiff(study.ID, revisions[1].ID, revisions[0].ID)
+	assert.Error(t, err)
+}
+
+func TestIntegration_ReviewThreads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockUsers := new(mockusers.MockUsers)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}, users: mockUsers, notifications: new(mocknotifications.MockNotifications)}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	reviewer := types.User{Username: "reviewer@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	require.NoError(t, db.Create(&reviewer).Error)
+	mockUsers.On("UsersWithConfigRole", rbac.IGOpsStaff).Return([]types.User{reviewer}, nil)
+
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusRejected),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	_, err := svc.CreateReviewThread(reviewer, study.ID, openapi.StudyReviewThreadRequest{Field: "not_a_field", Comment: "?"})
+	assert.Error(t, err)
+
+	thread, err := svc.CreateReviewThread(reviewer, study.ID, openapi.StudyReviewThreadRequest{Field: "title", Comment: "Please clarify"})
+	require.NoError(t, err)
+
+	err = svc.UpdateStudyReview(ctx, owner, study.ID, openapi.StudyReview{Status: openapi.StudyApprovalStatusPending})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	require.NoError(t, svc.CreateReviewComment(owner, study.ID, thread.ID, openapi.StudyReviewCommentRequest{Comment: "Done"}))
+	require.NoError(t, svc.ResolveReviewThread(reviewer, study.ID, thread.ID))
+
+	numUnresolved, err := countUnresolvedReviewThreads(db, study.ID)
+	require.NoError(t, err)
+	assert.Zero(t, numUnresolved)
+
+	err = svc.CreateReviewComment(owner, study.ID, thread.ID, openapi.StudyReviewCommentRequest{Comment: "Another"})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	threads, err := svc.StudyReviewThreads(study.ID)
+	require.NoError(t, err)
+	require.Len(t, threads, 1)
+	assert.True(t, threads[0].IsResolved())
+	require.Len(t, threads[0].Comments, 2)
+	assert.Equal(t, reviewer.Username, threads[0].Comments[0].User.Username)
+	assert.Equal(t, owner.Username, threads[0].Comments[1].User.Username)
+
+	// Invalid feedback leaves the study review unchanged
+	review := openapi.StudyReview{Status: openapi.StudyApprovalStatusApproved, Feedback: new(strings.Repeat("a", 1001))}
+	err = svc.UpdateStudyReview(ctx, reviewer, study.ID, review)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+	require.NoError(t, db.First(&study, study.ID).Error)
+	assert.Equal(t, string(openapi.StudyApprovalStatusRejected), study.ApprovalStatus)
+	assert.Nil(t, study.Feedback)
+	threads, err = svc.StudyReviewThreads(study.ID)
+	require.NoError(t, err)
+	assert.Len(t, threads, 1)
+
+	// Feedback on a rejection must be resolved before resubmission, otherwise it is already resolved
+	review = openapi.StudyReview{Status: openapi.StudyApprovalStatusRejected, Feedback: new("Needs work")}
+	require.NoError(t, svc.UpdateStudyReview(ctx, reviewer, study.ID, review))
+	numUnresolved, err = countUnresolvedReviewThreads(db, study.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), numUnresolved)
+
+	threads, err = svc.StudyReviewThreads(study.ID)
+	require.NoError(t, err)
+	require.Len(t, threads, 2)
+	require.NoError(t, svc.ResolveReviewThread(reviewer, study.ID, threads[1].ID))
+
+	review = openapi.StudyReview{Status: openapi.StudyApprovalStatusApproved, Feedback: new("Looks good")}
+	require.NoError(t, svc.UpdateStudyReview(ctx, reviewer, study.ID, review))
+	numUnresolved, err = countUnresolvedReviewThreads(db, study.ID)
+	require.NoError(t, err)
+	assert.Zero(t, numUnresolved)
+	threads, err = svc.StudyReviewThreads(study.ID)
+	require.NoError(t, err)
+	require.Len(t, threads, 3)
+	require.NotNil(t, threads[2].ResolvedByUser)
+	assert.Equal(t, reviewer.Username, threads[2].ResolvedByUser.Username)
+}
+
+func TestIntegration_ClaimStudyReview(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	reviewerA := types.User{Username: "reviewer-a@testIntegration.com"}
+	reviewerB := types.User{Username: "reviewer-b@testIntegration.com"}
+	for _, user := range []*types.User{&owner, &reviewerA, &reviewerB} {
+		require.NoError(t, db.Create(user).Error)
+	}
+
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusPending),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	require.NoError(t, svc.ClaimStudyReview(reviewerA, study.ID))
+	require.NoError(t, svc.ClaimStudyReview(reviewerA, study.ID)) // idempotent
+	err := svc.ClaimStudyReview(reviewerB, study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	assigned, err := svc.AllStudies(QueryParams{ReviewerUserID: &reviewerA.ID, Limit: 12})
+	require.NoError(t, err)
+	require.Len(t, assigned, 1)
+	assert.Equal(t, reviewerA.Username, assigned[0].Reviewer.Username)
+
+	unassigned, err := svc.AllStudies(QueryParams{Unassigned: true, Limit: 12})
+	require.NoError(t, err)
+	assert.Empty(t, unassigned)
+
+	require.NoError(t, svc.UnassignStudyReviewer(study.ID))
+	require.NoError(t, svc.ClaimStudyReview(reviewerB, study.ID))
+
+	// Only pending studies can be reviewed
+	require.NoError(t, svc.UnassignStudyReviewer(study.ID))
+	require.NoError(t, db.Model(&study).Update("approval_status", types.StudyApprovalStatusApproved).Error)
+	err = svc.ClaimStudyReview(reviewerA, study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+}
+
+func TestIntegration_StudyClosure(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusPending),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "asset", Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&asset).Error)
+	contract := types.Contract{CreatorUserID: owner.ID, StudyID: study.ID, Title: "contract", Status: types.ContractStatusActive}
+	require.NoError(t, db.Create(&contract).Error)
+
+	env := types.Environment{Name: "tre", Tier: 3}
+	require.NoError(t, db.Create(&env).Error)
+	deployed := types.Project{Name: "deployed", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	incomplete := types.Project{Name: "incomplete", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	require.NoError(t, db.Create(&deployed).Error)
+	require.NoError(t, db.Create(&incomplete).Error)
+	require.NoError(t, db.Create(&types.ProjectTRE{ProjectID: deployed.ID, Status: types.ProjectTREStatusDeployed}).Error)
+	require.NoError(t, db.Create(&types.ProjectTRE{ProjectID: incomplete.ID, Status: types.ProjectTREStatusIncomplete}).Error)
+	require.NoError(t, db.Create(&types.ProjectDSH{ProjectID: deployed.ID, Status: types.ProjectDSHStatusActive}).Error)
+
+	err := svc.StartStudyClosure(study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not approved
+
+	require.NoError(t, db.Model(&study).Update("approval_status", types.StudyApprovalStatusApproved).Error)
+	require.NoError(t, svc.StartStudyClosure(study.ID))
+
+	closure, err := svc.StudyClosure(study.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.StudyApprovalStatusClosing, closure.Study.ApprovalStatus)
+	assert.False(t, closure.CanComplete())
+	assert.Len(t, closure.OutstandingAssets, 1)
+	assert.Len(t, closure.OutstandingContracts, 1)
+	require.Len(t, closure.OutstandingProjects, 1)
+	assert.Equal(t, deployed.ID, closure.OutstandingProjects[0].ID)
+
+	projectTRE := types.ProjectTRE{}
+	require.NoError(t, db.Where("project_id = ?", deployed.ID).First(&projectTRE).Error)
+	assert.Equal(t, types.ProjectTREStatusPendingDeletion, projectTRE.Status)
+	assert.NotNil(t, projectTRE.RequestedVersionUpdatedAt)
+	projectDSH := types.ProjectDSH{}
+	require.NoError(t, db.Where("project_id = ?", deployed.ID).First(&projectDSH).Error)
+	assert.Equal(t, types.ProjectDSHStatus(types.ProjectDSHStatusRetired), projectDSH.Status)
+
+	err = svc.CompleteStudyClosure(study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	require.NoError(t, db.Model(&asset).Update("status", types.AssetStatusTransferred).Error)
+	require.NoError(t, db.Model(&contract).Update("status", types.ContractStatusClosed).Error)
+	require.NoError(t, svc.CompleteStudyClosure(study.ID))
+
+	exported, err := svc.ApprovedStudies()
+	require.NoError(t, err)
+	assert.Empty(t, exported)
+
+	err = svc.RecordStudySignoff(context.Background(), owner, study.ID, uuid.New(), nil)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	require.NoError(t, svc.ArchiveStudy(study.ID))
+	err = svc.ArchiveStudy(study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+}
+
+func TestIntegration_StudyRisk(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusIncomplete),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	assetData := validAssetBase()
+	assetData.ClassificationImpact = openapi.AssetBaseClassificationImpactHighlyConfidential
+	assetData.Protection = new(openapi.AssetBaseProtectionIdentifiableLowConfidencePseudonymisation)
+	assetData.IsLeakMajorDisruption = new(true)
+	assetData.Locations = []string{"usb_portable_unencrypted"}
+	assetData.Tier = 2
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.CreateAsset(owner, assetData, study.ID))
+
+	assetData.Tier = 3
+	require.NoError(t, svc.CreateAsset(owner, assetData, study.ID))
+
+	critical := openapi.Critical
+	studies, err := svc.AllStudies(QueryParams{RiskRating: &critical, Limit: 12})
+	require.NoError(t, err)
+	assert.Empty(t, studies)
+
+	vulnerable := openapi.Vulnerable
+	studies, err = svc.AllStudies(QueryParams{RiskRating: &vulnerable, Limit: 12})
+	require.NoError(t, err)
+	require.Len(t, studies, 1)
+	assert.Equal(t, 11, *studies[0].RiskScore) // impact 4*4/6 * likelihood 4
+	require.NotNil(t, studies[0].HighestAssetTier)
+	assert.Equal(t, 3, *studies[0].HighestAssetTier)
+}
+
+func TestIntegration_StudySignoff(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	agreement := types.Agreement{Type: agreements.StudySignoffType, Text: "signoff"}
+	require.NoError(t, db.Create(&agreement).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusApproved),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	require.NoError(t, svc.RecordStudySignoff(context.Background(), owner, study.ID, agreement.ID, nil))
+	require.NoError(t, svc.RecordStudySignoff(context.Background(), owner, study.ID, agreement.ID, new("Removed a leaver")))
+
+	signoffs, err := svc.StudySignoffs(study.ID)
+	require.NoError(t, err)
+	require.Len(t, signoffs, 2)
+	assert.Equal(t, "Removed a leaver", *signoffs[0].Comments)
+	assert.Equal(t, owner.Username, signoffs[0].User.Username)
+	assert.Equal(t, agreement.ID, signoffs[1].AgreementID)
+
+	require.NoError(t, db.First(&study, study.ID).Error)
+	require.NotNil(t, study.LastSignoff)
+	assert.WithinDuration(t, signoffs[0].CreatedAt, *study.LastSignoff, time.Second)
+}
+
+func TestIntegration_StudyProjectSuspension(t *testing.T) {
+	require.NoError(t, config.SetforTesting("enforcement.study_signoff.enabled", "true"))
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, notifications: new(mocknotifications.MockNotifications)}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	agreement := types.Agreement{Type: agreements.StudySignoffType, Text: "signoff"}
+	require.NoError(t, db.Create(&agreement).Error)
+	lapsed := time.Now().Add(-(config.StudySignoffValidity + 15*config.Day))
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusApproved),
+		LastSignoff:    &lapsed,
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	env := types.Environment{Name: "tre", Tier: 3}
+	require.NoError(t, db.Create(&env).Error)
+	deployed := types.Project{Name: "deployed", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	incomplete := types.Project{Name: "incomplete", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	require.NoError(t, db.Create(&deployed).Error)
+	require.NoError(t, db.Create(&incomplete).Error)
+	deployedTRE := types.ProjectTRE{ProjectID: deployed.ID, Status: types.ProjectTREStatusDeployed}
+	incompleteTRE := types.ProjectTRE{ProjectID: incomplete.ID, Status: types.ProjectTREStatusIncomplete}
+	require.NoError(t, db.Create(&deployedTRE).Error)
+	require.NoError(t, db.Create(&incompleteTRE).Error)
+
+	require.NoError(t, svc.SuspendLapsedStudyProjects(ctx))
+	require.NoError(t, svc.SuspendLapsedStudyProjects(ctx)) // idempotent
+
+	require.NoError(t, db.First(&deployedTRE, deployedTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusSuspended, deployedTRE.Status)
+	require.NoError(t, db.First(&incompleteTRE, incompleteTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusIncomplete, incompleteTRE.Status)
+
+	require.NoError(t, svc.RecordStudySignoff(ctx, owner, study.ID, agreement.ID, nil))
+	require.NoError(t, db.First(&deployedTRE, deployedTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusDeployed, deployedTRE.Status)
+
+	changes := []types.ProjectTRESuspensionChangelog{}
+	require.NoError(t, db.Where("project_tre_id = ?", deployedTRE.ID).Order("created_at").Find(&changes).Error)
+	require.Len(t, changes, 2)
+	assert.Equal(t, types.ProjectTRESuspensionActionSuspend, changes[0].Action)
+	assert.Equal(t, types.ProjectTRESuspensionActionReinstate, changes[1].Action)
+
+	// A lapsed approval also suspends, and signoff alone does not reinstate
+	approvalData := openapi.RegulatoryApprovalBase{
+		Type:        openapi.RegulatoryApprovalTypeHra,
+		Reference:   "IRAS 123456",
+		IssuingBody: "Health Research Authority",
+		GrantedDate: time.Now().AddDate(-2, 0, 0).Format(config.DateFormat),
+		ExpiryDate:  new(time.Now().AddDate(0, 0, -15).Format(config.DateFormat)),
+	}
+	approval, err := svc.CreateApproval(ctx, study.ID, approvalData, owner)
+	require.NoError(t, err)
+	require.NoError(t, svc.SuspendLapsedStudyProjects(ctx))
+	require.NoError(t, db.First(&deployedTRE, deployedTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusSuspended, deployedTRE.Status)
+
+	require.NoError(t, svc.RecordStudySignoff(ctx, owner, study.ID, agreement.ID, nil))
+	require.NoError(t, db.First(&deployedTRE, deployedTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusSuspended, deployedTRE.Status)
+
+	approvalData.ExpiryDate = new(time.Now().AddDate(1, 0, 0).Format(config.DateFormat))
+	_, err = svc.UpdateApproval(ctx, study.ID, approval.ID, approvalData)
+	require.NoError(t, err)
+	require.NoError(t, db.First(&deployedTRE, deployedTRE.ID).Error)
+	assert.Equal(t, types.ProjectTREStatusDeployed, deployedTRE.Status)
+}
+
+func TestIntegration_RegulatoryApprovals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusApproved),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	data := openapi.RegulatoryApprovalBase{
+		Type:        openapi.RegulatoryApprovalTypeHra,
+		Reference:   "IRAS 123456",
+		IssuingBody: "Health Research Authority",
+		GrantedDate: "2024-01-01",
+		ExpiryDate:  new("2025-01-01"),
+	}
+	approval, err := svc.CreateApproval(ctx, study.ID, data, owner)
+	require.NoError(t, err)
+
+	data.ExpiryDate = nil
+	data.Conditions = new("Annual progress report")
+	updated, err := svc.UpdateApproval(ctx, study.ID, approval.ID, data)
+	require.NoError(t, err)
+	assert.Nil(t, updated.ExpiryDate)
+	assert.Equal(t, "Annual progress report", *updated.Conditions)
+
+	_, err = svc.UpdateApproval(ctx, uuid.New(), approval.ID, data)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	_, err = svc.GetApprovalLetter(ctx, study.ID, approval.ID)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	letterMetadata := s3.ObjectMetadata{Id: approval.ID, Kind: s3.ApprovalLetterKind}
+	mockS3.On("StoreObject", mock.Anything, letterMetadata, mock.Anything).Return(nil)
+	withLetter, err := svc.StoreApprovalLetter(ctx, study.ID, approval.ID, "letter.pdf", mockcontrollers.MockS3Object("letter"))
+	require.NoError(t, err)
+	assert.Equal(t, "letter.pdf", *withLetter.LetterFilename)
+
+	approvals, err := svc.StudyApprovals(study.ID)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	assert.Equal(t, "letter.pdf", *approvals[0].LetterFilename)
+
+	mockS3.On("DeleteObject", letterMetadata).Return(nil)
+	require.NoError(t, svc.DeleteApproval(study.ID, approval.ID))
+	mockS3.AssertExpectations(t)
+
+	approvals, err = svc.StudyApprovals(study.ID)
+	require.NoError(t, err)
+	assert.Empty(t, approvals)
+}
+
+func TestIntegration_StudyDocuments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	admin := types.User{Username: "admin@testIntegration.com"}
+	require.NoError(t, db.Create(&admin).Error)
+	other := types.User{Username: "other@testIntegration.com"}
+	require.NoError(t, db.Create(&other).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusApproved),
+	}
+	require.NoError(t, db.Create(&study).Error)
+	require.NoError(t, db.Create(&types.StudyAdmin{StudyID: study.ID, UserID: admin.ID}).Error)
+
+	for user, expected := range map[types.User]bool{owner: true, admin: true, other: false} {
+		isMember, err := svc.IsStudyOwnerOrAdmin(user, study.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, isMember, user.Username)
+	}
+
+	isDocumentVersion := mock.MatchedBy(func(m s3.ObjectMetadata) bool { return m.Kind == s3.StudyDocumentKind })
+	mockS3.On("StoreObject", mock.Anything, isDocumentVersion, mock.Anything).Return(nil)
+
+	upload := StudyDocumentUpload{Filename: "plan.pdf", Object: mockcontrollers.MockS3Object("v1")}
+	_, err := svc.CreateStudyDocument(ctx, study.ID, owner, "unknown", "Data management plan", upload)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	document, err := svc.CreateStudyDocument(ctx, study.ID, owner, openapi.StudyDocumentCategoryDataManagementPlan, "Data management plan", upload)
+	require.NoError(t, err)
+	require.Len(t, document.Versions, 1)
+	assert.Equal(t, 1, document.Versions[0].Version)
+
+	upload = StudyDocumentUpload{Filename: "plan-v2.pdf", Object: mockcontrollers.MockS3Object("v2")}
+	document, err = svc.AddStudyDocumentVersion(ctx, study.ID, document.ID, admin, upload)
+	require.NoError(t, err)
+	require.Len(t, document.Versions, 2)
+	assert.Equal(t, 2, document.Versions[0].Version)
+	assert.Equal(t, "plan-v2.pdf", document.Versions[0].Filename)
+	assert.Equal(t, admin.Username, document.Versions[0].CreatorUser.Username)
+
+	_, err = svc.AddStudyDocumentVersion(ctx, uuid.New(), document.ID, admin, upload)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+	_, err = svc.GetStudyDocumentVersion(ctx, uuid.New(), document.ID, document.Versions[0].ID)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	mockS3.On("DeleteObject", isDocumentVersion).Return(nil).Twice()
+	require.NoError(t, svc.DeleteStudyDocument(study.ID, document.ID))
+	mockS3.AssertExpectations(t)
+
+	documents, err := svc.StudyDocuments(study.ID)
+	require.NoError(t, err)
+	assert.Empty(t, documents)
+}
+
+func TestIntegration_Dpia(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, entra: &mockcontrollers.MockEntra{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	dpo := types.User{Username: "dpo@testIntegration.com"}
+	require.NoError(t, db.Create(&dpo).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusIncomplete),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	assetData := validAssetBase()
+	require.NoError(t, svc.CreateAsset(owner, assetData, study.ID))
+	_, err := svc.StudyDpia(study.ID)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	assetData.StoredOutsideUkEea = true
+	require.NoError(t, svc.CreateAsset(owner, assetData, study.ID))
+	dpia, err := svc.StudyDpia(study.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.DpiaStatusDraft, dpia.Status)
+	assert.Contains(t, dpia.TriggerReason, "outside the UK/EEA")
+
+	// Incomplete DPIAs cannot be submitted
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.SubmitDpia(study.ID))
+
+	update := openapi.DpiaUpdate{Risks: []openapi.DpiaRisk{validDpiaRisk()}}
+	for _, question := range DpiaQuestions {
+		update.Answers = append(update.Answers, openapi.DpiaAnswerUpdate{Question: question.Key, Answer: "answer"})
+	}
+	dpia, err = svc.UpdateDpia(study.ID, update)
+	require.NoError(t, err)
+	assert.Len(t, dpia.Answers, len(DpiaQuestions))
+	assert.Len(t, dpia.Risks, 1)
+
+	reviewDate := openapi.DpiaSignoff{ReviewDate: time.Now().Add(365 * config.Day).Format(config.DateFormat)}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.SignOffDpia(dpo, study.ID, reviewDate))
+
+	require.NoError(t, svc.SubmitDpia(study.ID))
+	pastReviewDate := openapi.DpiaSignoff{ReviewDate: time.Now().Add(-config.Day).Format(config.DateFormat)}
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.SignOffDpia(dpo, study.ID, pastReviewDate))
+	require.NoError(t, svc.SignOffDpia(dpo, study.ID, reviewDate))
+
+	dpia, err = svc.StudyDpia(study.ID)
+	require.NoError(t, err)
+	assert.True(t, dpia.IsSignedOff())
+	require.NotNil(t, dpia.SignedOffByUser)
+	assert.Equal(t, dpo.Username, dpia.SignedOffByUser.Username)
+
+	// Any change must be signed off again
+	dpia, err = svc.UpdateDpia(study.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, types.DpiaStatusDraft, dpia.Status)
+	assert.Nil(t, dpia.SignedOffByUserID)
+	assert.Nil(t, dpia.ReviewDate)
+}
+
+func TestIntegration_OwnerChangeLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, notifications: new(mocknotifications.MockNotifications)}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	newOwner := types.User{Username: "new-owner@testIntegration.com"}
+	require.NoError(t, db.Create(&newOwner).Error)
+	study := types.Study{
+		OwnerUserID:    owner.ID,
+		ApprovalStatus: string(openapi.StudyApprovalStatusApproved),
+	}
+	require.NoError(t, db.Create(&study).Error)
+
+	request := func(createdAt time.Time) types.StudyOwnerChangelog {
+		changelog := types.StudyOwnerChangelog{
+			StudyID:    study.ID,
+			UserID:     &owner.ID,
+			FromUserID: owner.ID,
+			ToUserID:   newOwner.ID,
+			Action:     types.StudyOwnerChangelogActionRequest,
+		}
+		changelog.CreatedAt = createdAt
+		require.NoError(t, db.Create(&changelog).Error)
+		return changelog
+	}
+	reason := openapi.StudyOwnerChangeDecision{Reason: "Wrong person"}
+
+	// Nothing to reject until requested
+	assert.ErrorIs(t, svc.RejectStudyOwnerChange(ctx, study.ID, owner, reason), types.ErrNotFound)
+
+	// Only requests pending for longer than their validity expire
+	request(time.Now().Add(-config.StudyOwnerChangeRequestValidity - time.Hour))
+	require.NoError(t, svc.ExpireStaleOwnerChanges(ctx))
+	pending, err := svc.PendingOwnerChanges()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	request(time.Now())
+	require.NoError(t, svc.ExpireStaleOwnerChanges(ctx))
+	pending, err = svc.PendingOwnerChanges()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, newOwner.Username, pending[0].ToUser.Username)
+
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.RejectStudyOwnerChange(ctx, study.ID, owner, openapi.StudyOwnerChangeDecision{Reason: " "}))
+	require.NoError(t, svc.RejectStudyOwnerChange(ctx, study.ID, owner, reason))
+	pending, err = svc.PendingOwnerChanges()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.ErrorIs(t, svc.CancelStudyOwnerChange(ctx, study.ID, owner, reason), types.ErrNotFound)
+
+	request(time.Now())
+	require.NoError(t, svc.CancelStudyOwnerChange(ctx, study.ID, owner, reason))
+
+	changelogs := []types.StudyOwnerChangelog{}
+	require.NoError(t, db.Where("study_id = ?", study.ID).Order("created_at").Find(&changelogs).Error)
+	actions := []types.StudyOwnerChangelogAction{}
+	for _, changelog := range changelogs {
+		actions = append(actions, changelog.Action)
+	}
+	assert.Equal(t, []types.StudyOwnerChangelogAction{
+		types.StudyOwnerChangelogActionRequest,
+		types.StudyOwnerChangelogActionExpire,
+		types.StudyOwnerChangelogActionRequest,
+		types.StudyOwnerChangelogActionReject,
+		types.StudyOwnerChangelogActionRequest,
+		types.StudyOwnerChangelogActionCancel,
+	}, actions)
+}
+
+func TestIntegration_BulkImport(t *testing.T) {
+
+	// Note: Remove t.Parallel() from RBAC-dependent integration tests
+
+	ctx := context.Background()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	graceful.SetDBForTesting(db)
+	rbac.Init()
+	require.NoError(t, db.Create(&types.Agreement{Type: agreements.StudyOwnerType, Text: "agreement"}).Error)
+
+	mockEntra := new(mockcontrollers.MockEntra)
+	mockEntra.On("IsStaffMember", mock.Anything, types.Username("admin@example.com")).Return(true, nil)
+	mockEntra.On("FindUsernames", mock.Anything, "Signatory").Return([]types.Username{"signatory@example.com"}, nil)
+	service := &Service{db: db, entra: mockEntra}
+
+	studiesSheet := spreadsheet.Sheet{Name: "studies", Rows: [][]string{
+		{"caseref", "title", "data_controller_organisation", "owner_username", "additional_study_admin_username", "approval_status", "created_at", "updated_at", "owner_agreed_at"},
+		{"7", "Legacy Survey", "UCL", "owner@example.com", "admin@example.com", "Approved", "2019-01-01T00:00:00Z", "2020-01-01T00:00:00Z", "2019-01-02T00:00:00Z"},
+		{"8", "Legacy Cohort", "UCL", "owner@example.com", "", "Approved", "2019-01-01T00:00:00Z", "2020-01-01T00:00:00Z", ""},
+	}}
+	assetsSheet := spreadsheet.Sheet{Name: "assets", Rows: [][]string{
+		{"caseref", "title", "description", "tier", "format", "status", "locations", "data_types", "protection", "legal_basis", "created_at", "expires_at", "has_dspt", "requires_contract", "stored_outside_uk_eea"},
+		{"7", "Survey responses", "Survey responses", "2", "electronic", "active", "UK;EU", "personal", "anonymisation", "consent", "2019-01-01T00:00:00Z", "2030-01-01T00:00:00Z", "no", "yes", "no"},
+	}}
+	contractsSheet := spreadsheet.Sheet{Name: "contracts", Rows: [][]string{
+		{"caseref", "title", "status", "created_at", "organisation_signatory"},
+		{"8", "Data sharing agreement", "active", "2019-01-01T00:00:00Z", "Signatory"},
+	}}
+	sheets := []spreadsheet.Sheet{studiesSheet, assetsSheet, contractsSheet}
+
+	countStudies := func() int64 {
+		var count int64
+		require.NoError(t, db.Model(&types.Study{}).Count(&count).Error)
+		return count
+	}
+
+	report, err := service.BulkImport(ctx, sheets, true)
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.False(t, report.Committed)
+	assert.Equal(t, []int{2, 1, 1}, []int{report.Studies, report.Assets, report.Contracts})
+	assert.Zero(t, countStudies())
+
+	invalidAssets := spreadsheet.Sheet{Name: "assets", Rows: [][]string{
+		assetsSheet.Rows[0],
+		assetsSheet.Rows[1],
+		{"9", "Unknown study", "Unknown study", "0", "electronic", "active", "UK", "", "", "", "2019-01-01T00:00:00Z", "2030-01-01T00:00:00Z", "no", "no", "no"},
+		{"7", "Tier too low", "Tier too low", "2", "electronic", "active", "UK", "", "", "", "2019-01-01T00:00:00Z", "2030-01-01T00:00:00Z", "no", "no", "no"},
+	}}
+	report, err = service.BulkImport(ctx, []spreadsheet.Sheet{studiesSheet, invalidAssets}, false)
+	require.NoError(t, err)
+	require.Len(t, report.Errors, 2)
+	assert.Equal(t, BulkImportError{Sheet: "assets", Row: 3, Message: "no study with caseref [9]"}, report.Errors[0])
+	assert.Equal(t, 4, report.Errors[1].Row)
+	assert.Contains(t, report.Errors[1].Message, "lower than the minimum tier 3")
+	assert.False(t, report.Committed)
+	assert.Zero(t, countStudies())
+
+	report, err = service.BulkImport(ctx, sheets, false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.True(t, report.Committed)
+	assert.Equal(t, int64(2), countStudies())
+
+	study := types.Study{}
+	require.NoError(t, db.Preload("Owner").Preload("StudyAdmins.User").Where("caseref = ?", 7).First(&study).Error)
+	assert.Equal(t, types.Username("owner@example.com"), study.Owner.Username)
+	require.Len(t, study.StudyAdmins, 1)
+	assert.Equal(t, types.Username("admin@example.com"), study.StudyAdmins[0].User.Username)
+
+	asset := types.Asset{}
+	require.NoError(t, db.Preload("Locations").Preload("DataTypes").Where("study_id = ?", study.ID).First(&asset).Error)
+	assert.Len(t, asset.Locations, 2)
+	require.Len(t, asset.DataTypes, 1)
+	assert.Equal(t, "personal", asset.DataTypes[0].Name)
+
+	contract := types.Contract{}
+	require.NoError(t, db.Preload("SignatoryUser").Joins("JOIN studies ON studies.id = contracts.study_id").Where("studies.caseref = ?", 8).First(&contract).Error)
+	assert.Equal(t, types.Username("signatory@example.com"), contract.SignatoryUser.Username)
+
+	ownedStudyIDs, err := rbac.StudyIDsWithRole(study.Owner, rbac.StudyOwner)
+	require.NoError(t, err)
+	assert.Len(t, ownedStudyIDs, 2)
+	isIAO, err := rbac.HasRole(study.Owner, rbac.InformationAssetOwner)
+	require.NoError(t, err)
+	assert.True(t, isIAO)
+
+	// Importing again updates rather than duplicates
+	report, err = service.BulkImport(ctx, sheets, false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, int64(2), countStudies())
+}
+
+func TestIntegration_AssetLineage(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+
+	identifiable := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "identifiable", Tier: 3, Status: types.AssetStatusActive}
+	extract := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "extract", Tier: 1, Status: types.AssetStatusActive}
+	subset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "subset", Tier: 2, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&[]*types.Asset{&identifiable, &extract, &subset}).Error)
+	contract := types.Contract{
+		CreatorUserID: owner.ID,
+		StudyID:       study.ID,
+		Title:         "contract",
+		Status:        types.ContractStatusActive,
+		Assets:        []types.Asset{identifiable},
+	}
+	require.NoError(t, db.Create(&contract).Error)
+
+	link := func(child types.Asset, parent types.Asset, derivationType openapi.AssetDerivationType) error {
+		_, err := svc.LinkAsset(owner, study.ID, child.ID, openapi.AssetLinkRequest{
+			ParentAssetId:  parent.ID.String(),
+			DerivationType: derivationType,
+		})
+		return err
+	}
+
+	err := link(extract, identifiable, openapi.Pseudonymised)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // tier 1 < 3-1
+
+	require.NoError(t, db.Model(&extract).Update("tier", 2).Error)
+	err = link(identifiable, extract, openapi.Subset)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // tier 3 > 2
+	require.NoError(t, link(extract, identifiable, openapi.Pseudonymised))
+	require.NoError(t, link(subset, extract, openapi.Subset))
+	assert.IsType(t, &types.ErrClientInvalidObject{}, link(identifiable, subset, openapi.Aggregated)) // cycle
+	assert.IsType(t, &types.ErrClientInvalidObject{}, link(subset, extract, openapi.Subset))          // duplicate
+
+	asset, err := svc.AssetById(study.ID, subset.ID)
+	require.NoError(t, err)
+	require.Len(t, asset.InheritedContracts, 1)
+	assert.Equal(t, contract.ID, asset.InheritedContracts[0].ID)
+
+	assets, links, err := svc.AssetLineage(study.ID)
+	require.NoError(t, err)
+	assert.Len(t, assets, 3)
+	assert.Len(t, links, 2)
+
+	err = svc.DeleteAsset(study.ID, extract.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // subset is derived from it
+
+	require.NoError(t, svc.UnlinkAsset(study.ID, subset.ID, extract.ID))
+	assert.ErrorIs(t, svc.UnlinkAsset(study.ID, subset.ID, extract.ID), types.ErrNotFound)
+	require.NoError(t, svc.DeleteAsset(study.ID, extract.ID))
+
+	_, links, err = svc.AssetLineage(study.ID)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
+
+func TestIntegration_AssetDestruction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	igOps := types.User{Username: "ig-ops@testIntegration.com"}
+	require.NoError(t, db.Create(&[]*types.User{&owner, &igOps}).Error)
+	study := types.Study{OwnerUserID: owner.ID, ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "asset", Tier: 2, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&asset).Error)
+	env := types.Environment{Name: "tre", Tier: 3}
+	require.NoError(t, db.Create(&env).Error)
+	project := types.Project{Name: "project", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	require.NoError(t, db.Create(&project).Error)
+	projectTRE := types.ProjectTRE{ProjectID: project.ID, Status: types.ProjectTREStatusDeployed}
+	require.NoError(t, db.Create(&projectTRE).Error)
+	require.NoError(t, db.Create(&types.ProjectAsset{ProjectID: project.ID, AssetID: asset.ID}).Error)
+	require.NoError(t, db.Create(&types.AssetLocation{AssetID: asset.ID, Kind: types.AssetLocationKindProject, Location: project.Name, ProjectID: &project.ID}).Error)
+
+	upload := StudyDocumentUpload{Filename: "certificate.pdf", Object: mockcontrollers.MockS3Object("certificate")}
+	destroyedAt := time.Now().AddDate(0, 0, -1).Format(config.DateFormat)
+	mockS3.On("StoreObject", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := svc.RequestAssetDestruction(ctx, study.ID, asset.ID, owner, openapi.AssetDestructionMethod("burnt"), destroyedAt, upload)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	destruction, err := svc.RequestAssetDestruction(ctx, study.ID, asset.ID, owner, openapi.AssetDestructionMethodSecureDeletion, destroyedAt, upload)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetDestructionStatusPending, destruction.Status)
+
+	_, err = svc.RequestAssetDestruction(ctx, study.ID, asset.ID, owner, openapi.AssetDestructionMethodSecureDeletion, destroyedAt, upload)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // already pending
+
+	review := openapi.AssetDestructionReview{Comments: new("Certificate checked")}
+	_, err = svc.ApproveAssetDestruction(owner, study.ID, asset.ID, destruction.ID, review)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // requester cannot approve
+
+	destruction, err = svc.ApproveAssetDestruction(igOps, study.ID, asset.ID, destruction.ID, review)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetDestructionStatusApproved, destruction.Status)
+	assert.Equal(t, igOps.Username, destruction.ReviewerUser.Username)
+
+	destroyed, err := svc.AssetById(study.ID, asset.ID)
+	require.NoError(t, err)
+	assert.True(t, destroyed.IsDestroyed())
+
+	var numProjectAssets int64
+	require.NoError(t, db.Model(&types.ProjectAsset{}).Where("asset_id = ?", asset.ID).Count(&numProjectAssets).Error)
+	assert.Zero(t, numProjectAssets)
+	var numProjectLocations int64
+	require.NoError(t, db.Model(&types.AssetLocation{}).Where("asset_id = ? AND kind = ?", asset.ID, types.AssetLocationKindProject).Count(&numProjectLocations).Error)
+	assert.Zero(t, numProjectLocations)
+	require.NoError(t, db.First(&projectTRE, projectTRE.ID).Error)
+	assert.NotNil(t, projectTRE.RequestedVersionUpdatedAt) // so the deployer removes the asset
+
+	_, err = svc.UpdateAsset(validAssetBase(), study.ID, asset.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, svc.DeleteAsset(study.ID, asset.ID))
+
+	destructions, err := svc.AssetDestructions(study.ID, asset.ID)
+	require.NoError(t, err)
+	assert.Len(t, destructions, 1)
+	mockS3.AssertExpectations(t)
+}
+
+func TestIntegration_AssetTransfer(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	sourceOwner := types.User{Username: "source-owner@testIntegration.com"}
+	targetOwner := types.User{Username: "target-owner@testIntegration.com"}
+	igOps := types.User{Username: "ig-ops@testIntegration.com"}
+	require.NoError(t, db.Create(&[]*types.User{&sourceOwner, &targetOwner, &igOps}).Error)
+	source := types.Study{OwnerUserID: sourceOwner.ID, Title: "source", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	target := types.Study{OwnerUserID: targetOwner.ID, Title: "target", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&[]*types.Study{&source, &target}).Error)
+
+	asset := types.Asset{CreatorUserID: sourceOwner.ID, StudyID: source.ID, Title: "asset", Tier: 2, Status: types.AssetStatusActive}
+	other := types.Asset{CreatorUserID: sourceOwner.ID, StudyID: source.ID, Title: "other", Tier: 1, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&[]*types.Asset{&asset, &other}).Error)
+	require.NoError(t, db.Create(&types.AssetLocation{AssetID: asset.ID, Location: "somewhere"}).Error)
+	contract := types.Contract{CreatorUserID: sourceOwner.ID, StudyID: source.ID, Title: "contract", Status: types.ContractStatusActive}
+	require.NoError(t, db.Create(&contract).Error)
+	require.NoError(t, db.Model(&contract).Association("Assets").Append(&asset, &other))
+
+	data := openapi.AssetTransferRequest{TargetStudyId: target.ID.String(), Reason: "moving group"}
+	_, err := svc.RequestAssetTransfer(sourceOwner, source.ID, asset.ID, openapi.AssetTransferRequest{TargetStudyId: source.ID.String(), Reason: "same"})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+	_, err = svc.RequestAssetTransfer(sourceOwner, source.ID, asset.ID, data)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // contract also covers other asset
+
+	require.NoError(t, db.Model(&contract).Association("Assets").Delete(&other))
+	transfer, err := svc.RequestAssetTransfer(sourceOwner, source.ID, asset.ID, data)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetTransferStatusPending, transfer.Status)
+	assert.NotNil(t, transfer.SourceOwnerApprovedAt)
+	assert.Nil(t, transfer.TargetOwnerApprovedAt)
+
+	_, err = svc.RequestAssetTransfer(sourceOwner, source.ID, asset.ID, data)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // already pending
+
+	_, err = svc.ApproveAssetTransfer(igOps, transfer.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // target owner has not approved
+	pending, err := svc.PendingAssetTransfers()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	_, err = svc.ApproveStudyAssetTransfer(sourceOwner, target.ID, transfer.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not the target owner
+	_, err = svc.ApproveStudyAssetTransfer(targetOwner, uuid.New(), transfer.ID)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	transfer, err = svc.ApproveStudyAssetTransfer(targetOwner, target.ID, transfer.ID)
+	require.NoError(t, err)
+	assert.True(t, transfer.OwnersApproved())
+	pending, err = svc.PendingAssetTransfers()
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	_, err = svc.ApproveAssetTransfer(sourceOwner, transfer.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // requester cannot approve
+
+	transfer, err = svc.ApproveAssetTransfer(igOps, transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetTransferStatusCompleted, transfer.Status)
+	assert.Equal(t, []uuid.UUID{contract.ID}, transfer.ContractIDs)
+	assert.Equal(t, igOps.Username, transfer.ReviewerUser.Username)
+
+	moved, err := svc.AssetById(target.ID, asset.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"somewhere"}, moved.LocationStrings())
+	require.Len(t, moved.Contracts, 1)
+	assert.Equal(t, target.ID, moved.Contracts[0].StudyID)
+	assert.ErrorIs(t, svc.checkAssetExists(source.ID, asset.ID), types.ErrNotFound)
+
+	history, err := svc.AssetTransfers(target.ID, asset.ID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+	sourceTransfers, err := svc.StudyAssetTransfers(source.ID)
+	require.NoError(t, err)
+	assert.Len(t, sourceTransfers, 1)
+
+	back, err := svc.RequestAssetTransfer(targetOwner, target.ID, asset.ID, openapi.AssetTransferRequest{TargetStudyId: source.ID.String(), Reason: "back"})
+	require.NoError(t, err)
+	back, err = svc.RejectStudyAssetTransfer(sourceOwner, source.ID, back.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetTransferStatusRejected, back.Status)
+	_, err = svc.ApproveAssetTransfer(igOps, back.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not pending
+}
+
+func TestIntegration_AssetLocations(t *testing.T) {
+	t.Parallel()
+
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, Title: "located", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	env := types.Environment{Name: "tre", Tier: 1}
+	require.NoError(t, db.Create(&env).Error)
+	project := types.Project{Name: "project", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	require.NoError(t, db.Create(&project).Error)
+
+	assetData := validAssetBase()
+	assetData.Tier = 2
+	assetData.LocationReferences = &[]openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKindEnvironment, EnvironmentId: new(env.ID.String())},
+	}
+	err := svc.CreateAsset(owner, assetData, study.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // tier above the environment
+
+	assetData.Tier = 1
+	require.NoError(t, svc.CreateAsset(owner, assetData, study.ID))
+	assets, err := svc.Assets(study.ID)
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	asset := assets[0]
+	assert.Equal(t, []string{"UK"}, asset.LocationStrings())
+	require.Len(t, asset.Locations, 2)
+
+	assetData.LocationReferences = &[]openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKindProject, ProjectId: new(project.ID.String())},
+	}
+	_, err = svc.UpdateAsset(assetData, study.ID, asset.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not attached to the project
+
+	require.NoError(t, db.Create(&types.ProjectAsset{ProjectID: project.ID, AssetID: asset.ID}).Error)
+	assetData.LocationReferences = &[]openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKindProject, ProjectId: new(project.ID.String())},
+		{Kind: openapi.AssetLocationKindExternalSystem, Name: new("REDCap")},
+	}
+	_, err = svc.UpdateAsset(assetData, study.ID, asset.ID)
+	require.NoError(t, err)
+
+	assetData.LocationReferences = nil // typed locations are kept
+	updated, err := svc.UpdateAsset(assetData, study.ID, asset.ID)
+	require.NoError(t, err)
+	assert.Len(t, updated.Locations, 3)
+
+	report, err := svc.AssetLocationReport(new(types.AssetLocationKindProject))
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, "project", report[0].Location.Name())
+	require.Len(t, report[0].Assets, 1)
+	assert.Equal(t, study.Title, report[0].Assets[0].Study.Title)
+
+	report, err = svc.AssetLocationReport(nil)
+	require.NoError(t, err)
+	assert.Len(t, report, 3)
+	assert.Equal(t, types.AssetLocationKindExternalSystem, report[0].Location.Kind)
+
+	// Kept environment locations are checked against a raised tier
+	assetData.LocationReferences = &[]openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKindEnvironment, EnvironmentId: new(env.ID.String())},
+	}
+	_, err = svc.UpdateAsset(assetData, study.ID, asset.ID)
+	require.NoError(t, err)
+	assetData.LocationReferences = nil
+	assetData.Tier = 2
+	_, err = svc.UpdateAsset(assetData, study.ID, asset.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+}
+
+func TestIntegration_AssetRegister(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	first := types.Study{OwnerUserID: owner.ID, Title: "first", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	second := types.Study{OwnerUserID: owner.ID, Title: "second", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&first).Error)
+	require.NoError(t, db.Create(&second).Error)
+
+	assetData := validAssetBase()
+	assetData.Title = "b"
+	require.NoError(t, svc.CreateAsset(owner, assetData, second.ID))
+	assetData.Title = "a"
+	assetData.Tier = 3
+	require.NoError(t, svc.CreateAsset(owner, assetData, first.ID))
+	destroyed := types.Asset{CreatorUserID: owner.ID, StudyID: first.ID, Title: "c", Tier: 1, Status: types.AssetStatusDestroyed}
+	require.NoError(t, db.Create(&destroyed).Error)
+
+	entries, err := svc.AssetRegister(AssetRegisterFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "a", entries[0].AssetTitle)
+	assert.Equal(t, first.Caseref, entries[0].Caseref)
+	assert.Equal(t, string(owner.Username), entries[0].OwnerUsername)
+	assert.Equal(t, []string{"UK"}, entries[0].Locations)
+	assert.Equal(t, "b", entries[2].AssetTitle)
+
+	entries, err = svc.AssetRegister(AssetRegisterFilter{Status: new(types.AssetStatusActive), Tier: new(3)})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "a", entries[0].AssetTitle)
+
+	_, err = svc.ExportAssetRegister(AssetRegisterFilter{}, types.AssetRegisterFormat("pdf"))
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	file, err := svc.ExportAssetRegister(AssetRegisterFilter{}, types.AssetRegisterFormatXLSX)
+	require.NoError(t, err)
+	assert.Equal(t, 3, file.NumAssets)
+	assert.True(t, strings.HasSuffix(file.Filename, ".xlsx"))
+	sheets, err := spreadsheet.ReadXLSX(file.Content)
+	require.NoError(t, err)
+	require.Len(t, sheets, 1)
+	assert.Len(t, sheets[0].Rows, 4)
+
+	mockS3.On("StoreObject", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	export, err := svc.StoreAssetRegisterExport(ctx, types.AssetRegisterFormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, 3, export.NumAssets)
+	exports, err := svc.AssetRegisterExports()
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.Equal(t, export.ID, exports[0].ID)
+}
+
+func TestIntegration_AssetExpiryEnforcement(t *testing.T) {
+	require.NoError(t, config.SetforTesting("enforcement.asset_expiry.enabled", "true"))
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db, notifications: new(mocknotifications.MockNotifications)}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	expired := time.Now().AddDate(0, 0, -1)
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "expired", Tier: 2, Status: types.AssetStatusActive, ExpiresAt: &expired}
+	current := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "current", Tier: 2, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&[]*types.Asset{&asset, &current}).Error)
+
+	env := types.Environment{Name: "tre", Tier: 3}
+	require.NoError(t, db.Create(&env).Error)
+	project := types.Project{Name: "project", StudyID: study.ID, CreatorUserID: owner.ID, EnvironmentID: env.ID}
+	require.NoError(t, db.Create(&project).Error)
+	projectTRE := types.ProjectTRE{ProjectID: project.ID, Status: types.ProjectTREStatusDeployed}
+	require.NoError(t, db.Create(&projectTRE).Error)
+	require.NoError(t, db.Create(&[]types.ProjectAsset{
+		{ProjectID: project.ID, AssetID: asset.ID},
+		{ProjectID: project.ID, AssetID: current.ID},
+	}).Error)
+
+	require.NoError(t, svc.EnforceAssetExpiries(ctx))
+	require.NoError(t, svc.EnforceAssetExpiries(ctx)) // idempotent
+
+	require.NoError(t, db.First(&asset, asset.ID).Error)
+	assert.True(t, asset.IsExpired())
+	require.NoError(t, db.First(&current, current.ID).Error)
+	assert.False(t, current.IsExpired())
+	var numAttached int64
+	require.NoError(t, db.Model(&types.ProjectAsset{}).Where("project_id = ?", project.ID).Count(&numAttached).Error)
+	assert.Equal(t, int64(1), numAttached)
+	require.NoError(t, db.First(&projectTRE, projectTRE.ID).Error)
+	assert.NotNil(t, projectTRE.RequestedVersionUpdatedAt)
+
+	reviews, err := svc.AssetExpiryReviews(study.ID)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	review := reviews[0]
+	assert.Equal(t, types.AssetExpiryReviewStatusPending, review.Status)
+	assert.Equal(t, []uuid.UUID{project.ID}, review.DetachedProjectIDs)
+
+	assetData := validAssetBase()
+	assetData.ExpiresAt = new(time.Now().AddDate(1, 0, 0).Format(config.DateFormat))
+	_, err = svc.UpdateAsset(assetData, study.ID, asset.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // must be extended through the review
+
+	resolution := openapi.AssetExpiryResolution{Outcome: openapi.Extend, ExpiresAt: assetData.ExpiresAt}
+	_, err = svc.ResolveAssetExpiryReview(owner, study.ID, review.ID, resolution)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // no justification
+	_, err = svc.ResolveAssetExpiryReview(owner, uuid.New(), review.ID, openapi.AssetExpiryResolution{Outcome: openapi.Destroy})
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	resolution.Justification = "Follow up study approved"
+	resolved, err := svc.ResolveAssetExpiryReview(owner, study.ID, review.ID, resolution)
+	require.NoError(t, err)
+	assert.Equal(t, types.AssetExpiryReviewStatusExtended, resolved.Status)
+	require.NotNil(t, resolved.ResolverUser)
+	assert.Equal(t, owner.Username, resolved.ResolverUser.Username)
+	assert.False(t, resolved.Asset.IsExpired())
+	assert.Equal(t, *assetData.ExpiresAt, resolved.Asset.ExpiresAt.Format(config.DateFormat))
+
+	_, err = svc.ResolveAssetExpiryReview(owner, study.ID, review.ID, resolution)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // no longer pending
+}
+
+func TestIntegration_ContractAmendments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	igOps := types.User{Username: "ig-ops@testIntegration.com"}
+	require.NoError(t, db.Create(&[]*types.User{&owner, &igOps}).Error)
+	study := types.Study{OwnerUserID: owner.ID, Title: "study", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "asset", Tier: 2, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&asset).Error)
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	contract := types.Contract{
+		CreatorUserID: owner.ID,
+		StudyID:       study.ID,
+		Title:         "Data sharing agreement",
+		Status:        types.ContractStatusActive,
+		StartDate:     &startDate,
+		ExpiryDate:    &expiryDate,
+	}
+	require.NoError(t, db.Create(&contract).Error)
+
+	// Contracts without recorded versions have their current terms as version 1
+	versions, err := svc.ContractVersions(study.ID, contract.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, 1, versions[0].Version)
+	assert.Equal(t, owner.Username, versions[0].RequesterUser.Username)
+
+	amendment := openapi.ContractAmendmentRequest{
+		EffectiveDate: time.Now().AddDate(0, 0, -1).Format(config.DateFormat),
+		Reason:        "Extended to cover a second asset",
+		Terms: openapi.ContractBase{
+			Title:      "Data sharing agreement",
+			Status:     openapi.ContractBaseStatusActive,
+			StartDate:  new("2024-01-01"),
+			ExpiryDate: new(time.Now().AddDate(2, 0, 0).Format(config.DateFormat)),
+			AssetIds:   []string{asset.ID.String()},
+		},
+	}
+	_, err = svc.RequestContractAmendment(ctx, owner, study.ID, contract.ID, openapi.ContractAmendmentRequest{
+		EffectiveDate: amendment.EffectiveDate,
+		Reason:        "nothing changed",
+		Terms:         openapi.ContractBase{Title: contract.Title, Status: openapi.ContractBaseStatusActive, StartDate: new("2024-01-01"), ExpiryDate: new("2025-01-01")},
+	})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	version, err := svc.RequestContractAmendment(ctx, owner, study.ID, contract.ID, amendment)
+	require.NoError(t, err)
+	assert.Equal(t, 2, version.Version)
+	assert.Equal(t, types.ContractVersionStatusPending, version.Status)
+	assert.Equal(t, []string{"expiry_date", "asset_ids"}, version.ChangedFields)
+
+	_, err = svc.RequestContractAmendment(ctx, owner, study.ID, contract.ID, amendment)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // already pending
+	_, err = svc.ApproveContractAmendment(owner, study.ID, contract.ID, version.ID, openapi.ContractAmendmentReview{})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // requester cannot review
+	_, err = svc.ApproveContractAmendment(igOps, uuid.New(), contract.ID, version.ID, openapi.ContractAmendmentReview{})
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	approved, err := svc.ApproveContractAmendment(igOps, study.ID, contract.ID, version.ID, openapi.ContractAmendmentReview{Comments: new("ok")})
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractVersionStatusApproved, approved.Status)
+	assert.NotNil(t, approved.AppliedAt) // already effective
+
+	current, err := svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, current.Version)
+	assert.Equal(t, *amendment.Terms.ExpiryDate, current.ExpiryDate.Format(config.DateFormat))
+	assert.Len(t, current.Assets, 1)
+
+	_, err = svc.CreateContractObject(ctx, study.ID, ContractObject{
+		Meta: types.ContractObjectMetadata{Filename: "amendment.pdf", ContractID: contract.ID, ContractVersionID: &version.ID},
+	})
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // no longer pending
+
+	// Approved amendments are applied once effective
+	amendment.EffectiveDate = time.Now().AddDate(0, 1, 0).Format(config.DateFormat)
+	amendment.Reason = "Closed early"
+	amendment.Terms.Status = openapi.ContractBaseStatusClosed
+	future, err := svc.RequestContractAmendment(ctx, owner, study.ID, contract.ID, amendment)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"status"}, future.ChangedFields)
+	future, err = svc.ApproveContractAmendment(igOps, study.ID, contract.ID, future.ID, openapi.ContractAmendmentReview{})
+	require.NoError(t, err)
+	assert.Nil(t, future.AppliedAt)
+	assert.True(t, future.IsAwaitingEffect())
+
+	require.NoError(t, svc.ApplyDueContractAmendments())
+	current, err = svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractStatusActive, current.Status)
+
+	require.NoError(t, db.Model(future).Update("effective_date", time.Now().AddDate(0, 0, -1)).Error)
+	require.NoError(t, svc.ApplyDueContractAmendments())
+	current, err = svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractStatusClosed, current.Status)
+	assert.Equal(t, 3, current.Version)
+
+	// Rejected amendments leave the contract unchanged
+	amendment.Reason = "Reopened"
+	amendment.Terms.Status = openapi.ContractBaseStatusActive
+	rejected, err := svc.RequestContractAmendment(ctx, owner, study.ID, contract.ID, amendment)
+	require.NoError(t, err)
+	rejected, err = svc.RejectContractAmendment(igOps, study.ID, contract.ID, rejected.ID, openapi.ContractAmendmentReview{Comments: new("not agreed")})
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractVersionStatusRejected, rejected.Status)
+	assert.Equal(t, "not agreed", *rejected.ReviewerComments)
+
+	versions, err = svc.ContractVersions(study.ID, contract.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 4)
+	for i, version := range versions {
+		assert.Equal(t, i+1, version.Version)
+	}
+	assert.Equal(t, expiryDate, versions[0].ExpiryDate.UTC())
+	current, err = svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractStatusClosed, current.Status)
+
+	// Terms cannot be changed in place, bypassing an amendment
+	terms := amendment.Terms
+	terms.Status = openapi.ContractBaseStatusClosed
+	terms.Title = "Renamed agreement"
+	_, err = svc.UpdateContract(ctx, study.ID, contract.ID, terms)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	terms.Title = contract.Title
+	terms.RetentionEndDate = new("2035-01-01")
+	updated, err := svc.UpdateContract(ctx, study.ID, contract.ID, terms)
+	require.NoError(t, err)
+	assert.Equal(t, "2035-01-01", updated.RetentionEndDate.Format(config.DateFormat))
+	versions, err = svc.ContractVersions(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 4)
+	assert.Equal(t, "Data sharing agreement", versions[0].Title)
+}
+
+func TestIntegration_ContractRenewal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	svc := &Service{db: db}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, Title: "study", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	asset := types.Asset{CreatorUserID: owner.ID, StudyID: study.ID, Title: "asset", Tier: 2, Status: types.AssetStatusActive}
+	require.NoError(t, db.Create(&asset).Error)
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	contract := types.Contract{
+		CreatorUserID:  owner.ID,
+		StudyID:        study.ID,
+		Title:          "Data sharing agreement",
+		ThirdPartyName: new("Third party"),
+		Status:         types.ContractStatusActive,
+		StartDate:      &startDate,
+		ExpiryDate:     &expiryDate,
+		Assets:         []types.Asset{asset},
+	}
+	require.NoError(t, db.Create(&contract).Error)
+
+	_, err := svc.RenewContract(uuid.New(), contract.ID, owner)
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	successor, err := svc.RenewContract(study.ID, contract.ID, owner)
+	require.NoError(t, err)
+	assert.NotEqual(t, contract.ID, successor.ID)
+	assert.Equal(t, contract.ID, *successor.PredecessorContractID)
+	assert.Equal(t, types.ContractStatusPending, successor.Status)
+	assert.Equal(t, contract.Title, successor.Title)
+	assert.Equal(t, *contract.ThirdPartyName, *successor.ThirdPartyName)
+	assert.Equal(t, expiryDate, successor.StartDate.UTC())
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), successor.ExpiryDate.UTC())
+	require.Len(t, successor.Assets, 1)
+	assert.Equal(t, asset.ID, successor.Assets[0].ID)
+
+	versions, err := svc.ContractVersions(study.ID, successor.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+
+	predecessor, err := svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.True(t, predecessor.IsRenewed())
+	assert.Equal(t, successor.ID, predecessor.Successor.ID)
+	assert.Len(t, predecessor.Assets, 1)
+
+	_, err = svc.RenewContract(study.ID, contract.ID, owner)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // already being renewed
+	_, err = svc.RenewContract(study.ID, successor.ID, owner)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err) // not active
+
+	// Making the renewal active closes the renewed contract
+	_, err = svc.UpdateContract(ctx, study.ID, successor.ID, openapi.ContractBase{
+		Title:      successor.Title,
+		Status:     openapi.ContractBaseStatusActive,
+		StartDate:  new("2025-01-01"),
+		ExpiryDate: new("2026-01-01"),
+		AssetIds:   []string{asset.ID.String()},
+	})
+	require.NoError(t, err)
+	predecessor, err = svc.GetContract(study.ID, contract.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractStatusClosed, predecessor.Status)
+	versions, err = svc.ContractVersions(study.ID, contract.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, types.ContractStatusClosed, versions[0].ContractStatus)
+}
+
+func TestIntegration_ContractObjectScanning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	mockScanner := new(mockcontrollers.MockScanner)
+	mockUsers := new(mockusers.MockUsers)
+	svc := &Service{db: db, s3: mockS3, scanner: mockScanner, users: mockUsers, notifications: &mocknotifications.MockNotifications{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, Title: "study", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	contract := types.Contract{CreatorUserID: owner.ID, StudyID: study.ID, Title: "contract", Status: types.ContractStatusActive}
+	require.NoError(t, db.Create(&contract).Error)
+
+	mockS3.On("StoreObject", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockS3.On("GetObject", mock.Anything, mock.Anything).Return(mockcontrollers.MockS3Object("content"), nil)
+	mockS3.On("DeleteObject", mock.Anything).Return(nil)
+	mockUsers.On("UsersWithConfigRole", rbac.IGOpsStaff).Return([]types.User{owner}, nil)
+	mockScanner.On("Scan", mock.Anything, mock.Anything).Return(scanner.Result{}, types.NewErrServerError("clamd unavailable")).Once()
+
+	upload := func(filename string) *types.ContractObjectMetadata {
+		object, err := svc.CreateContractObject(ctx, study.ID, ContractObject{
+			Object: mockcontrollers.MockS3Object("content"),
+			Meta:   types.ContractObjectMetadata{Filename: filename, ContractID: contract.ID},
+		})
+		require.NoError(t, err)
+		return object
+	}
+
+	// Uploads stay quarantined while the scanner is unavailable
+	pending := upload("contract.docx")
+	assert.Equal(t, types.MalwareScanStatusPending, pending.ScanStatus)
+	mockS3.AssertCalled(t, "StoreObject", mock.Anything, s3.ObjectMetadata{Id: pending.ID, Kind: s3.ContractQuarantineKind}, mock.Anything)
+	_, err := svc.GetContractObject(ctx, owner, study.ID, contract.ID, pending.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	mockScanner.On("Scan", mock.Anything, mock.Anything).Return(scanner.Result{}, nil).Once()
+	require.NoError(t, svc.ScanQuarantinedContractObjects(ctx))
+	clean, err := svc.contractObject(study.ID, contract.ID, pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.MalwareScanStatusClean, clean.ScanStatus)
+	assert.NotNil(t, clean.ScannedAt)
+	mockS3.AssertCalled(t, "StoreObject", mock.Anything, s3.ObjectMetadata{Id: pending.ID, Kind: s3.ContractKind}, mock.Anything)
+	mockS3.AssertCalled(t, "DeleteObject", s3.ObjectMetadata{Id: pending.ID, Kind: s3.ContractQuarantineKind})
+	_, err = svc.GetContractObject(ctx, owner, study.ID, contract.ID, pending.ID)
+	assert.NoError(t, err)
+
+	// Infected uploads are kept in quarantine
+	mockScanner.On("Scan", mock.Anything, mock.Anything).Return(scanner.Result{Infected: true, Signature: "Eicar-Signature"}, nil).Once()
+	infected := upload("infected.pdf")
+	assert.Equal(t, types.MalwareScanStatusInfected, infected.ScanStatus)
+	assert.Equal(t, "Eicar-Signature", *infected.ScanSignature)
+	mockS3.AssertNotCalled(t, "StoreObject", mock.Anything, s3.ObjectMetadata{Id: infected.ID, Kind: s3.ContractKind}, mock.Anything)
+	mockUsers.AssertCalled(t, "UsersWithConfigRole", rbac.IGOpsStaff)
+	_, err = svc.GetContractObject(ctx, owner, study.ID, contract.ID, infected.ID)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	require.NoError(t, svc.DeleteContractObject(ctx, study.ID, contract.ID, infected.ID))
+	mockS3.AssertCalled(t, "DeleteObject", s3.ObjectMetadata{Id: infected.ID, Kind: s3.ContractQuarantineKind})
+}
+
+func TestIntegration_ContractObjectDownloads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := mockdb.NewTestDBSchema(t, migrate)
+	mockS3 := new(mockcontrollers.MockS3)
+	svc := &Service{db: db, s3: mockS3, notifications: &mocknotifications.MockNotifications{}}
+
+	owner := types.User{Username: "owner@testIntegration.com"}
+	require.NoError(t, db.Create(&owner).Error)
+	study := types.Study{OwnerUserID: owner.ID, Title: "study", ApprovalStatus: string(openapi.StudyApprovalStatusApproved)}
+	require.NoError(t, db.Create(&study).Error)
+	contract := types.Contract{CreatorUserID: owner.ID, StudyID: study.ID, Title: "contract", Status: types.ContractStatusActive}
+	require.NoError(t, db.Create(&contract).Error)
+
+	mockS3.On("StoreObject", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockS3.On("GetObject", mock.Anything, mock.Anything).Return(mockcontrollers.MockS3Object("content"), nil)
+	mockS3.On("DeleteObject", mock.Anything).Return(nil)
+
+	upload := func(filename string) *types.ContractObjectMetadata {
+		object, err := svc.CreateContractObject(ctx, study.ID, ContractObject{
+			Object: mockcontrollers.MockS3Object("content"),
+			Meta:   types.ContractObjectMetadata{Filename: filename, ContractID: contract.ID},
+		})
+		require.NoError(t, err)
+		return object
+	}
+
+	// Files other than PDFs are downloaded unchanged
+	document := upload("contract.docx")
+	object, err := svc.GetContractObject(ctx, owner, study.ID, contract.ID, document.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mockcontrollers.MockS3Object("content").NumBytes, object.NumBytes)
+
+	// PDFs that cannot be watermarked are not downloaded
+	invalid := upload("contract.pdf")
+	_, err = svc.GetContractObject(ctx, owner, study.ID, contract.ID, invalid.ID)
+	assert.Error(t, err)
+
+	// Downloads are kept after the object is deleted
+	require.NoError(t, svc.DeleteContractObject(ctx, study.ID, contract.ID, document.ID))
+	downloads, err := svc.ContractObjectDownloads(study.ID, contract.ID)
+	require.NoError(t, err)
+	require.Len(t, downloads, 1)
+	assert.Equal(t, document.ID, downloads[0].ContractObjectID)
+	assert.Equal(t, "contract.docx", downloads[0].ContractObject.Filename)
+	assert.Equal(t, owner.Username, downloads[0].User.Username)
+	assert.False(t, downloads[0].Watermarked)
+
+	_, err = svc.ContractObjectDownloads(study.ID, uuid.New())
+	assert.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -0,0 +1,229 @@
+package studies
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Link an asset to a parent asset in the same study that it was derived from
+func (s *Service) LinkAsset(creator types.User, studyID uuid.UUID, assetID uuid.UUID, data openapi.AssetLinkRequest) (*types.AssetLink, error) {
+	log.Debug().Any("studyID", studyID).Any("assetID", assetID).Any("parentAssetID", data.ParentAssetId).Msg("Linking asset")
+
+	if !data.DerivationType.Valid() {
+		return nil, types.NewErrClientInvalidObjectF("derivation type must be one of: subset, pseudonymised, aggregated, linked")
+	}
+	parentID, err := uuid.Parse(data.ParentAssetId)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("invalid parent asset id [%v]", data.ParentAssetId)
+	} else if parentID == assetID {
+		return nil, types.NewErrClientInvalidObjectF("an asset cannot be derived from itself")
+	}
+
+	child := types.Asset{}
+	if err := s.db.Where("study_id = ? AND id = ?", studyID, assetID).First(&child).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset")
+	} else if child.IsDestroyed() {
+		return nil, types.NewErrClientInvalidObjectF("destroyed assets cannot be modified")
+	}
+	parent := types.Asset{}
+	if err := s.db.Where("study_id = ? AND id = ?", studyID, parentID).First(&parent).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get parent asset")
+	}
+
+	links, err := studyAssetLinks(s.db, studyID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.ContainsFunc(links, func(link types.AssetLink) bool {
+		return link.ParentAssetID == parentID && link.ChildAssetID == assetID
+	}) {
+		return nil, types.NewErrClientInvalidObjectF("asset is already linked to this parent")
+	} else if slices.Contains(assetAncestorIDs(links, parentID), assetID) {
+		return nil, types.NewErrClientInvalidObjectF("parent asset is derived from this asset")
+	}
+
+	derivationType := types.AssetDerivationType(data.DerivationType)
+	if err := validateDerivedTier(child, parent, derivationType); err != nil {
+		return nil, err
+	}
+
+	link := types.AssetLink{
+		ParentAssetID:  parentID,
+		ChildAssetID:   assetID,
+		DerivationType: derivationType,
+		CreatorUserID:  creator.ID,
+	}
+	if err := s.db.Create(&link).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to create asset link")
+	}
+	return &link, nil
+}
+
+// Remove the link between an asset and one of its parents
+func (s *Service) UnlinkAsset(studyID uuid.UUID, assetID uuid.UUID, parentID uuid.UUID) error {
+	log.Debug().Any("studyID", studyID).Any("assetID", assetID).Any("parentAssetID", parentID).Msg("Unlinking asset")
+
+	asset := types.Asset{}
+	if err := s.db.Where("study_id = ? AND id = ?", studyID, assetID).First(&asset).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get asset")
+	} else if asset.IsDestroyed() {
+		return types.NewErrClientInvalidObjectF("destroyed assets cannot be modified")
+	}
+	result := s.db.Where("child_asset_id = ? AND parent_asset_id = ?", assetID, parentID).Delete(&types.AssetLink{})
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to delete asset link")
+	} else if result.RowsAffected == 0 {
+		return types.NewNotFoundError(fmt.Errorf("asset [%v] is not linked to parent [%v]", assetID, parentID))
+	}
+	return nil
+}
+
+// Assets of a study and the links between them
+func (s *Service) AssetLineage(studyID uuid.UUID) ([]types.Asset, []types.AssetLink, error) {
+	assets := []types.Asset{}
+	if err := s.db.Where("study_id = ?", studyID).Order("created_at").Find(&assets).Error; err != nil {
+		return nil, nil, types.NewErrFromGorm(err, "failed to get assets")
+	}
+	links, err := studyAssetLinks(s.db, studyID)
+	return assets, links, err
+}
+
+// Lowest tier a derived asset may have given the tier of its parent. Subsets
+// and linked assets are at least as sensitive as their parent, pseudonymisation
+// allows one tier lower and aggregates are assessed on their own answers
+func minimumDerivedTier(parentTier int, derivationType types.AssetDerivationType) int {
+	switch derivationType {
+	case types.AssetDerivationTypePseudonymised:
+		return max(parentTier-1, 0)
+	case types.AssetDerivationTypeAggregated:
+		return 0
+	default:
+		return parentTier
+	}
+}
+
+// Highest tier a derived asset may have given the tier of its parent. Subsets,
+// pseudonymised extracts and aggregates cannot be more sensitive than their
+// parent, while linking may combine it with other data so has no maximum
+func maximumDerivedTier(parentTier int, derivationType types.AssetDerivationType) (int, bool) {
+	if derivationType == types.AssetDerivationTypeLinked {
+		return 0, false
+	}
+	return parentTier, true
+}
+
+func validateDerivedTier(child types.Asset, parent types.Asset, derivationType types.AssetDerivationType) error {
+	if minimumTier := minimumDerivedTier(parent.Tier, derivationType); child.Tier < minimumTier {
+		return types.NewErrClientInvalidObjectF(
+			"tier %d of asset [%s] is lower than the minimum tier %d for a %s derivation of asset [%s]",
+			child.Tier, child.Title, minimumTier, derivationType, parent.Title,
+		)
+	}
+	if maximumTier, hasMaximum := maximumDerivedTier(parent.Tier, derivationType); hasMaximum && child.Tier > maximumTier {
+		return types.NewErrClientInvalidObjectF(
+			"tier %d of asset [%s] is higher than the maximum tier %d for a %s derivation of asset [%s]",
+			child.Tier, child.Title, maximumTier, derivationType, parent.Title,
+		)
+	}
+	return nil
+}
+
+// Check a new tier for an asset is allowed by the assets it was derived from,
+// and still allows the tiers of the assets derived from it
+func validateLineageTiers(db *gorm.DB, asset types.Asset) error {
+	links := []types.AssetLink{}
+	err := db.Preload("ParentAsset").Preload("ChildAsset").
+		Where("child_asset_id = ? OR parent_asset_id = ?", asset.ID, asset.ID).
+		Find(&links).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get asset links")
+	}
+	for _, link := range links {
+		if link.ChildAssetID == asset.ID {
+			err = validateDerivedTier(asset, link.ParentAsset, link.DerivationType)
+		} else {
+			err = validateDerivedTier(link.ChildAsset, asset, link.DerivationType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set the contracts inherited by each asset from the assets it was derived from
+func (s *Service) setInheritedContracts(studyID uuid.UUID, assets []types.Asset) error {
+	links, err := studyAssetLinks(s.db, studyID)
+	if err != nil || len(links) == 0 {
+		return err
+	}
+
+	ancestorIDs := map[uuid.UUID][]uuid.UUID{}
+	allAncestorIDs := []uuid.UUID{}
+	for _, asset := range assets {
+		ancestorIDs[asset.ID] = assetAncestorIDs(links, asset.ID)
+		allAncestorIDs = append(allAncestorIDs, ancestorIDs[asset.ID]...)
+	}
+	if len(allAncestorIDs) == 0 {
+		return nil
+	}
+
+	ancestors := []types.Asset{}
+	if err := s.db.Preload("Contracts").Where("id IN ?", allAncestorIDs).Find(&ancestors).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get parent asset contracts")
+	}
+	contracts := map[uuid.UUID][]types.Contract{}
+	for _, ancestor := range ancestors {
+		contracts[ancestor.ID] = ancestor.Contracts
+	}
+
+	for i, asset := range assets {
+		seen := map[uuid.UUID]bool{}
+		for _, contract := range asset.Contracts {
+			seen[contract.ID] = true
+		}
+		for _, ancestorID := range ancestorIDs[asset.ID] {
+			for _, contract := range contracts[ancestorID] {
+				if !seen[contract.ID] {
+					seen[contract.ID] = true
+					assets[i].InheritedContracts = append(assets[i].InheritedContracts, contract)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Links between the assets of a study. Links never cross studies
+func studyAssetLinks(db *gorm.DB, studyID uuid.UUID) ([]types.AssetLink, error) {
+	links := []types.AssetLink{}
+	err := db.Where("child_asset_id IN (?)", db.Model(&types.Asset{}).Select("id").Where("study_id = ?", studyID)).
+		Order("created_at").
+		Find(&links).Error
+	return links, types.NewErrFromGorm(err, "failed to get asset links")
+}
+
+// IDs of all the assets an asset was derived from, nearest first
+func assetAncestorIDs(links []types.AssetLink, assetID uuid.UUID) []uuid.UUID {
+	ancestorIDs := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{assetID: true}
+	queue := []uuid.UUID{assetID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, link := range links {
+			if link.ChildAssetID == id && !seen[link.ParentAssetID] {
+				seen[link.ParentAssetID] = true
+				ancestorIDs = append(ancestorIDs, link.ParentAssetID)
+				queue = append(queue, link.ParentAssetID)
+			}
+		}
+	}
+	return ancestorIDs
+}
@@ -0,0 +1,56 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestMinimumDerivedTier(t *testing.T) {
+	assert.Equal(t, 3, minimumDerivedTier(3, types.AssetDerivationTypeSubset))
+	assert.Equal(t, 3, minimumDerivedTier(3, types.AssetDerivationTypeLinked))
+	assert.Equal(t, 2, minimumDerivedTier(3, types.AssetDerivationTypePseudonymised))
+	assert.Equal(t, 0, minimumDerivedTier(0, types.AssetDerivationTypePseudonymised))
+	assert.Equal(t, 0, minimumDerivedTier(4, types.AssetDerivationTypeAggregated))
+}
+
+func TestValidateDerivedTier(t *testing.T) {
+	parent := types.Asset{Title: "parent", Tier: 3}
+	assert.NoError(t, validateDerivedTier(types.Asset{Tier: 3}, parent, types.AssetDerivationTypeSubset))
+	assert.NoError(t, validateDerivedTier(types.Asset{Tier: 1}, parent, types.AssetDerivationTypeAggregated))
+
+	err := validateDerivedTier(types.Asset{Title: "child", Tier: 2}, parent, types.AssetDerivationTypeLinked)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	assert.NoError(t, validateDerivedTier(types.Asset{Tier: 4}, parent, types.AssetDerivationTypeLinked))
+	for _, derivationType := range []types.AssetDerivationType{
+		types.AssetDerivationTypeSubset,
+		types.AssetDerivationTypePseudonymised,
+		types.AssetDerivationTypeAggregated,
+	} {
+		err := validateDerivedTier(types.Asset{Title: "child", Tier: 4}, parent, derivationType)
+		assert.IsType(t, &types.ErrClientInvalidObject{}, err, derivationType)
+	}
+}
+
+func TestMaximumDerivedTier(t *testing.T) {
+	maximum, hasMaximum := maximumDerivedTier(2, types.AssetDerivationTypeSubset)
+	assert.True(t, hasMaximum)
+	assert.Equal(t, 2, maximum)
+	_, hasMaximum = maximumDerivedTier(2, types.AssetDerivationTypeLinked)
+	assert.False(t, hasMaximum)
+}
+
+func TestAssetAncestorIDs(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	links := []types.AssetLink{
+		{ParentAssetID: a, ChildAssetID: b},
+		{ParentAssetID: b, ChildAssetID: c},
+		{ParentAssetID: a, ChildAssetID: c},
+		{ParentAssetID: c, ChildAssetID: d},
+	}
+	assert.Equal(t, []uuid.UUID{c, b, a}, assetAncestorIDs(links, d))
+	assert.Empty(t, assetAncestorIDs(links, a))
+}
@@ -0,0 +1,178 @@
+package studies
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Active assets stored in a location
+type LocationAssets struct {
+	Location types.AssetLocation
+	Assets   []types.Asset
+}
+
+// Active assets of all studies grouped by location, optionally only those of one kind
+func (s *Service) AssetLocationReport(kind *types.AssetLocationKind) ([]LocationAssets, error) {
+	query := s.db.Preload("Asset.Study").Preload("Environment").Preload("Project").
+		Joins("JOIN assets ON assets.id = asset_locations.asset_id AND assets.deleted_at IS NULL").
+		Where("assets.status = ?", types.AssetStatusActive)
+	if kind != nil {
+		query = query.Where("asset_locations.kind = ?", *kind)
+	}
+	locations := []types.AssetLocation{}
+	if err := query.Order("assets.title").Find(&locations).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset locations")
+	}
+
+	report := []LocationAssets{}
+	indices := map[string]int{}
+	for _, location := range locations {
+		i, exists := indices[location.PlaceKey()]
+		if !exists {
+			i = len(report)
+			indices[location.PlaceKey()] = i
+			report = append(report, LocationAssets{Location: location})
+		}
+		report[i].Assets = append(report[i].Assets, location.Asset)
+	}
+	slices.SortFunc(report, func(a LocationAssets, b LocationAssets) int {
+		return cmp.Or(
+			cmp.Compare(a.Location.Kind, b.Location.Kind),
+			cmp.Compare(a.Location.Name(), b.Location.Name()),
+		)
+	})
+	return report, nil
+}
+
+// Check a location reference has the fields required by its kind
+func validateLocationReference(reference openapi.AssetLocationReference) error {
+	switch reference.Kind {
+	case openapi.AssetLocationKindEnvironment:
+		if reference.EnvironmentId == nil {
+			return types.NewErrClientInvalidObjectF("environment locations must have an environment id")
+		} else if _, err := uuid.Parse(*reference.EnvironmentId); err != nil {
+			return types.NewErrClientInvalidObjectF("invalid environment id [%v]", *reference.EnvironmentId)
+		}
+	case openapi.AssetLocationKindProject:
+		if reference.ProjectId == nil {
+			return types.NewErrClientInvalidObjectF("project locations must have a project id")
+		} else if _, err := uuid.Parse(*reference.ProjectId); err != nil {
+			return types.NewErrClientInvalidObjectF("invalid project id [%v]", *reference.ProjectId)
+		}
+	case openapi.AssetLocationKindExternalSystem, openapi.AssetLocationKindOther:
+		if reference.Name == nil || strings.TrimSpace(*reference.Name) == "" {
+			return types.NewErrClientInvalidObjectF("%s locations must have a name", reference.Kind)
+		}
+	default:
+		return types.NewErrClientInvalidObjectF("location kind must be one of: environment, project, external_system, other")
+	}
+	return nil
+}
+
+// Locations requested for an asset. Typed locations are kept unchanged when
+// the request has no location references
+func assetLocationsFromBase(db *gorm.DB, asset types.Asset, data openapi.AssetBase) ([]types.AssetLocation, error) {
+	locations := []types.AssetLocation{}
+	for _, location := range data.Locations {
+		locations = append(locations, types.AssetLocation{
+			AssetID:  asset.ID,
+			Kind:     types.AssetLocationKindOther,
+			Location: location,
+		})
+	}
+
+	if data.LocationReferences == nil {
+		existing := []types.AssetLocation{}
+		err := db.Preload("Environment").Where("asset_id = ? AND kind != ?", asset.ID, types.AssetLocationKindOther).Find(&existing).Error
+		if err != nil {
+			return nil, types.NewErrFromGorm(err, "failed to get asset locations")
+		}
+		for _, location := range existing {
+			// The tier of the asset may have changed
+			if location.Environment != nil {
+				if err := checkEnvironmentTier(asset, *location.Environment); err != nil {
+					return nil, err
+				}
+			}
+			locations = append(locations, types.AssetLocation{
+				AssetID:       asset.ID,
+				Kind:          location.Kind,
+				Location:      location.Location,
+				EnvironmentID: location.EnvironmentID,
+				ProjectID:     location.ProjectID,
+			})
+		}
+		return locations, nil
+	}
+
+	for _, reference := range *data.LocationReferences {
+		location, err := assetLocationFromReference(db, asset, reference)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, *location)
+	}
+	return locations, nil
+}
+
+func assetLocationFromReference(db *gorm.DB, asset types.Asset, reference openapi.AssetLocationReference) (*types.AssetLocation, error) {
+	if err := validateLocationReference(reference); err != nil {
+		return nil, err
+	}
+	location := types.AssetLocation{AssetID: asset.ID, Kind: types.AssetLocationKind(reference.Kind)}
+
+	switch reference.Kind {
+	case openapi.AssetLocationKindEnvironment:
+		environment := types.Environment{}
+		result := db.Where("id = ?", *reference.EnvironmentId).Limit(1).Find(&environment)
+		if result.Error != nil {
+			return nil, types.NewErrFromGorm(result.Error, "failed to get environment")
+		} else if result.RowsAffected == 0 {
+			return nil, types.NewErrClientInvalidObjectF("environment [%v] not found", *reference.EnvironmentId)
+		} else if err := checkEnvironmentTier(asset, environment); err != nil {
+			return nil, err
+		}
+		location.EnvironmentID = &environment.ID
+		location.Location = string(environment.Name)
+
+	case openapi.AssetLocationKindProject:
+		project := types.Project{}
+		result := db.Where("id = ? AND study_id = ?", *reference.ProjectId, asset.StudyID).Limit(1).Find(&project)
+		if result.Error != nil {
+			return nil, types.NewErrFromGorm(result.Error, "failed to get project")
+		} else if result.RowsAffected == 0 {
+			return nil, types.NewErrClientInvalidObjectF("project [%v] not found in the study", *reference.ProjectId)
+		}
+		var numAttached int64
+		err := db.Model(&types.ProjectAsset{}).
+			Where("project_id = ? AND asset_id = ?", project.ID, asset.ID).
+			Count(&numAttached).Error
+		if err != nil {
+			return nil, types.NewErrFromGorm(err, "failed to check project assets")
+		} else if numAttached == 0 {
+			return nil, types.NewErrClientInvalidObjectF("asset is not attached to project [%s]", project.Name)
+		}
+		location.ProjectID = &project.ID
+		location.Location = project.Name
+
+	default:
+		location.Location = strings.TrimSpace(*reference.Name)
+	}
+	return &location, nil
+}
+
+// Check an asset may be stored in an environment given their tiers
+func checkEnvironmentTier(asset types.Asset, environment types.Environment) error {
+	if asset.Tier > environment.Tier {
+		return types.NewErrClientInvalidObjectF(
+			"environment [%s] only accepts assets up to tier %d", environment.Name, environment.Tier,
+		)
+	}
+	return nil
+}
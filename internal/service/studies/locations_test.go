@@ -0,0 +1,47 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestValidateLocationReference(t *testing.T) {
+	id := uuid.New().String()
+	valid := []openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKindEnvironment, EnvironmentId: &id},
+		{Kind: openapi.AssetLocationKindProject, ProjectId: &id},
+		{Kind: openapi.AssetLocationKindExternalSystem, Name: new("REDCap")},
+		{Kind: openapi.AssetLocationKindOther, Name: new("filing cabinet")},
+	}
+	for _, reference := range valid {
+		assert.NoError(t, validateLocationReference(reference), reference.Kind)
+	}
+
+	invalid := []openapi.AssetLocationReference{
+		{Kind: openapi.AssetLocationKind("cloud")},
+		{Kind: openapi.AssetLocationKindEnvironment},
+		{Kind: openapi.AssetLocationKindProject, ProjectId: new("not-a-uuid")},
+		{Kind: openapi.AssetLocationKindExternalSystem, Name: new("  ")},
+		{Kind: openapi.AssetLocationKindOther},
+	}
+	for _, reference := range invalid {
+		assert.IsType(t, &types.ErrClientInvalidObject{}, validateLocationReference(reference), reference.Kind)
+	}
+}
+
+func TestLocationLikelihood(t *testing.T) {
+	assert.Equal(t, locationLikelihoods["arc_tre"], locationLikelihood(types.AssetLocation{Kind: types.AssetLocationKindProject}))
+	assert.Equal(t, locationLikelihoods["email"], locationLikelihood(types.AssetLocation{Kind: types.AssetLocationKindOther, Location: "email"}))
+	assert.Zero(t, locationLikelihood(types.AssetLocation{Kind: types.AssetLocationKindExternalSystem, Location: "REDCap"}))
+}
+
+func TestCheckEnvironmentTier(t *testing.T) {
+	environment := types.Environment{Name: "tre", Tier: 2}
+	assert.NoError(t, checkEnvironmentTier(types.Asset{Tier: 1}, environment))
+	assert.NoError(t, checkEnvironmentTier(types.Asset{Tier: 2}, environment))
+	assert.IsType(t, &types.ErrClientInvalidObject{}, checkEnvironmentTier(types.Asset{Tier: 3}, environment))
+}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
 	"github.com/ucl-arc-tre/portal/internal/controller/entra"
 	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/controller/scanner"
 	"github.com/ucl-arc-tre/portal/internal/graceful"
 	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
 	"github.com/ucl-arc-tre/portal/internal/rbac"
 	"github.com/ucl-arc-tre/portal/internal/service/notifications"
+	"github.com/ucl-arc-tre/portal/internal/service/objects"
 	"github.com/ucl-arc-tre/portal/internal/service/users"
 	"github.com/ucl-arc-tre/portal/internal/types"
 	"github.com/ucl-arc-tre/portal/internal/validation"
@@ -31,6 +34,7 @@ type Service struct {
 	db            *gorm.DB
 	entra         entra.Interface
 	s3            s3.Interface
+	scanner       scanner.Interface // Nil if malware scanning is disabled
 	users         users.Interface
 	notifications notifications.Interface
 }
@@ -38,7 +42,8 @@ type Service struct {
 func New() *Service {
 	return &Service{
 		db:            graceful.NewDB(),
-		s3:            s3.New(),
+		s3:            objects.New(), // Verifies content digests
+		scanner:       scanner.New(),
 		entra:         entra.New(),
 		users:         users.New(),
 		notifications: notifications.New(),
@@ -175,8 +180,20 @@ func (s *Service) AllStudies(query QueryParams) ([]types.Study, error) {
 	if query.FuzzyTitle != nil && *query.FuzzyTitle != "" {
 		db = db.Where("title % ?", *query.FuzzyTitle)
 	}
+	if query.ReviewerUserID != nil && query.Unassigned {
+		return []types.Study{}, types.NewErrClientInvalidObjectF("cannot query by reviewer and unassigned")
+	}
+	if query.ReviewerUserID != nil {
+		db = db.Where("studies.reviewer_user_id = ?", *query.ReviewerUserID)
+	}
+	if query.Unassigned {
+		db = db.Where("studies.reviewer_user_id IS NULL")
+	}
+	if query.RiskRating != nil {
+		db = db.Where("studies.risk_rating = ?", *query.RiskRating)
+	}
 	studies := []types.Study{}
-	err := db.Preload("StudyAdmins.User").Preload("Owner").Order("last_signoff DESC, updated_at DESC").Limit(query.Limit).Offset(query.Offset).Find(&studies).Error
+	err := db.Preload("StudyAdmins.User").Preload("Owner").Preload("Reviewer").Preload("Dpia").Order("last_signoff DESC, updated_at DESC").Limit(query.Limit).Offset(query.Offset).Find(&studies).Error
 	return studies, types.NewErrFromGorm(err)
 }
 
@@ -205,7 +222,7 @@ func (s *Service) ApprovedStudies() ([]types.DSHStudyExportRecord, error) {
 // StudiesById retrieves all studies that are in a list of ids
 func (s *Service) StudiesById(ids ...uuid.UUID) ([]types.Study, error) {
 	studies := []types.Study{}
-	err := s.db.Preload("StudyAdmins.User").Preload("Owner").Preload("OwnerChangelogs").Preload("OwnerChangelogs.ToUser").Where("id IN (?)", ids).Find(&studies).Error
+	err := s.db.Preload("StudyAdmins.User").Preload("Owner").Preload("Reviewer").Preload("Dpia").Preload("OwnerChangelogs").Preload("OwnerChangelogs.ToUser").Where("id IN (?)", ids).Find(&studies).Error
 	return studies, types.NewErrFromGorm(err)
 }
 
@@ -337,12 +354,51 @@ func (s *Service) createStudy(ctx context.Context, owner types.User, studyData o
 		return err
 	}
 
+	before := studyFields(types.Study{}, []string{})
+	after := studyFields(study, usernames(studyAdminUsers))
+	if err := s.createStudyRevision(tx, owner, study.ID, before, after); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return s.commitStudyTransaction(tx, &study)
 }
 
-func (s *Service) UpdateStudyReview(ctx context.Context, id uuid.UUID, review openapi.StudyReview) error {
+func (s *Service) UpdateStudyReview(ctx context.Context, user types.User, id uuid.UUID, review openapi.StudyReview) error {
+	if isStudyClosureStatus(string(review.Status)) {
+		return types.NewErrClientInvalidObjectF("study status [%v] can only be set by closing the study", review.Status)
+	}
+
+	// Feedback is kept as a general review thread so is validated up front. Only
+	// a rejection needs the feedback addressed, otherwise the thread starts resolved
+	var thread *types.StudyReviewThread
+	if review.Feedback != nil && strings.TrimSpace(*review.Feedback) != "" {
+		data := openapi.StudyReviewThreadRequest{Field: reviewThreadFieldGeneral, Comment: *review.Feedback}
+		if err := s.validateReviewThread(id, data); err != nil {
+			return err
+		}
+		thread = new(newReviewThread(user, id, data))
+		if review.Status != openapi.StudyApprovalStatusRejected {
+			thread.ResolvedAt = new(time.Now())
+			thread.ResolvedByUserID = &user.ID
+		}
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if review.Status == openapi.StudyApprovalStatusPending {
+		if numUnresolved, err := countUnresolvedReviewThreads(tx, id); err != nil {
+			tx.Rollback()
+			return err
+		} else if numUnresolved > 0 {
+			tx.Rollback()
+			return types.NewErrClientInvalidObjectF("cannot submit study for review with %d unresolved review comment thread(s)", numUnresolved)
+		}
+	}
+
 	study := types.Study{}
-	db := s.db.Model(&study).
+	db := tx.Model(&study).
 		Clauses(clause.Returning{}).
 		Where("id = ?", id).
 		Update("approval_status", review.Status).
@@ -353,32 +409,86 @@ func (s *Service) UpdateStudyReview(ctx context.Context, id uuid.UUID, review op
 	}
 
 	if err := db.Error; err != nil {
+		tx.Rollback()
 		return types.NewErrFromGorm(err, "failed to update study review")
 	}
 	if db.RowsAffected == 0 {
+		tx.Rollback()
 		return nil // nothing changed
 	}
-	if err := s.db.Preload("Owner").Preload("StudyAdmins").Preload("StudyAdmins.User").First(&study).Error; err != nil {
+	if thread != nil {
+		if err := createReviewThread(tx, thread); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	if err := s.db.Preload("Owner").Preload("Reviewer").Preload("Dpia").Preload("StudyAdmins").Preload("StudyAdmins.User").First(&study).Error; err != nil {
 		return types.NewErrFromGorm(err, "failed to get study after update")
 	}
 
-	igOpsStaff, err := s.users.UsersWithConfigRole(rbac.IGOpsStaff)
+	reviewers, err := s.studyReviewers(study)
 	if err != nil {
 		return err
 	}
-	if err := s.notifications.NotifyStudyReview(ctx, study, igOpsStaff); err != nil {
+	if err := s.notifications.NotifyStudyReview(ctx, study, reviewers); err != nil {
 		log.Err(err).Msg("Failed to notify") // not fatal
 	}
 	return nil
 }
 
-func (s *Service) RecordStudySignoff(id uuid.UUID) error {
-	now := time.Now()
-	db := s.db.Model(&types.Study{}).Where("id = ?", id).Update("last_signoff", now)
-	return types.NewErrFromGorm(db.Error, "failed to record study signoff")
+// Record a signoff of a study by a user who was shown a version of the signoff attestation
+func (s *Service) RecordStudySignoff(ctx context.Context, user types.User, id uuid.UUID, agreementID uuid.UUID, comments *string) error {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	study := types.Study{}
+	if err := tx.Preload("Owner").Preload("StudyAdmins.User").Preload("Approvals").Where("id = ?", id).First(&study).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to get study")
+	} else if study.IsClosed() {
+		tx.Rollback()
+		return types.NewErrClientInvalidObjectF("closed studies do not require signoff")
+	}
+
+	signoff := types.StudySignoff{
+		StudyID:     study.ID,
+		UserID:      user.ID,
+		AgreementID: agreementID,
+		Comments:    comments,
+	}
+	if err := tx.Create(&signoff).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to create study signoff")
+	}
+	if err := tx.Model(&study).Update("last_signoff", signoff.CreatedAt).Error; err != nil {
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to record study signoff")
+	}
+	study.LastSignoff = &signoff.CreatedAt
+	reason := fmt.Sprintf("Study signed off on %s", signoff.CreatedAt.Format(config.DateFormat))
+	reinstated, err := reinstateStudyProjects(tx, study, reason)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	s.notifyProjectsSuspension(ctx, study, reinstated)
+	return nil
+}
+
+// Signoff history of a study, newest first
+func (s *Service) StudySignoffs(studyID uuid.UUID) ([]types.StudySignoff, error) {
+	signoffs := []types.StudySignoff{}
+	err := s.db.Preload("User").Where("study_id = ?", studyID).Order("created_at DESC").Find(&signoffs).Error
+	return signoffs, types.NewErrFromGorm(err, "failed to get study signoffs")
 }
 
-func (s *Service) UpdateStudy(ctx context.Context, id uuid.UUID, studyData openapi.StudyRequest) error {
+func (s *Service) UpdateStudy(ctx context.Context, user types.User, id uuid.UUID, studyData openapi.StudyRequest) error {
 	studies, err := s.StudiesById(id)
 	if err != nil {
 		return err
@@ -386,11 +496,15 @@ func (s *Service) UpdateStudy(ctx context.Context, id uuid.UUID, studyData opena
 		return types.NewNotFoundError("study not found")
 	}
 	study := studies[0]
+	if study.IsClosed() {
+		return types.NewErrClientInvalidObjectF("cannot update a %v study", strings.ToLower(study.ApprovalStatus))
+	}
 
 	if err := s.validateStudyData(ctx, studyData, true, study.Owner.Username); err != nil {
 		return err
 	}
 
+	before := studyFields(study, study.AdminUsernames())
 	setStudyFromStudyData(&study, studyData)
 
 	studyAdminUsers, err := s.createStudyAdminUsers(studyData)
@@ -412,6 +526,12 @@ func (s *Service) UpdateStudy(ctx context.Context, id uuid.UUID, studyData opena
 		return types.NewErrFromGorm(err, "failed to update study")
 	}
 
+	after := studyFields(study, usernames(studyAdminUsers))
+	if err := s.createStudyRevision(tx, user, study.ID, before, after); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return s.commitStudyTransaction(tx, &study)
 }
 
@@ -445,7 +565,7 @@ func (s *Service) UpdateStudyOwner(ctx context.Context, studyUUID uuid.UUID, use
 
 	changeEvent := types.StudyOwnerChangelog{
 		StudyID:    study.ID,
-		UserID:     user.ID,
+		UserID:     &user.ID,
 		FromUserID: study.OwnerUserID,
 		ToUserID:   newOwner.ID,
 		Action:     types.StudyOwnerChangelogActionRequest,
@@ -475,7 +595,7 @@ func (s *Service) notifyOwnerChange(ctx context.Context, study *types.Study) err
 	return s.notifications.NotifyOwnerChange(ctx, *study, igOpsStaff)
 }
 
-func (s *Service) ApproveStudyOwner(studyUUID uuid.UUID, user types.User, data openapi.StudyOwnerUpdate) error {
+func (s *Service) ApproveStudyOwner(ctx context.Context, studyUUID uuid.UUID, user types.User, data openapi.StudyOwnerUpdate) error {
 	tx := s.db.Begin()
 	defer graceful.RollbackTransactionOnPanic(tx)
 
@@ -485,16 +605,10 @@ func (s *Service) ApproveStudyOwner(studyUUID uuid.UUID, user types.User, data o
 		return types.NewErrFromGorm(err, "failed to get studies")
 	}
 
-	changeRequest := types.StudyOwnerChangelog{}
-	if res := tx.Preload("ToUser").Where("study_id = ? AND action = ?", studyUUID, types.StudyOwnerChangelogActionRequest).Order("created_at DESC").Limit(1).Find(&changeRequest); res.Error != nil {
-		tx.Rollback()
-		return types.NewErrFromGorm(res.Error, "failed to get study owner change log request")
-	} else if res.RowsAffected == 0 {
-		tx.Rollback()
-		return types.NewNotFoundError(fmt.Errorf("failed to find owner change request"))
-	} else if changeRequest.FromUserID != study.OwnerUserID {
+	changeRequest, err := pendingOwnerChange(tx, study)
+	if err != nil {
 		tx.Rollback()
-		return types.NewErrClientInvalidObjectF("study owner change request was stale. study owner has changed")
+		return err
 	}
 
 	oldOwner := study.Owner
@@ -512,7 +626,7 @@ func (s *Service) ApproveStudyOwner(studyUUID uuid.UUID, user types.User, data o
 
 	changeEvent := types.StudyOwnerChangelog{
 		StudyID:    study.ID,
-		UserID:     user.ID,
+		UserID:     &user.ID,
 		FromUserID: oldOwner.ID,
 		ToUserID:   newOwner.ID,
 		Action:     types.StudyOwnerChangelogActionApprove,
@@ -536,7 +650,12 @@ func (s *Service) ApproveStudyOwner(studyUUID uuid.UUID, user types.User, data o
 		return err
 	}
 
-	return commitTransaction(tx)
+	if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	changeEvent.FromUser, changeEvent.ToUser = oldOwner, *newOwner
+	s.notifyOwnerChangeOutcome(ctx, study, changeEvent)
+	return nil
 }
 
 func (s *Service) newStudyTransaction(ctx context.Context) *StudyTransaction {
@@ -560,6 +679,14 @@ func (s *Service) commitStudyTransaction(tx *StudyTransaction, study *types.Stud
 	return nil
 }
 
+func usernames(users []types.User) []string {
+	names := []string{}
+	for _, user := range users {
+		names = append(names, string(user.Username))
+	}
+	return names
+}
+
 func containsStudyAdminUser(studyAdmins []types.StudyAdmin, user types.User) bool {
 	for _, studyAdmin := range studyAdmins {
 		if studyAdmin.UserID == user.ID && !studyAdmin.IsDeleted() {
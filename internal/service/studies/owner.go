@@ -0,0 +1,148 @@
+package studies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Reject the pending owner change of a study
+func (s *Service) RejectStudyOwnerChange(ctx context.Context, studyUUID uuid.UUID, user types.User, data openapi.StudyOwnerChangeDecision) error {
+	return s.decideStudyOwnerChange(ctx, studyUUID, user, types.StudyOwnerChangelogActionReject, data)
+}
+
+// Withdraw the pending owner change of a study
+func (s *Service) CancelStudyOwnerChange(ctx context.Context, studyUUID uuid.UUID, user types.User, data openapi.StudyOwnerChangeDecision) error {
+	return s.decideStudyOwnerChange(ctx, studyUUID, user, types.StudyOwnerChangelogActionCancel, data)
+}
+
+func (s *Service) decideStudyOwnerChange(ctx context.Context, studyUUID uuid.UUID, user types.User, action types.StudyOwnerChangelogAction, data openapi.StudyOwnerChangeDecision) error {
+	if strings.TrimSpace(data.Reason) == "" {
+		return types.NewErrClientInvalidObjectF("a reason is required to %s an owner change", action)
+	}
+	study := types.Study{}
+	if err := s.db.Where("id = ?", studyUUID).First(&study).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get study")
+	}
+	request, err := pendingOwnerChange(s.db, study)
+	if err != nil {
+		return err
+	}
+	return s.closeOwnerChange(ctx, study, *request, &user.ID, action, &data.Reason)
+}
+
+// Owner change requests awaiting a decision across all studies, oldest first
+func (s *Service) PendingOwnerChanges() ([]types.StudyOwnerChangelog, error) {
+	latestIDs := s.db.Model(&types.StudyOwnerChangelog{}).
+		Select("DISTINCT ON (study_id) id").
+		Order("study_id, created_at DESC")
+
+	requests := []types.StudyOwnerChangelog{}
+	err := s.db.Preload("Study").Preload("User").Preload("FromUser").Preload("ToUser").
+		Where("id IN (?) AND action = ?", latestIDs, types.StudyOwnerChangelogActionRequest).
+		Order("created_at").
+		Find(&requests).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get pending owner changes")
+	}
+
+	pending := []types.StudyOwnerChangelog{}
+	for _, request := range requests {
+		if request.Study.ID != uuid.Nil && request.IsPending(request.Study.OwnerUserID) {
+			pending = append(pending, request)
+		}
+	}
+	return pending, nil
+}
+
+// Expire owner change requests which have been pending for longer than
+// their validity. Stops at the first failure
+func (s *Service) ExpireStaleOwnerChanges(ctx context.Context) error {
+	requests, err := s.PendingOwnerChanges()
+	if err != nil {
+		return err
+	}
+	for _, request := range requests {
+		if !isStaleOwnerChange(request, time.Now()) {
+			continue
+		}
+		log.Debug().Str("study", request.Study.Title).Msg("Expiring owner change request")
+		if err := s.closeOwnerChange(ctx, request.Study, request, nil, types.StudyOwnerChangelogActionExpire, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isStaleOwnerChange(request types.StudyOwnerChangelog, now time.Time) bool {
+	return now.Sub(request.CreatedAt) > config.StudyOwnerChangeRequestValidity
+}
+
+// Record the outcome of an owner change request which did not result in a new
+// owner. Fails if the request has since been superseded
+func (s *Service) closeOwnerChange(
+	ctx context.Context,
+	study types.Study,
+	request types.StudyOwnerChangelog,
+	userID *uuid.UUID,
+	action types.StudyOwnerChangelogAction,
+	reason *string,
+) error {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	latest, err := pendingOwnerChange(tx, study)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if latest.ID != request.ID {
+		tx.Rollback()
+		return types.NewErrClientInvalidObjectF("owner change request was superseded by another request")
+	}
+
+	changeEvent := types.StudyOwnerChangelog{
+		StudyID:    study.ID,
+		UserID:     userID,
+		FromUserID: latest.FromUserID,
+		ToUserID:   latest.ToUserID,
+		Action:     action,
+		Reason:     reason,
+	}
+	if err := tx.Create(&changeEvent).Error; err != nil { // NOTE: must not be first or create. Log is immutable
+		tx.Rollback()
+		return types.NewErrFromGorm(err, "failed to create StudyOwnerChangeLog record")
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	changeEvent.FromUser, changeEvent.ToUser = latest.FromUser, latest.ToUser
+	s.notifyOwnerChangeOutcome(ctx, study, changeEvent)
+	return nil
+}
+
+// Latest owner change request of a study, if it is still awaiting a decision
+func pendingOwnerChange(tx *gorm.DB, study types.Study) (*types.StudyOwnerChangelog, error) {
+	latest := types.StudyOwnerChangelog{}
+	if res := tx.Preload("FromUser").Preload("ToUser").Where("study_id = ?", study.ID).Order("created_at DESC").Limit(1).Find(&latest); res.Error != nil {
+		return nil, types.NewErrFromGorm(res.Error, "failed to get study owner change log")
+	} else if res.RowsAffected == 0 || !latest.IsPending(study.OwnerUserID) {
+		return nil, types.NewNotFoundError(fmt.Errorf("failed to find pending owner change request"))
+	}
+	return &latest, nil
+}
+
+func (s *Service) notifyOwnerChangeOutcome(ctx context.Context, study types.Study, change types.StudyOwnerChangelog) {
+	if err := s.notifications.NotifyOwnerChangeOutcome(ctx, study, change); err != nil {
+		log.Err(err).Msg("Failed to notify owner change outcome") // not fatal
+	}
+}
@@ -0,0 +1,21 @@
+package studies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestIsStaleOwnerChange(t *testing.T) {
+	now := time.Now()
+	request := types.StudyOwnerChangelog{Action: types.StudyOwnerChangelogActionRequest}
+
+	request.CreatedAt = now.Add(-config.Day)
+	assert.False(t, isStaleOwnerChange(request, now))
+
+	request.CreatedAt = now.Add(-config.StudyOwnerChangeRequestValidity - time.Minute)
+	assert.True(t, isStaleOwnerChange(request, now))
+}
@@ -0,0 +1,238 @@
+package studies
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/spreadsheet"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+const assetRegisterSheetName = "Information Asset Register"
+
+var (
+	assetRegisterHeader = []string{
+		"Caseref", "Study ID", "Study title", "Owner", "Asset ID", "Asset title", "Tier", "Status",
+		"Classification impact", "Legal basis", "Legal basis special", "Locations", "Data types",
+		"Contracts", "Expires at",
+	}
+
+	assetRegisterContentTypes = map[types.AssetRegisterFormat]string{
+		types.AssetRegisterFormatCSV:  "text/csv",
+		types.AssetRegisterFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		types.AssetRegisterFormatJSON: "application/json",
+	}
+)
+
+// Filters applied to the assets in the register
+type AssetRegisterFilter struct {
+	Status *types.AssetStatus
+	Tier   *int
+}
+
+// Rendered asset register
+type AssetRegisterFile struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	NumAssets   int
+}
+
+// Every asset of all studies as entries of the information asset register,
+// ordered by study caseref then asset title
+func (s *Service) AssetRegister(filter AssetRegisterFilter) ([]openapi.AssetRegisterEntry, error) {
+	query := s.db.Preload("Study.Owner").
+		Preload("Locations.Environment").
+		Preload("Locations.Project").
+		Preload("DataTypes").
+		Preload("Contracts").
+		Joins("JOIN studies ON studies.id = assets.study_id AND studies.deleted_at IS NULL")
+	if filter.Status != nil {
+		query = query.Where("assets.status = ?", *filter.Status)
+	}
+	if filter.Tier != nil {
+		query = query.Where("assets.tier = ?", *filter.Tier)
+	}
+	assets := []types.Asset{}
+	if err := query.Order("studies.caseref, assets.title").Find(&assets).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get assets")
+	}
+
+	entries := []openapi.AssetRegisterEntry{}
+	for _, asset := range assets {
+		entries = append(entries, assetRegisterEntry(asset))
+	}
+	return entries, nil
+}
+
+// Render the asset register in a format
+func (s *Service) ExportAssetRegister(filter AssetRegisterFilter, format types.AssetRegisterFormat) (*AssetRegisterFile, error) {
+	contentType, exists := assetRegisterContentTypes[format]
+	if !exists {
+		return nil, types.NewErrClientInvalidObjectF("format must be one of: csv, xlsx, json")
+	}
+	entries, err := s.AssetRegister(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+	switch format {
+	case types.AssetRegisterFormatCSV:
+		content, err = spreadsheet.WriteCSV(assetRegisterSheet(entries))
+	case types.AssetRegisterFormatXLSX:
+		content, err = spreadsheet.WriteXLSX(assetRegisterSheet(entries))
+	case types.AssetRegisterFormatJSON:
+		content, err = json.Marshal(entries)
+	}
+	if err != nil {
+		return nil, types.NewErrServerError(fmt.Errorf("failed to write asset register: %w", err))
+	}
+	return &AssetRegisterFile{
+		Filename:    fmt.Sprintf("asset-register-%s.%s", time.Now().Format(config.DateFormat), format),
+		ContentType: contentType,
+		Content:     content,
+		NumAssets:   len(entries),
+	}, nil
+}
+
+// Store an export of the whole asset register in S3
+func (s *Service) StoreAssetRegisterExport(ctx context.Context, format types.AssetRegisterFormat) (*types.AssetRegisterExport, error) {
+	file, err := s.ExportAssetRegister(AssetRegisterFilter{}, format)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Str("filename", file.Filename).Int("numAssets", file.NumAssets).Msg("Storing asset register export")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	export := types.AssetRegisterExport{
+		Format:    format,
+		Filename:  file.Filename,
+		NumAssets: file.NumAssets,
+	}
+	if err := tx.Create(&export).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create asset register export")
+	}
+
+	object := types.S3Object{
+		Content:  io.NopCloser(bytes.NewReader(file.Content)),
+		NumBytes: new(int64(len(file.Content))),
+	}
+	if err := s.s3.StoreObject(ctx, assetRegisterExportMetadata(export.ID), object); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.commitTransactionWithObject(tx, assetRegisterExportMetadata(export.ID)); err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// Stored exports of the asset register, most recent first
+func (s *Service) AssetRegisterExports() ([]types.AssetRegisterExport, error) {
+	exports := []types.AssetRegisterExport{}
+	if err := s.db.Order("created_at DESC").Find(&exports).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset register exports")
+	}
+	return exports, nil
+}
+
+func (s *Service) GetAssetRegisterExport(ctx context.Context, exportID uuid.UUID) (*types.AssetRegisterExport, types.S3Object, error) {
+	export := types.AssetRegisterExport{}
+	result := s.db.Where("id = ?", exportID).Limit(1).Find(&export)
+	if result.Error != nil {
+		return nil, types.S3Object{}, types.NewErrFromGorm(result.Error, "failed to get asset register export")
+	} else if result.RowsAffected == 0 {
+		return nil, types.S3Object{}, types.NewNotFoundError(fmt.Errorf("asset register export [%v] not found", exportID))
+	}
+	object, err := s.s3.GetObject(ctx, assetRegisterExportMetadata(export.ID))
+	return &export, object, err
+}
+
+func assetRegisterEntry(asset types.Asset) openapi.AssetRegisterEntry {
+	entry := openapi.AssetRegisterEntry{
+		Caseref:              asset.Study.Caseref,
+		StudyId:              asset.StudyID.String(),
+		StudyTitle:           asset.Study.Title,
+		OwnerUsername:        string(asset.Study.Owner.Username),
+		AssetId:              asset.ID.String(),
+		AssetTitle:           asset.Title,
+		Tier:                 asset.Tier,
+		Status:               string(asset.Status),
+		ClassificationImpact: asset.ClassificationImpact,
+		LegalBasis:           asset.LegalBasis,
+		LegalBasisSpecial:    asset.LegalBasisSpecial,
+		Locations:            []string{},
+		DataTypes:            []string{},
+		Contracts:            []string{},
+	}
+	for _, location := range asset.Locations {
+		entry.Locations = append(entry.Locations, location.Name())
+	}
+	for _, dataType := range asset.DataTypes {
+		entry.DataTypes = append(entry.DataTypes, dataType.Name)
+	}
+	for _, contract := range asset.Contracts {
+		entry.Contracts = append(entry.Contracts, contract.Title)
+	}
+	if asset.ExpiresAt != nil {
+		entry.ExpiresAt = new(asset.ExpiresAt.Format(config.DateFormat))
+	}
+	return entry
+}
+
+// Register as a sheet with a header row. Multiple values in a cell are separated by semicolons
+func assetRegisterSheet(entries []openapi.AssetRegisterEntry) spreadsheet.Sheet {
+	rows := [][]string{assetRegisterHeader}
+	for _, entry := range entries {
+		rows = append(rows, []string{
+			strconv.Itoa(entry.Caseref),
+			entry.StudyId,
+			entry.StudyTitle,
+			entry.OwnerUsername,
+			entry.AssetId,
+			entry.AssetTitle,
+			strconv.Itoa(entry.Tier),
+			entry.Status,
+			entry.ClassificationImpact,
+			valueOrEmpty(entry.LegalBasis),
+			valueOrEmpty(entry.LegalBasisSpecial),
+			strings.Join(entry.Locations, "; "),
+			strings.Join(entry.DataTypes, "; "),
+			strings.Join(entry.Contracts, "; "),
+			valueOrEmpty(entry.ExpiresAt),
+		})
+	}
+	return spreadsheet.Sheet{Name: assetRegisterSheetName, Rows: rows}
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func assetRegisterExportMetadata(exportID uuid.UUID) s3.ObjectMetadata {
+	return s3.ObjectMetadata{
+		Id:   exportID,
+		Kind: s3.AssetRegisterKind,
+	}
+}
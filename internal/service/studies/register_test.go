@@ -0,0 +1,54 @@
+package studies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestAssetRegisterSheet(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	asset := types.Asset{
+		StudyID:              uuid.New(),
+		Title:                "=cmd",
+		Tier:                 2,
+		Status:               types.AssetStatusActive,
+		ClassificationImpact: "confidential",
+		LegalBasis:           new("consent"),
+		ExpiresAt:            &expiresAt,
+		Study:                types.Study{Title: "study", Caseref: 10001, Owner: types.User{Username: "owner@example.com"}},
+		Locations: []types.AssetLocation{
+			{Kind: types.AssetLocationKindOther, Location: "UK"},
+			{Kind: types.AssetLocationKindProject, Location: "old", Project: &types.Project{Name: "project"}},
+		},
+		DataTypes: []types.AssetDataType{{Name: "personal"}, {Name: "research"}},
+		Contracts: []types.Contract{{Title: "DSA"}},
+	}
+
+	entry := assetRegisterEntry(asset)
+	assert.Equal(t, 10001, entry.Caseref)
+	assert.Equal(t, "owner@example.com", entry.OwnerUsername)
+	assert.Equal(t, []string{"UK", "project"}, entry.Locations)
+	assert.Equal(t, []string{"DSA"}, entry.Contracts)
+	assert.Equal(t, "2030-01-02", *entry.ExpiresAt)
+	assert.Nil(t, entry.LegalBasisSpecial)
+
+	sheet := assetRegisterSheet(nil)
+	assert.Equal(t, [][]string{assetRegisterHeader}, sheet.Rows)
+
+	sheet = assetRegisterSheet([]openapi.AssetRegisterEntry{entry})
+	require.Len(t, sheet.Rows, 2)
+	row := sheet.Rows[1]
+	assert.Len(t, row, len(assetRegisterHeader))
+	assert.Equal(t, "10001", row[0])
+	assert.Equal(t, "=cmd", row[5]) // escaped when written
+	assert.Equal(t, "", row[10])
+	assert.Equal(t, "UK; project", row[11])
+	assert.Equal(t, "personal; research", row[12])
+	assert.Equal(t, "2030-01-02", row[14])
+}
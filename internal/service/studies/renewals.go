@@ -0,0 +1,93 @@
+package studies
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Renew a contract by creating a successor pre-filled with its terms and
+// assets. The successor is pending until it is made active, at which point
+// the renewed contract is closed
+func (s *Service) RenewContract(studyID uuid.UUID, contractID uuid.UUID, creator types.User) (*types.Contract, error) {
+	log.Debug().Any("contractId", contractID).Msg("Renewing contract")
+
+	predecessor, err := s.GetContract(studyID, contractID)
+	if err != nil {
+		return nil, err
+	} else if predecessor.Status != types.ContractStatusActive {
+		return nil, types.NewErrClientInvalidObjectF("Only active contracts can be renewed")
+	} else if predecessor.IsRenewed() {
+		return nil, types.NewErrClientInvalidObjectF("Contract is already being renewed")
+	}
+
+	successor := types.Contract{
+		StudyID:               studyID,
+		CreatorUserID:         creator.ID,
+		SignatoryUserId:       predecessor.SignatoryUserId,
+		Title:                 predecessor.Title,
+		ThirdPartyName:        predecessor.ThirdPartyName,
+		OtherSignatories:      predecessor.OtherSignatories,
+		Status:                types.ContractStatusPending,
+		StartDate:             predecessor.ExpiryDate,
+		ExpiryDate:            renewedExpiryDate(*predecessor),
+		RetentionEndDate:      predecessor.RetentionEndDate,
+		PredecessorContractID: &predecessor.ID,
+		Assets:                predecessor.Assets,
+	}
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	if err := tx.Omit("Assets.*").Create(&successor).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to create contract renewal")
+	}
+
+	if err := ensureOriginalContractVersion(tx, successor); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return s.GetContract(studyID, successor.ID)
+}
+
+// Expiry of a renewal, keeping the term of the renewed contract
+func renewedExpiryDate(predecessor types.Contract) *time.Time {
+	if predecessor.StartDate == nil || predecessor.ExpiryDate == nil {
+		return nil
+	}
+	term := predecessor.ExpiryDate.Sub(*predecessor.StartDate)
+	return new(predecessor.ExpiryDate.Add(term))
+}
+
+// Close the contract renewed by a contract, once the renewal is active
+func closeRenewedContract(tx *gorm.DB, contractID uuid.UUID) error {
+	contract := types.Contract{}
+	if err := tx.Select("id", "status", "predecessor_contract_id").Where("id = ?", contractID).First(&contract).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get contract")
+	} else if contract.Status != types.ContractStatusActive || contract.PredecessorContractID == nil {
+		return nil
+	}
+
+	predecessorID := *contract.PredecessorContractID
+	err := tx.Model(&types.Contract{}).
+		Where("id = ? AND (status IS NULL OR status != ?)", predecessorID, types.ContractStatusClosed).
+		Update("status", types.ContractStatusClosed).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to close renewed contract")
+	}
+
+	// Keep the current terms of the renewed contract in step
+	err = tx.Model(&types.ContractVersion{}).
+		Where("contract_id = ? AND version = (SELECT version FROM contracts WHERE id = ?)", predecessorID, predecessorID).
+		Update("contract_status", types.ContractStatusClosed).Error
+	return types.NewErrFromGorm(err, "failed to update renewed contract version")
+}
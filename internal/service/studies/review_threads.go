@@ -0,0 +1,131 @@
+package studies
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"github.com/ucl-arc-tre/portal/internal/validation"
+	"gorm.io/gorm"
+)
+
+const (
+	reviewThreadFieldGeneral = "general" // Thread about the study as a whole rather than a single field
+)
+
+// All review threads of a study including resolved ones, oldest first
+func (s *Service) StudyReviewThreads(studyID uuid.UUID) ([]types.StudyReviewThread, error) {
+	threads := []types.StudyReviewThread{}
+	err := s.db.Preload("CreatorUser").
+		Preload("ResolvedByUser").
+		Preload("Comments", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Comments.User").
+		Where("study_id = ?", studyID).
+		Order("created_at ASC").
+		Find(&threads).Error
+	return threads, types.NewErrFromGorm(err, "failed to get study review threads")
+}
+
+func (s *Service) validateReviewThread(studyID uuid.UUID, data openapi.StudyReviewThreadRequest) error {
+	if !validation.ReviewCommentPattern.MatchString(data.Comment) {
+		return types.NewErrClientInvalidObjectF("comment must be 1-1000 characters")
+	}
+	if data.AssetId == nil {
+		studyFieldNames := []string{reviewThreadFieldGeneral}
+		for _, field := range studyFields(types.Study{}, []string{}) {
+			studyFieldNames = append(studyFieldNames, field.Name)
+		}
+		if !slices.Contains(studyFieldNames, data.Field) {
+			return types.NewErrClientInvalidObjectF("unknown study field [%v]", data.Field)
+		}
+		return nil
+	}
+	if !validation.FieldNamePattern.MatchString(data.Field) {
+		return types.NewErrClientInvalidObjectF("invalid asset field [%v]", data.Field)
+	}
+	assetID, err := uuid.Parse(*data.AssetId)
+	if err != nil {
+		return types.NewErrClientInvalidObjectF("invalid asset id")
+	}
+	return s.checkAssetExists(studyID, assetID)
+}
+
+// Start a new review thread with an initial comment
+func (s *Service) CreateReviewThread(user types.User, studyID uuid.UUID, data openapi.StudyReviewThreadRequest) (*types.StudyReviewThread, error) {
+	if err := s.validateReviewThread(studyID, data); err != nil {
+		return nil, err
+	}
+	thread := newReviewThread(user, studyID, data)
+	if err := createReviewThread(s.db, &thread); err != nil {
+		return nil, err
+	}
+	return &thread, nil
+}
+
+// Review thread started by a user with an initial comment
+func newReviewThread(user types.User, studyID uuid.UUID, data openapi.StudyReviewThreadRequest) types.StudyReviewThread {
+	thread := types.StudyReviewThread{
+		StudyID:       studyID,
+		CreatorUserID: user.ID,
+		Field:         data.Field,
+		Comments:      []types.StudyReviewComment{{UserID: user.ID, Body: data.Comment}},
+	}
+	if data.AssetId != nil {
+		thread.AssetID = new(uuid.MustParse(*data.AssetId))
+	}
+	return thread
+}
+
+// Create a validated review thread, within a transaction if required
+func createReviewThread(tx *gorm.DB, thread *types.StudyReviewThread) error {
+	return types.NewErrFromGorm(tx.Create(thread).Error, "failed to create review thread")
+}
+
+// Add a reply to an unresolved review thread
+func (s *Service) CreateReviewComment(user types.User, studyID uuid.UUID, threadID uuid.UUID, data openapi.StudyReviewCommentRequest) error {
+	if !validation.ReviewCommentPattern.MatchString(data.Comment) {
+		return types.NewErrClientInvalidObjectF("comment must be 1-1000 characters")
+	}
+	thread, err := s.reviewThread(studyID, threadID)
+	if err != nil {
+		return err
+	} else if thread.IsResolved() {
+		return types.NewErrClientInvalidObjectF("cannot comment on a resolved review thread")
+	}
+	comment := types.StudyReviewComment{
+		ThreadID: thread.ID,
+		UserID:   user.ID,
+		Body:     data.Comment,
+	}
+	return types.NewErrFromGorm(s.db.Create(&comment).Error, "failed to create review comment")
+}
+
+func (s *Service) ResolveReviewThread(user types.User, studyID uuid.UUID, threadID uuid.UUID) error {
+	thread, err := s.reviewThread(studyID, threadID)
+	if err != nil {
+		return err
+	} else if thread.IsResolved() {
+		return nil // nothing to do
+	}
+	err = s.db.Model(&thread).Updates(map[string]any{
+		"resolved_at":         time.Now(),
+		"resolved_by_user_id": user.ID,
+	}).Error
+	return types.NewErrFromGorm(err, "failed to resolve review thread")
+}
+
+func (s *Service) reviewThread(studyID uuid.UUID, threadID uuid.UUID) (types.StudyReviewThread, error) {
+	thread := types.StudyReviewThread{}
+	err := s.db.Where("id = ? AND study_id = ?", threadID, studyID).First(&thread).Error
+	return thread, types.NewErrFromGorm(err, "failed to get review thread")
+}
+
+func countUnresolvedReviewThreads(db *gorm.DB, studyID uuid.UUID) (int64, error) {
+	var count int64
+	err := db.Model(&types.StudyReviewThread{}).
+		Where("study_id = ? AND resolved_at IS NULL", studyID).
+		Count(&count).Error
+	return count, types.NewErrFromGorm(err, "failed to count unresolved review threads")
+}
@@ -0,0 +1,84 @@
+package studies
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+// Claim the review of a pending study for the user. Fails if another reviewer is already assigned
+func (s *Service) ClaimStudyReview(user types.User, studyID uuid.UUID) error {
+	result := s.db.Model(&types.Study{}).
+		Where("id = ? AND approval_status = ? AND (reviewer_user_id IS NULL OR reviewer_user_id = ?)", studyID, types.StudyApprovalStatusPending, user.ID).
+		Update("reviewer_user_id", user.ID)
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to claim study review")
+	} else if result.RowsAffected > 0 {
+		return nil
+	}
+
+	study := types.Study{}
+	if err := s.db.Preload("Reviewer").Where("id = ?", studyID).First(&study).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get study")
+	} else if study.ApprovalStatus != types.StudyApprovalStatusPending {
+		return types.NewErrClientInvalidObjectF("only pending studies can be reviewed. study is [%v]", study.ApprovalStatus)
+	}
+	return types.NewErrClientInvalidObjectF("study is already being reviewed by [%v]", study.Reviewer.Username)
+}
+
+// Assign, or reassign, the review of a pending study to a named IG ops staff member
+func (s *Service) AssignStudyReviewer(ctx context.Context, assigner types.User, studyID uuid.UUID, data openapi.StudyReviewerUpdate) error {
+	igOpsStaff, err := s.users.UsersWithConfigRole(rbac.IGOpsStaff)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(igOpsStaff, func(u types.User) bool { return u.Username == types.Username(data.Username) })
+	if idx < 0 {
+		return types.NewErrClientInvalidObjectF("reviewer [%v] must be an IG ops staff member", data.Username)
+	}
+	reviewer := igOpsStaff[idx]
+
+	study := types.Study{}
+	if err := s.db.Where("id = ?", studyID).First(&study).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get study")
+	} else if study.ApprovalStatus != types.StudyApprovalStatusPending {
+		return types.NewErrClientInvalidObjectF("only pending studies can be reviewed. study is [%v]", study.ApprovalStatus)
+	} else if study.ReviewerUserID != nil && *study.ReviewerUserID == reviewer.ID {
+		return nil // nothing changed
+	}
+
+	if err := s.db.Model(&study).Update("reviewer_user_id", reviewer.ID).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to assign study reviewer")
+	}
+
+	if reviewer.ID != assigner.ID {
+		if err := s.notifications.NotifyReviewerAssignment(ctx, study, reviewer); err != nil {
+			log.Err(err).Msg("Failed to notify reviewer assignment") // not fatal
+		}
+	}
+	return nil
+}
+
+// Release the review of a study so that it can be claimed by anyone
+func (s *Service) UnassignStudyReviewer(studyID uuid.UUID) error {
+	result := s.db.Model(&types.Study{}).Where("id = ?", studyID).Update("reviewer_user_id", nil)
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to unassign study reviewer")
+	} else if result.RowsAffected == 0 {
+		return types.NewNotFoundError("study not found")
+	}
+	return nil
+}
+
+// Users to notify of a study review. Only the assigned reviewer, once claimed
+func (s *Service) studyReviewers(study types.Study) ([]types.User, error) {
+	if study.Reviewer != nil {
+		return []types.User{*study.Reviewer}, nil
+	}
+	return s.users.UsersWithConfigRole(rbac.IGOpsStaff)
+}
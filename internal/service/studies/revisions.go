@@ -0,0 +1,161 @@
+package studies
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+type studyField struct {
+	Name  string // JSON name as in /api/web.yaml
+	Value *string
+}
+
+// Snapshot of the user editable fields of a study, used to compute revisions
+func studyFields(study types.Study, adminUsernames []string) []studyField {
+	return []studyField{
+		{Name: "title", Value: stringOrNil(study.Title)},
+		{Name: "description", Value: study.Description},
+		{Name: "data_controller_organisation", Value: stringOrNil(study.DataControllerOrganisation)},
+		{Name: "additional_study_admin_usernames", Value: usernamesOrNil(adminUsernames)},
+		{Name: "involves_ucl_sponsorship", Value: boolOrNil(study.InvolvesUclSponsorship)},
+		{Name: "involves_cag", Value: boolOrNil(study.InvolvesCag)},
+		{Name: "cag_reference", Value: study.CagReference},
+		{Name: "involves_ethics_approval", Value: boolOrNil(study.InvolvesEthicsApproval)},
+		{Name: "involves_hra_approval", Value: boolOrNil(study.InvolvesHraApproval)},
+		{Name: "iras_id", Value: study.IrasId},
+		{Name: "is_nhs_associated", Value: boolOrNil(study.IsNhsAssociated)},
+		{Name: "involves_nhs_england", Value: boolOrNil(study.InvolvesNhsEngland)},
+		{Name: "nhs_england_reference", Value: study.NhsEnglandReference},
+		{Name: "involves_mnca", Value: boolOrNil(study.InvolvesMnca)},
+		{Name: "requires_dspt", Value: boolOrNil(study.RequiresDspt)},
+		{Name: "requires_dbs", Value: boolOrNil(study.RequiresDbs)},
+		{Name: "is_data_protection_office_registered", Value: boolOrNil(study.IsDataProtectionOfficeRegistered)},
+		{Name: "data_protection_number", Value: study.DataProtectionNumber},
+		{Name: "involves_third_party", Value: boolOrNil(study.InvolvesThirdParty)},
+		{Name: "involves_external_users", Value: boolOrNil(study.InvolvesExternalUsers)},
+		{Name: "involves_participant_consent", Value: boolOrNil(study.InvolvesParticipantConsent)},
+		{Name: "involves_indirect_data_collection", Value: boolOrNil(study.InvolvesIndirectDataCollection)},
+		{Name: "involves_data_processing_outside_eea", Value: boolOrNil(study.InvolvesDataProcessingOutsideEea)},
+	}
+}
+
+// Field level changes between two snapshots. Snapshots must list the same fields in the same order
+func diffStudyFields(before []studyField, after []studyField) []types.StudyRevisionChange {
+	changes := []types.StudyRevisionChange{}
+	for i, field := range after {
+		oldValue := before[i].Value
+		if !equalOptionalStrings(oldValue, field.Value) {
+			changes = append(changes, types.StudyRevisionChange{
+				Field:    field.Name,
+				OldValue: oldValue,
+				NewValue: field.Value,
+			})
+		}
+	}
+	return changes
+}
+
+// Combine the changes of consecutive revisions (oldest first) into a single set of changes,
+// keeping the first old value and last new value of each field
+func mergeRevisionChanges(revisions []types.StudyRevision) []types.StudyRevisionChange {
+	merged := []types.StudyRevisionChange{}
+	indexByField := map[string]int{}
+	for _, revision := range revisions {
+		for _, change := range revision.Changes {
+			if i, exists := indexByField[change.Field]; exists {
+				merged[i].NewValue = change.NewValue
+				continue
+			}
+			indexByField[change.Field] = len(merged)
+			merged = append(merged, types.StudyRevisionChange{
+				Field:    change.Field,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+			})
+		}
+	}
+	// Fields may have been changed and then changed back
+	return slices.DeleteFunc(merged, func(change types.StudyRevisionChange) bool {
+		return equalOptionalStrings(change.OldValue, change.NewValue)
+	})
+}
+
+// Record a revision if any of the fields have changed. Must be called within the transaction saving the study
+func (s *Service) createStudyRevision(tx *StudyTransaction, user types.User, studyID uuid.UUID, before []studyField, after []studyField) error {
+	changes := diffStudyFields(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+	revision := types.StudyRevision{
+		StudyID: studyID,
+		UserID:  user.ID,
+		Changes: changes,
+	}
+	if err := tx.db.Create(&revision).Error; err != nil { // NOTE: must not be first or create. Log is immutable
+		return types.NewErrFromGorm(err, "failed to create study revision")
+	}
+	return nil
+}
+
+// All revisions of a study, oldest first
+func (s *Service) StudyRevisions(studyID uuid.UUID) ([]types.StudyRevision, error) {
+	revisions := []types.StudyRevision{}
+	err := s.db.Preload("User").Preload("Changes").
+		Where("study_id = ?", studyID).
+		Order("created_at ASC").
+		Find(&revisions).Error
+	return revisions, types.NewErrFromGorm(err, "failed to get study revisions")
+}
+
+// Changes made to a study after the from revision, up to and including the to revision
+func (s *Service) StudyRevisionsDiff(studyID uuid.UUID, fromRevisionID uuid.UUID, toRevisionID uuid.UUID) ([]types.StudyRevisionChange, error) {
+	revisions, err := s.StudyRevisions(studyID)
+	if err != nil {
+		return nil, err
+	}
+	isRevision := func(id uuid.UUID) func(types.StudyRevision) bool {
+		return func(r types.StudyRevision) bool { return r.ID == id }
+	}
+	fromIdx := slices.IndexFunc(revisions, isRevision(fromRevisionID))
+	toIdx := slices.IndexFunc(revisions, isRevision(toRevisionID))
+	if fromIdx < 0 || toIdx < 0 {
+		return nil, types.NewNotFoundError("study revision not found")
+	} else if fromIdx > toIdx {
+		return nil, types.NewErrClientInvalidObjectF("from revision must not be after the to revision")
+	}
+	return mergeRevisionChanges(revisions[fromIdx+1 : toIdx+1]), nil
+}
+
+func equalOptionalStrings(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func stringOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func boolOrNil(value *bool) *string {
+	if value == nil {
+		return nil
+	}
+	return new(strconv.FormatBool(*value))
+}
+
+func usernamesOrNil(names []string) *string {
+	if len(names) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(names)
+	slices.Sort(sorted)
+	return new(strings.Join(sorted, ", "))
+}
@@ -0,0 +1,54 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestDiffStudyFields(t *testing.T) {
+	before := types.Study{Title: "Study", InvolvesCag: new(false)}
+	after := types.Study{Title: "Study", InvolvesCag: new(true), CagReference: new("22/CAG/0001")}
+
+	changes := diffStudyFields(studyFields(before, []string{"b@example.com"}), studyFields(after, []string{"a@example.com", "b@example.com"}))
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, "additional_study_admin_usernames", changes[0].Field)
+	assert.Equal(t, "b@example.com", *changes[0].OldValue)
+	assert.Equal(t, "a@example.com, b@example.com", *changes[0].NewValue)
+
+	assert.Equal(t, "involves_cag", changes[1].Field)
+	assert.Equal(t, "false", *changes[1].OldValue)
+	assert.Equal(t, "true", *changes[1].NewValue)
+
+	assert.Equal(t, "cag_reference", changes[2].Field)
+	assert.Nil(t, changes[2].OldValue)
+	assert.Equal(t, "22/CAG/0001", *changes[2].NewValue)
+
+	assert.Empty(t, diffStudyFields(studyFields(after, []string{}), studyFields(after, []string{})))
+}
+
+func TestMergeRevisionChanges(t *testing.T) {
+	revisions := []types.StudyRevision{
+		{Changes: []types.StudyRevisionChange{
+			{Field: "title", OldValue: new("a"), NewValue: new("b")},
+			{Field: "description", OldValue: nil, NewValue: new("x")},
+		}},
+		{Changes: []types.StudyRevisionChange{
+			{Field: "title", OldValue: new("b"), NewValue: new("c")},
+		}},
+		{Changes: []types.StudyRevisionChange{
+			{Field: "description", OldValue: new("x"), NewValue: nil},
+		}},
+	}
+
+	changes := mergeRevisionChanges(revisions)
+	require.Len(t, changes, 1) // description was set then unset
+	assert.Equal(t, "title", changes[0].Field)
+	assert.Equal(t, "a", *changes[0].OldValue)
+	assert.Equal(t, "c", *changes[0].NewValue)
+
+	assert.Empty(t, mergeRevisionChanges([]types.StudyRevision{}))
+}
@@ -0,0 +1,322 @@
+package studies
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+const (
+	maxLikelihoodScore = 4 // to align with the IG likelihood scale
+	maxImpactScore     = 4
+	maxAssetImpact     = 6 // classification + protection + major disruption/financial/reputational
+)
+
+// Relative likelihood of a data leak from each storage location. See https://github.com/ucl-arc-tre/portal/issues/866
+var locationLikelihoods = map[string]int{
+	"arc_tre":                              1,
+	"courier_service_secured":              2,
+	"data_controller_external":             2,
+	"data_entry":                           6,
+	"data_safe_haven":                      1,
+	"data_safe_haven_applications":         1,
+	"data_safe_haven_file_transfer":        1,
+	"desktop_ucl_staff":                    2,
+	"dfe_network":                          1,
+	"email":                                3,
+	"fax":                                  3,
+	"galaxkey":                             2,
+	"handheld_recording_secured":           3,
+	"handheld_recording_unsecured":         6,
+	"internet_download_upload_secured":     3,
+	"internet_download_upload_unsecured":   6,
+	"laptop_pc_partially_secured":          3,
+	"laptop_pc_secured":                    3,
+	"laptop_pc_security_unconfirmed":       3,
+	"microsoft_365":                        2,
+	"network_file_storage":                 2,
+	"network_transfer":                     2,
+	"nhs_mail":                             3,
+	"nhs_digital":                          1,
+	"nhs_it":                               1,
+	"nhs_site":                             1,
+	"online_database_secured":              3,
+	"online_database_partially_secured":    3,
+	"online_database_security_unconfirmed": 3,
+	"openclinica":                          2,
+	"other_location":                       6,
+	"phone_call":                           3,
+	"phone_tablet_partially_secured":       3,
+	"phone_tablet_secured":                 3,
+	"phone_tablet_security_unconfirmed":    3,
+	"printer_scanner":                      6,
+	"public_health_england":                2,
+	"redcap_data_safe_haven":               1,
+	"research_participant_home_device":     2,
+	"royal_mail_secured":                   2,
+	"secure_physical_storage":              2,
+	"staff_carries_items":                  2,
+	"standard_postage_courier":             3,
+	"third_party_dspt":                     2,
+	"text_sms":                             3,
+	"royal_mail_application_support":       1,
+	"usb_portable_encrypted":               3,
+	"usb_portable_unencrypted":             6,
+}
+
+var maxLocationLikelihood = func() int {
+	maxLikelihood := 0
+	for _, likelihood := range locationLikelihoods {
+		maxLikelihood = max(maxLikelihood, likelihood)
+	}
+	return maxLikelihood
+}()
+
+type AssetRisk struct {
+	MinimumTier int
+	Score       int      // 0 to maxImpactScore*maxLikelihoodScore
+	Reasons     []string // Why the minimum tier is required
+}
+
+// Derive the minimum tier and risk score of an asset from its answers.
+// See: https://isms.arc.ucl.ac.uk/rism06-data_classification_and_environment_tiering_policy/
+func DeriveAssetRisk(asset types.Asset) AssetRisk {
+	return AssetRisk{
+		MinimumTier: assetMinimumTier(asset),
+		Score:       assetRiskScore(asset),
+		Reasons:     assetMinimumTierReasons(asset),
+	}
+}
+
+func assetMinimumTier(asset types.Asset) int {
+	switch asset.ClassificationImpact {
+	case string(openapi.AssetClassificationImpactPublic):
+		return 0
+	case string(openapi.AssetClassificationImpactConfidential):
+		return 1
+	}
+	if isHighImpactAsset(asset) {
+		if isTrue(asset.HasTargetedThreatActors) {
+			return 4
+		}
+		return 3
+	}
+	if assetHasDataType(asset, openapi.AssetDataTypesPersonal) && isStronglyProtected(asset) {
+		return 2
+	}
+	return 3
+}
+
+func assetMinimumTierReasons(asset types.Asset) []string {
+	switch asset.ClassificationImpact {
+	case string(openapi.AssetClassificationImpactPublic):
+		return []string{}
+	case string(openapi.AssetClassificationImpactConfidential):
+		return []string{"confidential data requires at least tier 1"}
+	}
+
+	reasons := []string{}
+	if isMajorImpactOnLeak(asset) {
+		reasons = append(reasons, "disclosure would cause major disruption, financial loss or reputational damage")
+	}
+	if assetHasDataType(asset, openapi.AssetDataTypesSpecialCategoryPersonal) {
+		reasons = append(reasons, "contains special category personal data")
+	}
+	if isTrue(asset.RequiresTre) {
+		reasons = append(reasons, "must be held in an ISO27001 certified TRE")
+	}
+	if len(reasons) > 0 {
+		if isTrue(asset.HasTargetedThreatActors) {
+			reasons = append(reasons, "has targeted threat actors, requiring tier 4")
+		}
+		return reasons
+	}
+	if assetHasDataType(asset, openapi.AssetDataTypesPersonal) && isStronglyProtected(asset) {
+		return []string{"highly confidential personal data which has been anonymised or pseudonymised requires at least tier 2"}
+	}
+	return []string{"highly confidential data without anonymised or pseudonymised personal data requires at least tier 3"}
+}
+
+func assetRiskScore(asset types.Asset) int {
+	impact := 0
+	switch asset.ClassificationImpact {
+	case string(openapi.AssetClassificationImpactConfidential), string(openapi.AssetClassificationImpactHighlyConfidential):
+		impact += 1
+	}
+	if asset.Protection != nil {
+		switch openapi.AssetProtection(*asset.Protection) {
+		case openapi.AssetProtectionPseudonymisation:
+			impact += 1
+		case openapi.AssetProtectionIdentifiableLowConfidencePseudonymisation:
+			impact += 2
+		}
+	}
+	for _, isMajor := range []*bool{asset.IsLeakMajorDisruption, asset.IsLeakMajorFinancialLoss, asset.IsLeakMajorReputationalDamage} {
+		if isTrue(isMajor) {
+			impact += 1
+		}
+	}
+
+	likelihood := 0
+	for _, location := range asset.Locations {
+		likelihood = max(likelihood, locationLikelihood(location))
+	}
+	if asset.StoredOutsideUkEea {
+		likelihood += 1
+	}
+
+	// Both are relative so normalise to the IG scales
+	normalisedImpact := float64(impact) * maxImpactScore / maxAssetImpact
+	normalisedLikelihood := float64(min(likelihood, maxLocationLikelihood)) * maxLikelihoodScore / float64(maxLocationLikelihood)
+	return int(math.Round(normalisedImpact * normalisedLikelihood))
+}
+
+// Likelihood of a leak from a location. Portal environments and projects are
+// as likely as the trusted research environment
+func locationLikelihood(location types.AssetLocation) int {
+	switch location.Kind {
+	case types.AssetLocationKindEnvironment, types.AssetLocationKindProject:
+		return locationLikelihoods["arc_tre"]
+	default:
+		return locationLikelihoods[location.Location]
+	}
+}
+
+// Reject assets with a declared tier lower than the one derived from its answers
+func validateAssetTier(data openapi.AssetBase) error {
+	asset, err := assetFromBase(data)
+	if err != nil {
+		return err
+	}
+	for _, location := range data.Locations {
+		asset.Locations = append(asset.Locations, types.AssetLocation{Location: location})
+	}
+	if data.LocationReferences != nil {
+		for _, reference := range *data.LocationReferences {
+			location := types.AssetLocation{Kind: types.AssetLocationKind(reference.Kind)}
+			if reference.Name != nil {
+				location.Location = *reference.Name
+			}
+			asset.Locations = append(asset.Locations, location)
+		}
+	}
+	for _, dataType := range data.DataTypes {
+		asset.DataTypes = append(asset.DataTypes, types.AssetDataType{Name: string(dataType)})
+	}
+
+	risk := DeriveAssetRisk(*asset)
+	if asset.Tier >= risk.MinimumTier {
+		return nil
+	}
+	return types.NewErrClientInvalidObjectF(
+		"tier %d is lower than the minimum tier %d for this asset: %s",
+		asset.Tier, risk.MinimumTier, strings.Join(risk.Reasons, "; "),
+	)
+}
+
+func riskRating(score int) types.StudyRiskRating {
+	switch {
+	case score < 3:
+		return types.StudyRiskRatingManageable
+	case score < 5:
+		return types.StudyRiskRatingUncomfortable
+	case score < 12:
+		return types.StudyRiskRatingVulnerable
+	default:
+		return types.StudyRiskRatingCritical
+	}
+}
+
+// Recompute the risk of every study e.g. after the scoring rules have changed
+func UpdateAllStudyRisks(db *gorm.DB) error {
+	studyIDs := []uuid.UUID{}
+	if err := db.Model(&types.Study{}).Pluck("id", &studyIDs).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to list studies")
+	}
+	for _, studyID := range studyIDs {
+		if err := updateStudyRisk(db, studyID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recompute the risk score and rating of a study as those of its highest risk asset,
+// along with the highest tier of its active assets. Studies without assets are unrated
+func updateStudyRisk(tx *gorm.DB, studyID uuid.UUID) error {
+	assets := []types.Asset{}
+	if err := tx.Preload("Locations").Preload("DataTypes").Where("study_id = ?", studyID).Find(&assets).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get study assets")
+	}
+
+	updates := map[string]any{"risk_score": nil, "risk_rating": nil, "highest_asset_tier": highestActiveAssetTier(assets)}
+	if score := highestActiveAssetRiskScore(assets); score != nil {
+		updates["risk_score"] = *score
+		updates["risk_rating"] = riskRating(*score)
+	}
+	err := tx.Model(&types.Study{}).Where("id = ?", studyID).Updates(updates).Error
+	return types.NewErrFromGorm(err, fmt.Sprintf("failed to update risk of study [%v]", studyID))
+}
+
+// Highest risk score of the assets which are neither destroyed nor transferred. Nil if there are none
+func highestActiveAssetRiskScore(assets []types.Asset) *int {
+	var score *int
+	for _, asset := range assets {
+		if !isActiveAsset(asset) {
+			continue
+		}
+		if assetScore := assetRiskScore(asset); score == nil || assetScore > *score {
+			score = new(assetScore)
+		}
+	}
+	return score
+}
+
+// Highest tier of the assets which are neither destroyed nor transferred. Nil if there are none
+func highestActiveAssetTier(assets []types.Asset) *int {
+	var tier *int
+	for _, asset := range assets {
+		if !isActiveAsset(asset) {
+			continue
+		}
+		if tier == nil || asset.Tier > *tier {
+			tier = new(asset.Tier)
+		}
+	}
+	return tier
+}
+
+func isActiveAsset(asset types.Asset) bool {
+	return !asset.IsDestroyed() && !asset.IsTransferred()
+}
+
+func isHighImpactAsset(asset types.Asset) bool {
+	return isMajorImpactOnLeak(asset) ||
+		assetHasDataType(asset, openapi.AssetDataTypesSpecialCategoryPersonal) ||
+		isTrue(asset.RequiresTre)
+}
+
+func isMajorImpactOnLeak(asset types.Asset) bool {
+	return isTrue(asset.IsLeakMajorDisruption) || isTrue(asset.IsLeakMajorFinancialLoss) || isTrue(asset.IsLeakMajorReputationalDamage)
+}
+
+func isStronglyProtected(asset types.Asset) bool {
+	return asset.Protection != nil && slices.Contains([]string{
+		string(openapi.AssetProtectionAnonymisation),
+		string(openapi.AssetProtectionPseudonymisation),
+	}, *asset.Protection)
+}
+
+func assetHasDataType(asset types.Asset, dataType openapi.AssetDataTypes) bool {
+	return slices.ContainsFunc(asset.DataTypes, func(d types.AssetDataType) bool { return d.Name == string(dataType) })
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
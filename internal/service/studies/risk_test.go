@@ -0,0 +1,106 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func highlyConfidentialAsset(dataTypes ...openapi.AssetDataTypes) types.Asset {
+	asset := types.Asset{ClassificationImpact: string(openapi.AssetClassificationImpactHighlyConfidential)}
+	for _, dataType := range dataTypes {
+		asset.DataTypes = append(asset.DataTypes, types.AssetDataType{Name: string(dataType)})
+	}
+	return asset
+}
+
+func TestAssetMinimumTier(t *testing.T) {
+	assert.Equal(t, 0, assetMinimumTier(types.Asset{ClassificationImpact: string(openapi.AssetClassificationImpactPublic)}))
+	assert.Equal(t, 1, assetMinimumTier(types.Asset{ClassificationImpact: string(openapi.AssetClassificationImpactConfidential)}))
+
+	personal := highlyConfidentialAsset(openapi.AssetDataTypesPersonal)
+	assert.Equal(t, 3, assetMinimumTier(personal))
+	personal.Protection = new(string(openapi.AssetProtectionPseudonymisation))
+	assert.Equal(t, 2, assetMinimumTier(personal))
+
+	special := highlyConfidentialAsset(openapi.AssetDataTypesSpecialCategoryPersonal)
+	special.Protection = new(string(openapi.AssetProtectionAnonymisation))
+	assert.Equal(t, 3, assetMinimumTier(special))
+	special.HasTargetedThreatActors = new(true)
+	assert.Equal(t, 4, assetMinimumTier(special))
+
+	majorImpact := highlyConfidentialAsset(openapi.AssetDataTypesResearch)
+	majorImpact.IsLeakMajorFinancialLoss = new(true)
+	assert.Equal(t, 3, assetMinimumTier(majorImpact))
+	assert.Len(t, assetMinimumTierReasons(majorImpact), 1)
+}
+
+func TestAssetRiskScore(t *testing.T) {
+	assert.Equal(t, 0, assetRiskScore(types.Asset{ClassificationImpact: string(openapi.AssetClassificationImpactPublic)}))
+
+	asset := highlyConfidentialAsset(openapi.AssetDataTypesPersonal)
+	asset.Protection = new(string(openapi.AssetProtectionIdentifiableLowConfidencePseudonymisation))
+	asset.IsLeakMajorDisruption = new(true)
+	asset.IsLeakMajorFinancialLoss = new(true)
+	asset.IsLeakMajorReputationalDamage = new(true)
+	asset.Locations = []types.AssetLocation{{Location: "arc_tre"}, {Location: "usb_portable_unencrypted"}}
+	assert.Equal(t, maxImpactScore*maxLikelihoodScore, assetRiskScore(asset))
+
+	asset.Locations = []types.AssetLocation{{Location: "arc_tre"}}
+	assert.Equal(t, 3, assetRiskScore(asset)) // impact 4 * likelihood 4/6
+}
+
+func TestRiskRating(t *testing.T) {
+	assert.Equal(t, types.StudyRiskRatingManageable, riskRating(0))
+	assert.Equal(t, types.StudyRiskRatingUncomfortable, riskRating(3))
+	assert.Equal(t, types.StudyRiskRatingVulnerable, riskRating(5))
+	assert.Equal(t, types.StudyRiskRatingCritical, riskRating(16))
+}
+
+func TestValidateAssetTier(t *testing.T) {
+	data := validAssetBase()
+	data.ClassificationImpact = openapi.AssetBaseClassificationImpactHighlyConfidential
+	data.DataTypes = []openapi.AssetBaseDataTypes{openapi.AssetBaseDataTypesSpecialCategoryPersonal}
+	data.Tier = 2
+
+	err := validateAssetTier(data)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+	assert.ErrorContains(t, err, "special category personal data")
+
+	data.Tier = 3
+	assert.NoError(t, validateAssetTier(data))
+}
+
+func TestHighestActiveAssetTier(t *testing.T) {
+	assert.Nil(t, highestActiveAssetTier(nil))
+
+	assets := []types.Asset{
+		{Tier: 2, Status: types.AssetStatusActive},
+		{Tier: 4, Status: types.AssetStatusDestroyed},
+		{Tier: 3, Status: types.AssetStatusTransferred},
+	}
+	assert.Equal(t, 2, *highestActiveAssetTier(assets))
+	assert.Nil(t, highestActiveAssetTier(assets[1:]))
+}
+
+func TestHighestActiveAssetRiskScore(t *testing.T) {
+	assert.Nil(t, highestActiveAssetRiskScore(nil))
+
+	risky := highlyConfidentialAsset(openapi.AssetDataTypesPersonal)
+	risky.Protection = new(string(openapi.AssetProtectionIdentifiableLowConfidencePseudonymisation))
+	risky.IsLeakMajorDisruption = new(true)
+	risky.IsLeakMajorFinancialLoss = new(true)
+	risky.IsLeakMajorReputationalDamage = new(true)
+	risky.Locations = []types.AssetLocation{{Location: "arc_tre"}}
+	destroyed := risky
+	destroyed.Status = types.AssetStatusDestroyed
+	transferred := risky
+	transferred.Status = types.AssetStatusTransferred
+	public := types.Asset{ClassificationImpact: string(openapi.AssetClassificationImpactPublic), Status: types.AssetStatusActive}
+
+	assert.Equal(t, 3, *highestActiveAssetRiskScore([]types.Asset{public, risky}))
+	assert.Equal(t, 0, *highestActiveAssetRiskScore([]types.Asset{public, destroyed, transferred}))
+	assert.Nil(t, highestActiveAssetRiskScore([]types.Asset{destroyed, transferred}))
+}
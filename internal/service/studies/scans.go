@@ -0,0 +1,116 @@
+package studies
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/controller/s3"
+	"github.com/ucl-arc-tre/portal/internal/rbac"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+// Scan contract objects still in quarantine e.g. as the scanner was
+// unavailable when they were uploaded
+func (s *Service) ScanQuarantinedContractObjects(ctx context.Context) error {
+	if s.scanner == nil {
+		return nil
+	}
+	objects := []types.ContractObjectMetadata{}
+	err := s.db.Where("scan_status = ?", types.MalwareScanStatusPending).Order("created_at").Find(&objects).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get quarantined contract objects")
+	}
+
+	errs := []error{}
+	for i := range objects {
+		if err := s.scanContractObject(ctx, &objects[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Scan a quarantined contract object. Clean objects are released from
+// quarantine while infected ones are kept there and IG ops staff notified
+func (s *Service) scanContractObject(ctx context.Context, object *types.ContractObjectMetadata) error {
+	quarantined := contractObjectS3Metadata(*object)
+	content, err := s.s3.GetObject(ctx, quarantined)
+	if err != nil {
+		return err
+	}
+	result, err := s.scanner.Scan(ctx, content.Content)
+	closeS3Object(content)
+	if err != nil {
+		return err
+	}
+
+	update := types.ContractObjectMetadata{
+		ScanStatus: types.MalwareScanStatusClean,
+		ScannedAt:  new(time.Now()),
+	}
+	if result.Infected {
+		update.ScanStatus = types.MalwareScanStatusInfected
+		update.ScanSignature = &result.Signature
+	} else if err := s.releaseContractObject(ctx, quarantined); err != nil {
+		return err
+	}
+
+	if err := s.db.Model(object).Updates(update).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to update contract object scan")
+	}
+	object.ScanStatus = update.ScanStatus
+	object.ScanSignature = update.ScanSignature
+	object.ScannedAt = update.ScannedAt
+
+	if result.Infected {
+		log.Warn().Any("contractObjectID", object.ID).Str("signature", result.Signature).Msg("Malware detected in contract object")
+		if err := s.notifyMalwareDetected(ctx, *object); err != nil {
+			log.Err(err).Msg("Failed to notify malware detected") // not fatal
+		}
+	} else if err := s.s3.DeleteObject(quarantined); err != nil {
+		log.Err(err).Any("contractObjectID", object.ID).Msg("Failed to delete released object from quarantine") // not fatal
+	}
+	return nil
+}
+
+// Copy a quarantined object to the clean contract objects
+func (s *Service) releaseContractObject(ctx context.Context, quarantined s3.ObjectMetadata) error {
+	content, err := s.s3.GetObject(ctx, quarantined)
+	if err != nil {
+		return err
+	}
+	defer closeS3Object(content)
+	return s.s3.StoreObject(ctx, s3.ObjectMetadata{Id: quarantined.Id, Kind: s3.ContractKind}, content)
+}
+
+func (s *Service) notifyMalwareDetected(ctx context.Context, object types.ContractObjectMetadata) error {
+	contract := types.Contract{}
+	if err := s.db.Preload("Study").Where("id = ?", object.ContractID).First(&contract).Error; err != nil {
+		return types.NewErrFromGorm(err, "failed to get contract")
+	}
+	igOpsStaff, err := s.users.UsersWithConfigRole(rbac.IGOpsStaff)
+	if err != nil {
+		return err
+	}
+	return s.notifications.NotifyMalwareDetected(ctx, contract, object, igOpsStaff)
+}
+
+// Location of a contract object in S3, which depends on whether it is quarantined
+func contractObjectS3Metadata(object types.ContractObjectMetadata) s3.ObjectMetadata {
+	metadata := s3.ObjectMetadata{Id: object.ID, Kind: s3.ContractKind}
+	if object.IsQuarantined() {
+		metadata.Kind = s3.ContractQuarantineKind
+	}
+	return metadata
+}
+
+func closeS3Object(object types.S3Object) {
+	if object.Content == nil {
+		return
+	}
+	if err := object.Content.Close(); err != nil {
+		log.Err(err).Msg("Failed to close S3 object")
+	}
+}
@@ -0,0 +1,146 @@
+package studies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Suspend the deployed TRE projects of approved studies whose signoff or a
+// regulatory approval has lapsed for longer than the grace period of the
+// enforcement policy
+func (s *Service) SuspendLapsedStudyProjects(ctx context.Context) error {
+	policy := config.StudySignoffEnforcement()
+	if !policy.Enabled {
+		return nil
+	}
+
+	studies := []types.Study{}
+	err := s.db.Preload("Owner").Preload("StudyAdmins.User").Preload("Approvals").
+		Where("approval_status = ?", types.StudyApprovalStatusApproved).
+		Find(&studies).Error
+	if err != nil {
+		return types.NewErrFromGorm(err, "failed to get studies")
+	}
+
+	errs := []error{}
+	for _, study := range studies {
+		if !config.ShouldSuspendStudyProjects(study, policy) {
+			continue
+		}
+		if err := s.suspendStudyProjects(ctx, study, policy); err != nil {
+			errs = append(errs, err) // other studies are still suspended
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) suspendStudyProjects(ctx context.Context, study types.Study, policy config.EnforcementPolicy) error {
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	changes, err := changeProjectsSuspension(tx, study, types.ProjectTRESuspensionActionSuspend, suspensionReason(study, policy))
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if err := commitTransaction(tx); err != nil {
+		return err
+	}
+	s.notifyProjectsSuspension(ctx, study, changes)
+	return nil
+}
+
+func suspensionReason(study types.Study, policy config.EnforcementPolicy) string {
+	if config.IsStudySignoffLapsed(study, policy) {
+		return fmt.Sprintf("Study signoff expired on %s", config.StudySignoffExpiresAt(study).Format(config.DateFormat))
+	}
+	approval := config.LapsedRegulatoryApproval(study, policy)
+	return fmt.Sprintf("Study %s approval [%s] expired on %s", approval.Type, approval.Reference, approval.ExpiryDate.Format(config.DateFormat))
+}
+
+// Reinstate any suspended TRE projects of a study, unless its signoff or a
+// regulatory approval remains lapsed. Requires the approvals of the study to be
+// loaded
+func reinstateStudyProjects(tx *gorm.DB, study types.Study, reason string) ([]types.ProjectTRESuspensionChangelog, error) {
+	if config.ShouldSuspendStudyProjects(study, config.StudySignoffEnforcement()) {
+		return []types.ProjectTRESuspensionChangelog{}, nil
+	}
+	return changeProjectsSuspension(tx, study, types.ProjectTRESuspensionActionReinstate, reason)
+}
+
+// Reinstate the suspended TRE projects of a study whose approvals have changed
+// within a transaction, returning the study to notify the changes for
+func reinstateStudyProjectsOnApprovalChange(tx *gorm.DB, studyID uuid.UUID) (*types.Study, []types.ProjectTRESuspensionChangelog, error) {
+	study := types.Study{}
+	if err := tx.Preload("Owner").Preload("StudyAdmins.User").Preload("Approvals").Where("id = ?", studyID).First(&study).Error; err != nil {
+		return nil, nil, types.NewErrFromGorm(err, "failed to get study")
+	}
+	reason := fmt.Sprintf("Study approvals updated on %s", time.Now().Format(config.DateFormat))
+	reinstated, err := reinstateStudyProjects(tx, study, reason)
+	return &study, reinstated, err
+}
+
+// Move the TRE projects of a study between deployed and suspended within a
+// transaction, recording each change
+func changeProjectsSuspension(
+	tx *gorm.DB,
+	study types.Study,
+	action types.ProjectTRESuspensionAction,
+	reason string,
+) ([]types.ProjectTRESuspensionChangelog, error) {
+	fromStatus, toStatus := types.ProjectTREStatusDeployed, types.ProjectTREStatusSuspended
+	if action == types.ProjectTRESuspensionActionReinstate {
+		fromStatus, toStatus = toStatus, fromStatus
+	}
+
+	projectTREs := []types.ProjectTRE{}
+	err := tx.Preload("Project").
+		Joins("INNER JOIN projects ON projects.id = project_tres.project_id").
+		Where("projects.study_id = ? AND projects.deleted_at IS NULL AND project_tres.status = ?", study.ID, fromStatus).
+		Find(&projectTREs).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get study TRE projects")
+	}
+
+	changes := []types.ProjectTRESuspensionChangelog{}
+	for _, projectTRE := range projectTREs {
+		log.Info().Str("project", projectTRE.Project.Name).Any("action", action).Msg("Changing TRE project suspension")
+
+		// Bump the requested version so the deployer applies the change
+		err := tx.Model(&projectTRE).Updates(types.ProjectTRE{
+			Status:                    toStatus,
+			RequestedVersionUpdatedAt: new(time.Now()),
+		}).Error
+		if err != nil {
+			return nil, types.NewErrFromGorm(err, "failed to update TRE project status")
+		}
+
+		change := types.ProjectTRESuspensionChangelog{
+			ProjectTREID: projectTRE.ID,
+			Action:       action,
+			Reason:       reason,
+		}
+		if err := tx.Create(&change).Error; err != nil { // NOTE: must not be first or create. Log is immutable
+			return nil, types.NewErrFromGorm(err, "failed to create TRE project suspension changelog")
+		}
+		change.ProjectTRE = projectTRE
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (s *Service) notifyProjectsSuspension(ctx context.Context, study types.Study, changes []types.ProjectTRESuspensionChangelog) {
+	for _, change := range changes {
+		if err := s.notifications.NotifyProjectSuspension(ctx, study, change); err != nil {
+			log.Err(err).Msg("Failed to notify project suspension") // not fatal
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package studies
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/ucl-arc-tre/portal/internal/graceful"
+	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
+	"github.com/ucl-arc-tre/portal/internal/types"
+	"gorm.io/gorm"
+)
+
+// Transfer history of an asset, most recent first
+func (s *Service) AssetTransfers(studyID uuid.UUID, assetID uuid.UUID) ([]types.AssetTransfer, error) {
+	if err := s.checkAssetExists(studyID, assetID); err != nil {
+		return nil, err
+	}
+	transfers := []types.AssetTransfer{}
+	err := preloadAssetTransfer(s.db).
+		Where("asset_id = ?", assetID).
+		Order("created_at DESC").
+		Find(&transfers).Error
+	return transfers, types.NewErrFromGorm(err, "failed to get asset transfers")
+}
+
+// Transfers of assets into or out of a study, most recent first
+func (s *Service) StudyAssetTransfers(studyID uuid.UUID) ([]types.AssetTransfer, error) {
+	transfers := []types.AssetTransfer{}
+	err := preloadAssetTransfer(s.db).
+		Where("source_study_id = ? OR target_study_id = ?", studyID, studyID).
+		Order("created_at DESC").
+		Find(&transfers).Error
+	return transfers, types.NewErrFromGorm(err, "failed to get study asset transfers")
+}
+
+// Pending transfers approved by both study owners and awaiting IG approval, oldest first
+func (s *Service) PendingAssetTransfers() ([]types.AssetTransfer, error) {
+	transfers := []types.AssetTransfer{}
+	err := preloadAssetTransfer(s.db).
+		Where("status = ?", types.AssetTransferStatusPending).
+		Where("source_owner_approved_at IS NOT NULL AND target_owner_approved_at IS NOT NULL").
+		Order("created_at").
+		Find(&transfers).Error
+	return transfers, types.NewErrFromGorm(err, "failed to get pending asset transfers")
+}
+
+// Request the transfer of an asset to another study. A requester who owns
+// either study approves the transfer on its behalf
+func (s *Service) RequestAssetTransfer(
+	requester types.User,
+	studyID uuid.UUID,
+	assetID uuid.UUID,
+	data openapi.AssetTransferRequest,
+) (*types.AssetTransfer, error) {
+	targetStudyID, err := uuid.Parse(data.TargetStudyId)
+	if err != nil {
+		return nil, types.NewErrClientInvalidObjectF("invalid target study id [%v]", data.TargetStudyId)
+	} else if targetStudyID == studyID {
+		return nil, types.NewErrClientInvalidObjectF("an asset cannot be transferred to its own study")
+	} else if data.Reason == "" {
+		return nil, types.NewErrClientInvalidObjectF("a reason for the transfer is required")
+	}
+
+	if _, err := transferableContractIDs(s.db, studyID, assetID, targetStudyID); err != nil {
+		return nil, err
+	}
+
+	var numPending int64
+	err = s.db.Model(&types.AssetTransfer{}).
+		Where("asset_id = ? AND status = ?", assetID, types.AssetTransferStatusPending).
+		Count(&numPending).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to check for pending transfers")
+	} else if numPending > 0 {
+		return nil, types.NewErrClientInvalidObjectF("a transfer of this asset is already pending")
+	}
+
+	log.Debug().Any("studyID", studyID).Any("assetID", assetID).Any("targetStudyID", targetStudyID).Msg("Requesting asset transfer")
+
+	transfer := types.AssetTransfer{
+		AssetID:         assetID,
+		SourceStudyID:   &studyID,
+		TargetStudyID:   &targetStudyID,
+		RequesterUserID: requester.ID,
+		Reason:          data.Reason,
+		Status:          types.AssetTransferStatusPending,
+	}
+	studies := []types.Study{}
+	if err := s.db.Where("id IN ?", []uuid.UUID{studyID, targetStudyID}).Find(&studies).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get studies")
+	}
+	for _, study := range studies {
+		if study.OwnerUserID != requester.ID {
+			continue
+		} else if study.ID == studyID {
+			transfer.SourceOwnerApprovedAt = new(time.Now())
+		} else {
+			transfer.TargetOwnerApprovedAt = new(time.Now())
+		}
+	}
+
+	if err := s.db.Create(&transfer).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to create asset transfer")
+	}
+	return assetTransfer(s.db, transfer.ID)
+}
+
+// Approve a pending transfer into or out of a study as the owner of the study
+func (s *Service) ApproveStudyAssetTransfer(owner types.User, studyID uuid.UUID, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	log.Debug().Any("transferID", transferID).Any("studyID", studyID).Msg("Approving asset transfer as study owner")
+
+	transfer, err := pendingStudyAssetTransfer(s.db, owner, studyID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	column := "source_owner_approved_at"
+	if transfer.IsTo(studyID) {
+		column = "target_owner_approved_at"
+	}
+	if err := s.db.Model(transfer).Update(column, time.Now()).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to approve asset transfer")
+	}
+	return assetTransfer(s.db, transferID)
+}
+
+// Reject a pending transfer into or out of a study as the owner of the study
+func (s *Service) RejectStudyAssetTransfer(owner types.User, studyID uuid.UUID, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	log.Debug().Any("transferID", transferID).Any("studyID", studyID).Msg("Rejecting asset transfer as study owner")
+
+	transfer, err := pendingStudyAssetTransfer(s.db, owner, studyID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if err := rejectAssetTransfer(s.db, owner, transfer); err != nil {
+		return nil, err
+	}
+	return assetTransfer(s.db, transferID)
+}
+
+// Complete a transfer approved by both study owners. The asset moves to the
+// target study along with its locations, data types and contracts
+func (s *Service) ApproveAssetTransfer(reviewer types.User, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	log.Debug().Any("transferID", transferID).Any("reviewer", reviewer.Username).Msg("Approving asset transfer")
+
+	tx := s.db.Begin()
+	defer graceful.RollbackTransactionOnPanic(tx)
+
+	transfer, err := assetTransfer(tx, transferID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	} else if transfer.Status != types.AssetTransferStatusPending {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("transfer is %s, not pending", transfer.Status)
+	} else if !transfer.OwnersApproved() {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("transfer must be approved by the owners of both studies first")
+	} else if transfer.RequesterUserID == reviewer.ID {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("a transfer must be reviewed by a different user to the requester")
+	} else if transfer.SourceStudyID == nil || transfer.TargetStudyID == nil {
+		tx.Rollback()
+		return nil, types.NewErrClientInvalidObjectF("transfer is to or from an erased study")
+	}
+	sourceStudyID, targetStudyID := *transfer.SourceStudyID, *transfer.TargetStudyID
+
+	contractIDs, err := transferableContractIDs(tx, sourceStudyID, transfer.AssetID, targetStudyID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(&types.Asset{}).Where("id = ?", transfer.AssetID).Update("study_id", targetStudyID).Error; err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to move asset")
+	}
+	if len(contractIDs) > 0 {
+		if err := tx.Model(&types.Contract{}).Where("id IN ?", contractIDs).Update("study_id", targetStudyID).Error; err != nil {
+			tx.Rollback()
+			return nil, types.NewErrFromGorm(err, "failed to move asset contracts")
+		}
+	}
+
+	err = tx.Model(transfer).Updates(types.AssetTransfer{
+		Status:         types.AssetTransferStatusCompleted,
+		ReviewerUserID: &reviewer.ID,
+		ReviewedAt:     new(time.Now()),
+		ContractIDs:    contractIDs,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, types.NewErrFromGorm(err, "failed to complete asset transfer")
+	}
+
+	for _, studyID := range []uuid.UUID{sourceStudyID, targetStudyID} {
+		if err := updateStudyRisk(tx, studyID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := commitTransaction(tx); err != nil {
+		return nil, err
+	}
+	return assetTransfer(s.db, transferID)
+}
+
+// Reject a pending transfer. The asset is unchanged
+func (s *Service) RejectAssetTransfer(reviewer types.User, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	log.Debug().Any("transferID", transferID).Any("reviewer", reviewer.Username).Msg("Rejecting asset transfer")
+
+	transfer, err := assetTransfer(s.db, transferID)
+	if err != nil {
+		return nil, err
+	} else if transfer.Status != types.AssetTransferStatusPending {
+		return nil, types.NewErrClientInvalidObjectF("transfer is %s, not pending", transfer.Status)
+	}
+	if err := rejectAssetTransfer(s.db, reviewer, transfer); err != nil {
+		return nil, err
+	}
+	return assetTransfer(s.db, transferID)
+}
+
+// IDs of the contracts which move with an asset to the target study. Fails if
+// the asset or any of its contracts cannot be transferred
+func transferableContractIDs(db *gorm.DB, studyID uuid.UUID, assetID uuid.UUID, targetStudyID uuid.UUID) ([]uuid.UUID, error) {
+	target := types.Study{}
+	if err := db.Where("id = ?", targetStudyID).First(&target).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get target study")
+	} else if target.IsClosed() {
+		return nil, types.NewErrClientInvalidObjectF("assets cannot be transferred to a closed study")
+	}
+
+	asset := types.Asset{}
+	if err := db.Preload("Contracts.Assets").Where("study_id = ? AND id = ?", studyID, assetID).First(&asset).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset")
+	} else if asset.IsDestroyed() || asset.IsTransferred() {
+		return nil, types.NewErrClientInvalidObjectF("%s assets cannot be transferred", asset.Status)
+	}
+
+	var numLinks, numProjects, numDestructions int64
+	if err := db.Model(&types.AssetLink{}).Where("child_asset_id = ? OR parent_asset_id = ?", assetID, assetID).Count(&numLinks).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to count asset links")
+	} else if numLinks > 0 {
+		return nil, types.NewErrClientInvalidObjectF("assets linked to other assets cannot be transferred")
+	}
+	if err := db.Model(&types.ProjectAsset{}).Where("asset_id = ?", assetID).Count(&numProjects).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to count asset projects")
+	} else if numProjects > 0 {
+		return nil, types.NewErrClientInvalidObjectF("assets used by projects cannot be transferred")
+	}
+	err := db.Model(&types.AssetDestruction{}).
+		Where("asset_id = ? AND status = ?", assetID, types.AssetDestructionStatusPending).
+		Count(&numDestructions).Error
+	if err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to check for pending destructions")
+	} else if numDestructions > 0 {
+		return nil, types.NewErrClientInvalidObjectF("assets pending destruction cannot be transferred")
+	}
+
+	return assetContractIDs(asset)
+}
+
+// IDs of the contracts of an asset, which must cover no other asset to be
+// transferred with it
+func assetContractIDs(asset types.Asset) ([]uuid.UUID, error) {
+	contractIDs := []uuid.UUID{}
+	for _, contract := range asset.Contracts {
+		for _, other := range contract.Assets {
+			if other.ID != asset.ID {
+				return nil, types.NewErrClientInvalidObjectF(
+					"contract [%s] also covers asset [%s] so cannot be transferred", contract.Title, other.Title,
+				)
+			}
+		}
+		contractIDs = append(contractIDs, contract.ID)
+	}
+	return contractIDs, nil
+}
+
+// Pending transfer into or out of a study, which the user must own
+func pendingStudyAssetTransfer(db *gorm.DB, owner types.User, studyID uuid.UUID, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	transfer, err := assetTransfer(db, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	var study *types.Study
+	switch {
+	case transfer.IsFrom(studyID):
+		study = transfer.SourceStudy
+	case transfer.IsTo(studyID):
+		study = transfer.TargetStudy
+	default:
+		return nil, types.NewNotFoundError(fmt.Errorf("transfer [%v] not found for study [%v]", transferID, studyID))
+	}
+
+	if study.OwnerUserID != owner.ID {
+		return nil, types.NewErrClientInvalidObjectF("only the owner of the study can review a transfer")
+	} else if transfer.Status != types.AssetTransferStatusPending {
+		return nil, types.NewErrClientInvalidObjectF("transfer is %s, not pending", transfer.Status)
+	}
+	return transfer, nil
+}
+
+func rejectAssetTransfer(db *gorm.DB, reviewer types.User, transfer *types.AssetTransfer) error {
+	err := db.Model(transfer).Updates(types.AssetTransfer{
+		Status:         types.AssetTransferStatusRejected,
+		ReviewerUserID: &reviewer.ID,
+		ReviewedAt:     new(time.Now()),
+	}).Error
+	return types.NewErrFromGorm(err, "failed to reject asset transfer")
+}
+
+func assetTransfer(db *gorm.DB, transferID uuid.UUID) (*types.AssetTransfer, error) {
+	transfer := types.AssetTransfer{}
+	if err := preloadAssetTransfer(db).Where("id = ?", transferID).First(&transfer).Error; err != nil {
+		return nil, types.NewErrFromGorm(err, "failed to get asset transfer")
+	}
+	return &transfer, nil
+}
+
+func preloadAssetTransfer(db *gorm.DB) *gorm.DB {
+	return db.Preload("Asset").
+		Preload("SourceStudy").
+		Preload("TargetStudy").
+		Preload("RequesterUser").
+		Preload("ReviewerUser")
+}
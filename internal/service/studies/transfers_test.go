@@ -0,0 +1,34 @@
+package studies
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+func TestAssetContractIDs(t *testing.T) {
+	asset := types.Asset{Title: "asset"}
+	asset.ID = uuid.New()
+	other := types.Asset{Title: "other"}
+	other.ID = uuid.New()
+
+	contract := types.Contract{Title: "contract", Assets: []types.Asset{asset}}
+	contract.ID = uuid.New()
+	asset.Contracts = []types.Contract{contract}
+	contractIDs, err := assetContractIDs(asset)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{contract.ID}, contractIDs)
+
+	shared := types.Contract{Title: "shared", Assets: []types.Asset{asset, other}}
+	shared.ID = uuid.New()
+	asset.Contracts = append(asset.Contracts, shared)
+	_, err = assetContractIDs(asset)
+	assert.IsType(t, &types.ErrClientInvalidObject{}, err)
+
+	contractIDs, err = assetContractIDs(types.Asset{})
+	require.NoError(t, err)
+	assert.Empty(t, contractIDs)
+}
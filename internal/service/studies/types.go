@@ -3,6 +3,7 @@ package studies
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/ucl-arc-tre/portal/internal/graceful"
 	openapi "github.com/ucl-arc-tre/portal/internal/openapi/web"
 	"github.com/ucl-arc-tre/portal/internal/types"
@@ -15,6 +16,9 @@ type QueryParams struct {
 	FuzzyTitle     *string
 	Owner          *string // username, email, name
 	Administrator  *string // username, email, name
+	ReviewerUserID *uuid.UUID
+	Unassigned     bool // No reviewer assigned
+	RiskRating     *openapi.StudyRiskRating
 	Limit          int
 	Offset         int
 }
@@ -24,6 +28,11 @@ type ContractObject struct {
 	Meta   types.ContractObjectMetadata
 }
 
+type StudyDocumentUpload struct {
+	Filename string
+	Object   types.S3Object
+}
+
 type StudyTransaction struct {
 	ctx     context.Context
 	db      *gorm.DB
@@ -38,3 +47,19 @@ func (s *StudyTransaction) Rollback() {
 func (s *StudyTransaction) RollbackOnPanic() {
 	graceful.RollbackTransactionOnPanic(s.db)
 }
+
+// Error in a row of a bulk import
+type BulkImportError struct {
+	Sheet   string `json:"sheet"`
+	Row     int    `json:"row"` // As numbered in a spreadsheet i.e. the header is row 1
+	Message string `json:"message"`
+}
+
+type BulkImportReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Committed bool              `json:"committed"`
+	Studies   int               `json:"studies"`
+	Assets    int               `json:"assets"`
+	Contracts int               `json:"contracts"`
+	Errors    []BulkImportError `json:"errors"`
+}
@@ -2,21 +2,14 @@ package certificate
 
 import (
 	"encoding/base64"
-	"time"
 
 	"github.com/klippa-app/go-pdfium"
 	pdfref "github.com/klippa-app/go-pdfium/references"
 	pdfreq "github.com/klippa-app/go-pdfium/requests"
-	pdfwasm "github.com/klippa-app/go-pdfium/webassembly"
+	"github.com/ucl-arc-tre/portal/internal/pdf"
 	"github.com/ucl-arc-tre/portal/internal/types"
 )
 
-var pdfWasmPool = must(pdfwasm.Init(pdfwasm.Config{
-	MinIdle:  0,
-	MaxIdle:  1,
-	MaxTotal: 1,
-}))
-
 func firstPageText(contentBase64 string) (string, error) {
 	content, err := base64.StdEncoding.DecodeString(contentBase64)
 	if err != nil {
@@ -31,9 +24,9 @@ func firstPageText(contentBase64 string) (string, error) {
 }
 
 func getFirstPageText(content []byte) (string, error) {
-	instance, err := pdfWasmPool.GetInstance(100 * time.Millisecond)
+	instance, err := pdf.Instance()
 	if err != nil {
-		return "", types.NewErrServerError(err)
+		return "", err
 	}
 	//nolint:errcheck // always non nil error
 	defer instance.Close()
@@ -72,14 +65,3 @@ func firstPageTextFromDoc(instance pdfium.Pdfium, doc pdfref.FPDF_DOCUMENT) (str
 	}
 	return pageTextResult.Text, nil
 }
-
-func must[T any](value T, err error) T {
-	assertNoError(err)
-	return value
-}
-
-func assertNoError(err error) {
-	if err != nil {
-		panic(err)
-	}
-}
@@ -61,6 +61,8 @@ func (s *Service) UsersWithConfigRole(role rbac.ConfigRolename) ([]types.User, e
 		usernames = config.DSHOpsStaffUsernames()
 	case rbac.TreOpsStaff:
 		usernames = config.TreOpsStaffUsernames()
+	case rbac.DPO:
+		usernames = config.DPOUsernames()
 	default:
 		return []types.User{}, types.NewErrInvalidObjectF("[%v] is not a config set role name", role)
 	}
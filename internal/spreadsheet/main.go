@@ -0,0 +1,244 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+
+	// Limit on the uncompressed size of a single part of a workbook
+	maxPartSize = 64 << 20
+
+	// Limits of a worksheet, i.e. up to row 1048576 and column XFD
+	maxRows    = 1 << 20
+	maxColumns = 1 << 14
+)
+
+// Sheet of a workbook as rows of cell values. Rows may have differing lengths
+type Sheet struct {
+	Name string
+	Rows [][]string
+}
+
+// Read a CSV file as a single sheet
+func ReadCSV(name string, content []byte) (Sheet, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return Sheet{}, fmt.Errorf("failed to parse csv [%v]: %w", name, err)
+	}
+	return Sheet{Name: name, Rows: rows}, nil
+}
+
+// Read all sheets of an Office Open XML (.xlsx) workbook, in workbook order. Cell values
+// are read as displayed text for strings and as stored for numbers, so dates must be
+// entered as text to be read in a given format
+func ReadXLSX(content []byte) ([]Sheet, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open xlsx: %w", err)
+	}
+
+	workbook := xlsxWorkbook{}
+	if err := readXMLPart(archive, workbookPath, &workbook); err != nil {
+		return nil, err
+	}
+	relationships := xlsxRelationships{}
+	if err := readXMLPart(archive, workbookRelsPath, &relationships); err != nil {
+		return nil, err
+	}
+	sharedStrings := xlsxSharedStrings{}
+	if err := readXMLPart(archive, sharedStringsPath, &sharedStrings); err != nil && !errors.Is(err, errPartNotFound) {
+		return nil, err
+	}
+
+	sheets := []Sheet{}
+	for _, workbookSheet := range workbook.Sheets {
+		target := relationships.target(workbookSheet.RelationshipID)
+		if target == "" {
+			return nil, fmt.Errorf("no relationship for sheet [%v]", workbookSheet.Name)
+		}
+		worksheet := xlsxWorksheet{}
+		if err := readXMLPart(archive, target, &worksheet); err != nil {
+			return nil, err
+		}
+		rows, err := worksheet.values(sharedStrings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sheet [%v]: %w", workbookSheet.Name, err)
+		}
+		sheets = append(sheets, Sheet{Name: workbookSheet.Name, Rows: rows})
+	}
+	return sheets, nil
+}
+
+var errPartNotFound = errors.New("part not found")
+
+func readXMLPart(archive *zip.Reader, name string, value any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPartNotFound, name)
+	}
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxPartSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read [%v]: %w", name, err)
+	} else if len(content) > maxPartSize {
+		return fmt.Errorf("[%v] exceeded the maximum size", name)
+	}
+	if err := xml.Unmarshal(content, value); err != nil {
+		return fmt.Errorf("failed to parse [%v]: %w", name, err)
+	}
+	return nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name           string `xml:"name,attr"`
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// Path within the archive of a relationship target. Targets are relative to xl/ unless absolute
+func (r xlsxRelationships) target(id string) string {
+	for _, relationship := range r.Relationships {
+		if relationship.ID != id {
+			continue
+		}
+		if strings.HasPrefix(relationship.Target, "/") {
+			return strings.TrimPrefix(path.Clean(relationship.Target), "/")
+		}
+		return path.Join("xl", relationship.Target)
+	}
+	return ""
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// Plain or rich text. Rich text is split into runs with their own formatting
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	builder := strings.Builder{}
+	for _, run := range t.Runs {
+		builder.WriteString(run.Text)
+	}
+	return builder.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Reference string   `xml:"r,attr"`
+			Type      string   `xml:"t,attr"`
+			Value     string   `xml:"v"`
+			Inline    xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// Cell values by row and column. Rows and cells may be omitted when empty so are
+// positioned by their index and reference
+func (w xlsxWorksheet) values(sharedStrings xlsxSharedStrings) ([][]string, error) {
+	rows := [][]string{}
+	for _, row := range w.Rows {
+		rowIndex := len(rows)
+		if row.Index > 0 {
+			rowIndex = row.Index - 1
+		}
+		if rowIndex >= maxRows {
+			return nil, fmt.Errorf("row [%d] exceeds the maximum of %d rows", rowIndex+1, maxRows)
+		} else if rowIndex < len(rows) {
+			return nil, fmt.Errorf("row [%d] out of order", row.Index)
+		}
+		for len(rows) <= rowIndex {
+			rows = append(rows, []string{})
+		}
+
+		values := []string{}
+		for _, cell := range row.Cells {
+			column := len(values)
+			if cell.Reference != "" {
+				index, err := columnIndex(cell.Reference)
+				if err != nil {
+					return nil, err
+				}
+				column = index
+			}
+			if column < len(values) {
+				return nil, fmt.Errorf("cell [%v] out of order", cell.Reference)
+			}
+			for len(values) < column {
+				values = append(values, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("invalid shared string [%v] in cell [%v]", cell.Value, cell.Reference)
+				}
+				values = append(values, sharedStrings.Items[index].String())
+			case "inlineStr":
+				values = append(values, cell.Inline.String())
+			case "b":
+				values = append(values, strconv.FormatBool(cell.Value == "1"))
+			default:
+				values = append(values, cell.Value)
+			}
+		}
+		rows[rowIndex] = values
+	}
+	return rows, nil
+}
+
+// Zero based column index of a cell reference e.g. "AB12" -> 27
+func columnIndex(reference string) (int, error) {
+	index := 0
+	letters := 0
+	for _, char := range reference {
+		if char < 'A' || char > 'Z' {
+			break
+		}
+		index = index*26 + int(char-'A') + 1
+		letters++
+		if index > maxColumns { // also guards against overflow
+			return 0, fmt.Errorf("cell reference [%v] exceeds the maximum of %d columns", reference, maxColumns)
+		}
+	}
+	if letters == 0 {
+		return 0, fmt.Errorf("invalid cell reference [%v]", reference)
+	}
+	return index - 1, nil
+}
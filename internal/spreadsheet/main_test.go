@@ -0,0 +1,122 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeXLSX(t *testing.T, parts map[string]string) []byte {
+	buffer := bytes.Buffer{}
+	writer := zip.NewWriter(&buffer)
+	for name, content := range parts {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func TestReadXLSX(t *testing.T) {
+	content := makeXLSX(t, map[string]string{
+		workbookPath: `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+	<sheets>
+		<sheet name="studies" sheetId="1" r:id="rId1"/>
+		<sheet name="assets" sheetId="2" r:id="rId2"/>
+	</sheets>
+</workbook>`,
+		workbookRelsPath: `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+	<Relationship Id="rId2" Type="worksheet" Target="/xl/worksheets/sheet2.xml"/>
+	<Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+		sharedStringsPath: `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<si><t>caseref</t></si>
+	<si><t>title</t></si>
+	<si><r><t>Legacy </t></r><r><t>study</t></r></si>
+</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<sheetData>
+		<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+		<row r="3"><c r="A3"><v>42</v></c><c r="B3" t="s"><v>2</v></c><c r="D3" t="b"><v>1</v></c></row>
+	</sheetData>
+</worksheet>`,
+		"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<sheetData>
+		<row><c t="inlineStr"><is><t>title</t></is></c></row>
+	</sheetData>
+</worksheet>`,
+	})
+
+	sheets, err := ReadXLSX(content)
+	require.NoError(t, err)
+	assert.Equal(t, []Sheet{
+		{Name: "studies", Rows: [][]string{
+			{"caseref", "title"},
+			{},
+			{"42", "Legacy study", "", "true"},
+		}},
+		{Name: "assets", Rows: [][]string{{"title"}}},
+	}, sheets)
+}
+
+func TestReadXLSXInvalid(t *testing.T) {
+	_, err := ReadXLSX([]byte("caseref,title"))
+	assert.Error(t, err)
+
+	_, err = ReadXLSX(makeXLSX(t, map[string]string{workbookPath: "<workbook/>"}))
+	assert.ErrorIs(t, err, errPartNotFound)
+}
+
+func TestReadCSV(t *testing.T) {
+	sheet, err := ReadCSV("assets", []byte("caseref,title\n1,\"Survey, 2019\",extra\n"))
+	require.NoError(t, err)
+	assert.Equal(t, Sheet{Name: "assets", Rows: [][]string{{"caseref", "title"}, {"1", "Survey, 2019", "extra"}}}, sheet)
+}
+
+func TestColumnIndex(t *testing.T) {
+	for reference, expected := range map[string]int{"A1": 0, "Z9": 25, "AA10": 26, "AB12": 27} {
+		index, err := columnIndex(reference)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, index, reference)
+	}
+	_, err := columnIndex("12")
+	assert.Error(t, err)
+
+	index, err := columnIndex("XFD1")
+	assert.NoError(t, err)
+	assert.Equal(t, maxColumns-1, index)
+	for _, reference := range []string{"XFE1", "ZZZZZZZ1", strings.Repeat("Z", 100) + "1"} {
+		_, err := columnIndex(reference)
+		assert.Error(t, err, reference)
+	}
+}
+
+func TestReadXLSXOutOfBounds(t *testing.T) {
+	readSheet := func(sheetData string) error {
+		_, err := ReadXLSX(makeXLSX(t, map[string]string{
+			workbookPath: `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+	<sheets><sheet name="studies" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`,
+			workbookRelsPath: `<Relationships>
+	<Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+			"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` + sheetData + `</sheetData></worksheet>`,
+		}))
+		return err
+	}
+	assert.NoError(t, readSheet(`<row r="2"><c r="B2"><v>1</v></c></row>`))
+	assert.Error(t, readSheet(`<row r="1048577"><c r="A1048577"><v>1</v></c></row>`))
+	assert.Error(t, readSheet(`<row r="1048576000"><c r="A1048576000"><v>1</v></c></row>`))
+	assert.Error(t, readSheet(`<row r="1"><c r="ZZZZZZZ1"><v>1</v></c></row>`))
+}
@@ -0,0 +1,164 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	contentTypesPath = "[Content_Types].xml"
+	packageRelsPath  = "_rels/.rels"
+
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+	// Excel limits sheet names to this many characters
+	maxSheetNameLength = 31
+)
+
+// Write a sheet as CSV. Values which a spreadsheet application would run as a
+// formula are prefixed with a quote so they are shown as text
+func WriteCSV(sheet Sheet) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	writer := csv.NewWriter(&buffer)
+	for _, row := range sheet.Rows {
+		values := make([]string, len(row))
+		for i, value := range row {
+			values[i] = escapeFormula(value)
+		}
+		if err := writer.Write(values); err != nil {
+			return nil, fmt.Errorf("failed to write csv: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write csv: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Write sheets as an Office Open XML (.xlsx) workbook with every cell as text
+func WriteXLSX(sheets ...Sheet) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	archive := zip.NewWriter(&buffer)
+
+	contentTypes := strings.Builder{}
+	contentTypes.WriteString(xmlHeader)
+	contentTypes.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	contentTypes.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	contentTypes.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	contentTypes.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+
+	workbook := strings.Builder{}
+	workbook.WriteString(xmlHeader)
+	workbook.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+
+	relationships := strings.Builder{}
+	relationships.WriteString(xmlHeader)
+	relationships.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+
+	for i, sheet := range sheets {
+		target := fmt.Sprintf("worksheets/sheet%d.xml", i+1)
+		fmt.Fprintf(&contentTypes, `<Override PartName="/xl/%s" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, target)
+		fmt.Fprintf(&workbook, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(sheetName(sheet.Name, i)), i+1, i+1)
+		fmt.Fprintf(&relationships, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="%s"/>`, i+1, target)
+		if err := writePart(archive, "xl/"+target, worksheetXML(sheet)); err != nil {
+			return nil, err
+		}
+	}
+	contentTypes.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets></workbook>`)
+	relationships.WriteString(`</Relationships>`)
+
+	packageRelationships := xmlHeader +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	for name, content := range map[string]string{
+		contentTypesPath: contentTypes.String(),
+		packageRelsPath:  packageRelationships,
+		workbookPath:     workbook.String(),
+		workbookRelsPath: relationships.String(),
+	} {
+		if err := writePart(archive, name, content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func worksheetXML(sheet Sheet) string {
+	builder := strings.Builder{}
+	builder.WriteString(xmlHeader)
+	builder.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for rowIndex, row := range sheet.Rows {
+		fmt.Fprintf(&builder, `<row r="%d">`, rowIndex+1)
+		for column, value := range row {
+			if value == "" {
+				continue
+			}
+			fmt.Fprintf(&builder, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+				columnName(column), rowIndex+1, escapeXML(value))
+		}
+		builder.WriteString(`</row>`)
+	}
+	builder.WriteString(`</sheetData></worksheet>`)
+	return builder.String()
+}
+
+func writePart(archive *zip.Writer, name string, content string) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create [%v]: %w", name, err)
+	}
+	if _, err := io.WriteString(file, content); err != nil {
+		return fmt.Errorf("failed to write [%v]: %w", name, err)
+	}
+	return nil
+}
+
+func escapeXML(value string) string {
+	builder := strings.Builder{}
+	_ = xml.EscapeText(&builder, []byte(value))
+	return builder.String()
+}
+
+// Name of a sheet within the limits of spreadsheet applications, defaulting to its position
+func sheetName(name string, index int) string {
+	name = strings.Map(func(char rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, char) {
+			return '_'
+		}
+		return char
+	}, name)
+	if name == "" {
+		return fmt.Sprintf("Sheet%d", index+1)
+	} else if len([]rune(name)) > maxSheetNameLength {
+		return string([]rune(name)[:maxSheetNameLength])
+	}
+	return name
+}
+
+// Letters of a zero based column index e.g. 27 -> "AB". Inverse of columnIndex
+func columnName(index int) string {
+	name := ""
+	for index += 1; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,39 @@
+package spreadsheet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteXLSXRoundTrip(t *testing.T) {
+	sheets := []Sheet{
+		{Name: "assets", Rows: [][]string{{"caseref", "title"}, {"1", "Survey <2019> & more"}, {"2", "", "=SUM(A1)"}}},
+		{Name: "a/very:long[sheet]name that will be truncated", Rows: [][]string{{"value"}}},
+	}
+	content, err := WriteXLSX(sheets...)
+	require.NoError(t, err)
+
+	read, err := ReadXLSX(content)
+	require.NoError(t, err)
+	require.Len(t, read, 2)
+	assert.Equal(t, sheets[0], read[0])
+	assert.Equal(t, "a_very_long_sheet_name that wil", read[1].Name)
+	assert.Equal(t, sheets[1].Rows, read[1].Rows)
+}
+
+func TestWriteCSV(t *testing.T) {
+	content, err := WriteCSV(Sheet{Rows: [][]string{{"caseref", "title"}, {"1", "Survey, 2019"}, {"2", "=HYPERLINK()"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "caseref,title\n1,\"Survey, 2019\"\n2,'=HYPERLINK()\n", string(content))
+}
+
+func TestColumnName(t *testing.T) {
+	for expected, index := range map[string]int{"A": 0, "Z": 25, "AA": 26, "AB": 27, "BA": 52} {
+		assert.Equal(t, expected, columnName(index))
+		reference, err := columnIndex(expected + "1")
+		assert.NoError(t, err)
+		assert.Equal(t, index, reference)
+	}
+}
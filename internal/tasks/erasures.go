@@ -0,0 +1,5 @@
+package tasks
+
+func (m *Manager) retryErasureCleanups() error {
+	return m.erasures.RetryErasureCleanups()
+}
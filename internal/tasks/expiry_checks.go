@@ -47,10 +47,15 @@ func (m *Manager) checkContractsExpiry() error {
 		return nil
 	}
 
+	// Notify on the latest effective terms of each contract
+	if err := m.applyContractAmendments(); err != nil {
+		return err
+	}
+
 	ctx := context.Background()
 
 	studies := []types.Study{}
-	result := m.db.Model(&types.Study{}).Preload("Owner").Preload("StudyAdmins.User").Preload("Contracts").Find(&studies)
+	result := m.db.Model(&types.Study{}).Preload("Owner").Preload("StudyAdmins.User").Preload("Contracts.Successor").Find(&studies)
 	if result.Error != nil {
 		return types.NewErrFromGorm(result.Error, "failed to get studies")
 	}
@@ -92,6 +97,54 @@ func earliestExpringContractShouldNotifyExpiry(study types.Study) *types.Contrac
 	return expiringContract
 }
 
+func (m *Manager) checkRegulatoryApprovalsExpiry() error {
+	if !config.NotificationsEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	studies := []types.Study{}
+	result := m.db.Model(&types.Study{}).Preload("Owner").Preload("StudyAdmins.User").Preload("Approvals").Find(&studies)
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to get studies")
+	}
+
+	for _, study := range studies {
+		if study.IsClosed() {
+			continue
+		}
+
+		approval := earliestExpiringApprovalShouldNotifyExpiry(study)
+		if approval == nil {
+			continue
+		}
+
+		log.Debug().Str("study", study.Title).Str("approval", approval.Reference).Msg("Notifying approval expiry")
+		err := m.notifications.NotifyRegulatoryApprovalExpiry(ctx, *approval, study)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Return the approval with the most urgent expiry notification.
+// Returns nil if there are no approvals that should notify the expiry for
+func earliestExpiringApprovalShouldNotifyExpiry(study types.Study) *types.RegulatoryApproval {
+	var expiringApproval *types.RegulatoryApproval
+	for _, approval := range study.Approvals {
+		if !config.ShouldNotifyRegulatoryApprovalExpiry(approval) {
+			continue
+		}
+		if expiringApproval == nil || *config.DaysUntilRegulatoryApprovalExpiry(approval) < *config.DaysUntilRegulatoryApprovalExpiry(*expiringApproval) {
+			expiringApproval = &approval
+		}
+	}
+	return expiringApproval
+}
+
 func (m *Manager) checkTrainingCertificatesExpiry() error {
 	if !config.NotificationsEnabled() {
 		return nil
@@ -145,3 +198,32 @@ func (m *Manager) checkStudySignoffExpiry() error {
 	}
 	return nil
 }
+
+func (m *Manager) checkDpiaReviewExpiry() error {
+	if !config.NotificationsEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	dpias := []types.Dpia{}
+	result := m.db.Model(&types.Dpia{}).
+		Where("status = ?", types.DpiaStatusSignedOff).
+		Preload("Study.Owner").
+		Find(&dpias)
+	if result.Error != nil {
+		return types.NewErrFromGorm(result.Error, "failed to get DPIAs")
+	}
+
+	for _, dpia := range dpias {
+		if !config.ShouldNotifyDpiaReview(dpia) {
+			continue
+		}
+
+		log.Debug().Str("study", dpia.Study.Title).Msg("Notifying DPIA review")
+		if err := m.notifications.NotifyDpiaReview(ctx, dpia); err != nil {
+			return err
+		}
+	}
+	return nil
+}
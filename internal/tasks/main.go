@@ -10,7 +10,10 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/ucl-arc-tre/portal/internal/config"
 	"github.com/ucl-arc-tre/portal/internal/graceful"
+	"github.com/ucl-arc-tre/portal/internal/service/erasures"
 	"github.com/ucl-arc-tre/portal/internal/service/notifications"
+	"github.com/ucl-arc-tre/portal/internal/service/objects"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
 	"github.com/ucl-arc-tre/portal/internal/service/users"
 	"gorm.io/gorm"
 )
@@ -20,6 +23,9 @@ type Manager struct {
 	db            *gorm.DB
 	notifications notifications.Interface
 	users         *users.Service
+	studies       *studies.Service
+	objects       *objects.Service
+	erasures      *erasures.Service
 }
 
 // Create a task manager instance
@@ -29,6 +35,9 @@ func New() *Manager {
 		db:            graceful.NewDB(),
 		notifications: notifications.New(),
 		users:         users.New(),
+		studies:       studies.New(),
+		objects:       objects.New(),
+		erasures:      erasures.New(),
 	}
 	return &manager
 }
@@ -36,10 +45,25 @@ func New() *Manager {
 // Start the task manager - non blocking
 func (m *Manager) Start() {
 	m.mustEvery(config.Day, m.checkAssetsExpiry, "checkAssetsExpiry")
+	m.mustEvery(config.Day, m.applyContractAmendments, "applyContractAmendments")
 	m.mustEvery(config.Day, m.checkContractsExpiry, "checkContractsExpiry")
+	m.mustEvery(config.Day, m.checkRegulatoryApprovalsExpiry, "checkRegulatoryApprovalsExpiry")
 	m.mustEvery(config.Day, m.checkTrainingCertificatesExpiry, "checkTrainingCertificatesExpiry")
 	m.mustEvery(config.Day, m.checkStudySignoffExpiry, "checkStudySignoffExpiry")
+	m.mustEvery(config.Day, m.checkDpiaReviewExpiry, "checkDpiaReviewExpiry")
 	m.mustEvery(config.Day, m.updateUserEmails, "updateUserEmails")
+	m.mustEvery(config.Day, m.updateStudyRisks, "updateStudyRisks")
+	m.mustEvery(config.Day, m.expireStudyOwnerChanges, "expireStudyOwnerChanges")
+	m.mustEvery(config.Day, m.suspendLapsedStudyProjects, "suspendLapsedStudyProjects")
+	m.mustEvery(config.Day, m.enforceAssetsExpiry, "enforceAssetsExpiry")
+	m.mustEvery(config.S3VerificationInterval(), m.verifyStoredObjects, "verifyStoredObjects")
+	m.mustEvery(time.Hour, m.retryErasureCleanups, "retryErasureCleanups")
+	if config.MalwareScanning().Enabled {
+		m.mustEvery(time.Hour, m.scanQuarantinedObjects, "scanQuarantinedObjects")
+	}
+	if policy := config.AssetRegisterExport(); policy.Enabled {
+		m.mustEvery(policy.Interval, m.exportAssetRegister, "exportAssetRegister")
+	}
 
 	m.scheduler.Start()
 }
@@ -0,0 +1,7 @@
+package tasks
+
+import "context"
+
+func (m *Manager) verifyStoredObjects() error {
+	return m.objects.VerifyStoredObjects(context.Background())
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/ucl-arc-tre/portal/internal/config"
+	"github.com/ucl-arc-tre/portal/internal/service/studies"
+	"github.com/ucl-arc-tre/portal/internal/types"
+)
+
+// Keep study risk ratings current with the scoring rules, including for studies
+// whose assets predate them
+func (m *Manager) updateStudyRisks() error {
+	return studies.UpdateAllStudyRisks(m.db)
+}
+
+// Expire owner change requests which were never approved, rejected or cancelled
+func (m *Manager) expireStudyOwnerChanges() error {
+	return m.studies.ExpireStaleOwnerChanges(context.Background())
+}
+
+// Suspend the TRE projects of studies whose signoff lapsed beyond the grace period
+func (m *Manager) suspendLapsedStudyProjects() error {
+	return m.studies.SuspendLapsedStudyProjects(context.Background())
+}
+
+// Store an export of the information asset register in S3
+func (m *Manager) exportAssetRegister() error {
+	_, err := m.studies.StoreAssetRegisterExport(context.Background(), types.AssetRegisterFormat(config.AssetRegisterExport().Format))
+	return err
+}
+
+// Flag assets which are past their expiry and raise reviews for their owners
+func (m *Manager) enforceAssetsExpiry() error {
+	return m.studies.EnforceAssetExpiries(context.Background())
+}
+
+// Make approved contract amendments the current terms once they are effective
+func (m *Manager) applyContractAmendments() error {
+	return m.studies.ApplyDueContractAmendments()
+}
+
+// Scan uploaded objects left in quarantine e.g. as the scanner was unavailable
+func (m *Manager) scanQuarantinedObjects() error {
+	return m.studies.ScanQuarantinedContractObjects(context.Background())
+}
@@ -20,11 +20,13 @@ func (m *MockS3) StoreObject(ctx context.Context, metadata s3.ObjectMetadata, ob
 }
 
 func (m *MockS3) GetObject(ctx context.Context, metadata s3.ObjectMetadata) (types.S3Object, error) {
-	panic("not implemented")
+	args := m.Called(ctx, metadata)
+	return args.Get(0).(types.S3Object), args.Error(1)
 }
 
 func (m *MockS3) DeleteObject(metadata s3.ObjectMetadata) error {
-	panic("not implemented")
+	args := m.Called(metadata)
+	return args.Error(0)
 }
 
 func MockS3Object(data string) types.S3Object {
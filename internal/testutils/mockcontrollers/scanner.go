@@ -0,0 +1,18 @@
+package mockcontrollers
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/ucl-arc-tre/portal/internal/controller/scanner"
+)
+
+type MockScanner struct {
+	mock.Mock
+}
+
+func (m *MockScanner) Scan(ctx context.Context, content io.Reader) (scanner.Result, error) {
+	args := m.Called(ctx, content)
+	return args.Get(0).(scanner.Result), args.Error(1)
+}
@@ -35,8 +35,8 @@ func NewTestDBSchema(t *testing.T, migrate Migrator) *gorm.DB {
 	adminDB, err := gorm.Open(postgres.Open(baseDSN), &gorm.Config{})
 	require.NoError(err, "admin connect")
 
-	// run a one-time bootstrap step for creating extension & sequence;
-	// make sure the extension & sequence are created before creating the schema
+	// run a one-time bootstrap step for creating extensions & sequence;
+	// make sure the extensions & sequence are created before creating the schema
 	setupOnce.Do(func() {
 		fmt.Printf("adminDB bootstrap (extension + sequence)\n")
 
@@ -54,7 +54,8 @@ func NewTestDBSchema(t *testing.T, migrate Migrator) *gorm.DB {
 			}
 			err1 := adminDB.Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`).Error
 			err2 := adminDB.Exec(`CREATE SEQUENCE IF NOT EXISTS study_caseref_seq START 10000;`).Error
-			if err1 == nil && err2 == nil {
+			err3 := adminDB.Exec(`CREATE EXTENSION IF NOT EXISTS "pg_trgm";`).Error
+			if err1 == nil && err2 == nil && err3 == nil {
 				return
 			}
 			time.Sleep(connectRetryDelay)
@@ -28,12 +28,20 @@ func (s *MockNotifications) NotifyContractExpiry(ctx context.Context, contract t
 	panic("not-implemented")
 }
 
+func (s *MockNotifications) NotifyRegulatoryApprovalExpiry(ctx context.Context, approval types.RegulatoryApproval, study types.Study) error {
+	panic("not-implemented")
+}
+
 func (s *MockNotifications) NotifyTrainingExpiry(ctx context.Context, training types.UserTrainingRecord) error {
 	panic("not-implemented")
 }
 
 func (s *MockNotifications) NotifyStudyReview(ctx context.Context, study types.Study, igOpsStaff []types.User) error {
-	panic("not-implemented")
+	return nil
+}
+
+func (s *MockNotifications) NotifyReviewerAssignment(ctx context.Context, study types.Study, reviewer types.User) error {
+	return nil
 }
 
 func (s *MockNotifications) NotifyIaaAssignment(ctx context.Context, iaa types.User, study types.Study) error {
@@ -48,10 +56,30 @@ func (s *MockNotifications) NotifyAssetExpiry(ctx context.Context, assets []type
 	panic("not-implemented")
 }
 
+func (s *MockNotifications) NotifyAssetExpiryEnforced(ctx context.Context, study types.Study, review types.AssetExpiryReview) error {
+	return nil
+}
+
+func (s *MockNotifications) NotifyMalwareDetected(ctx context.Context, contract types.Contract, object types.ContractObjectMetadata, igOpsStaff []types.User) error {
+	return nil
+}
+
+func (s *MockNotifications) NotifyStoredObjectsFailedVerification(ctx context.Context, objects []types.StoredObject, admins []types.User) error {
+	return nil
+}
+
+func (s *MockNotifications) NotifyDpiaReview(ctx context.Context, dpia types.Dpia) error {
+	panic("not-implemented")
+}
+
 func (s *MockNotifications) NotifyOwnerChange(ctx context.Context, study types.Study, igOpsStaff []types.User) error {
 	panic("not-implemented")
 }
 
+func (s *MockNotifications) NotifyOwnerChangeOutcome(ctx context.Context, study types.Study, change types.StudyOwnerChangelog) error {
+	return nil
+}
+
 func (s *MockNotifications) NotifyUserNameChange(attrs types.UserAttributes, igOpsStaff []types.User) error {
 	panic("not-implemented")
 }
@@ -59,3 +87,7 @@ func (s *MockNotifications) NotifyUserNameChange(attrs types.UserAttributes, igO
 func (s *MockNotifications) NotifyProjectDeployed(project types.Project, user types.User) error {
 	panic("not-implemented")
 }
+
+func (s *MockNotifications) NotifyProjectSuspension(ctx context.Context, study types.Study, change types.ProjectTRESuspensionChangelog) error {
+	return nil
+}
@@ -115,5 +115,6 @@ func (m *MockUsers) AllApprovedResearchers() ([]users.ApprovedResearcherExportRe
 }
 
 func (m *MockUsers) UsersWithConfigRole(role rbac.ConfigRolename) ([]types.User, error) {
-	panic("not implemented")
+	args := m.Called(role)
+	return args.Get(0).([]types.User), args.Error(1)
 }
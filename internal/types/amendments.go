@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ContractVersionStatusPending  = ContractVersionStatus("pending")
+	ContractVersionStatusApproved = ContractVersionStatus("approved")
+	ContractVersionStatusRejected = ContractVersionStatus("rejected")
+)
+
+type ContractVersionStatus string
+
+// Terms of a contract from an effective date. Version 1 holds the original
+// terms and each later version an amendment, which becomes the current terms
+// of the contract once approved and effective
+type ContractVersion struct {
+	Model
+	UpdatedAt        time.Time
+	ContractID       uuid.UUID             `gorm:"not null;uniqueIndex:idx_contract_version"`
+	Version          int                   `gorm:"not null;uniqueIndex:idx_contract_version"`
+	Status           ContractVersionStatus `gorm:"not null;index"`
+	EffectiveDate    time.Time             `gorm:"not null"`
+	Reason           string                `gorm:"type:text;not null"`
+	ChangedFields    []string              `gorm:"serializer:json"` // Relative to the previous effective version
+	RequesterUserID  uuid.UUID             `gorm:"not null"`
+	ReviewerUserID   *uuid.UUID
+	ReviewerComments *string `gorm:"type:text"`
+	ReviewedAt       *time.Time
+	AppliedAt        *time.Time // When the terms became those of the contract
+
+	// Terms
+	Title            string
+	SignatoryUserID  *uuid.UUID
+	ThirdPartyName   *string
+	OtherSignatories *string
+	ContractStatus   ContractStatus
+	StartDate        *time.Time
+	ExpiryDate       *time.Time
+	AssetIDs         []uuid.UUID `gorm:"serializer:json"`
+
+	// Relationships
+	Contract      Contract                 `gorm:"foreignKey:ContractID"`
+	RequesterUser User                     `gorm:"foreignKey:RequesterUserID"`
+	ReviewerUser  *User                    `gorm:"foreignKey:ReviewerUserID"`
+	SignatoryUser *User                    `gorm:"foreignKey:SignatoryUserID"`
+	Objects       []ContractObjectMetadata `gorm:"foreignKey:ContractVersionID"`
+}
+
+// Whether the version is approved but not yet the current terms of the contract
+func (v ContractVersion) IsAwaitingEffect() bool {
+	return v.Status == ContractVersionStatusApproved && v.AppliedAt == nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RegulatoryApprovalType = string
+
+// Ethics or regulatory approval of a study e.g. from a research ethics committee
+type RegulatoryApproval struct {
+	ModelAuditable
+	StudyID        uuid.UUID              `gorm:"not null;index"`
+	CreatorUserID  uuid.UUID              `gorm:"not null"`
+	Type           RegulatoryApprovalType `gorm:"not null"`
+	Reference      string                 `gorm:"not null"` // e.g. IRAS ID or CAG reference
+	IssuingBody    string                 `gorm:"not null"`
+	GrantedDate    time.Time              `gorm:"not null"`
+	ExpiryDate     *time.Time             // Does not expire if nil
+	Conditions     *string                `gorm:"type:text"`
+	LetterFilename *string                // Letter is stored in S3 under the approval ID. Not uploaded if nil
+
+	// Relationships
+	Study       Study `gorm:"foreignKey:StudyID"`
+	CreatorUser User  `gorm:"foreignKey:CreatorUserID"`
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AssetDestructionStatusPending  = AssetDestructionStatus("pending")
+	AssetDestructionStatusApproved = AssetDestructionStatus("approved")
+	AssetDestructionStatusRejected = AssetDestructionStatus("rejected")
+)
+
+type AssetDestructionMethod = string
+
+type AssetDestructionStatus string
+
+// Request to mark an asset as destroyed, approved by IG operations staff. The
+// certificate of destruction is stored in S3 under the request ID
+type AssetDestruction struct {
+	Model
+	UpdatedAt           time.Time
+	AssetID             uuid.UUID              `gorm:"not null;index"`
+	RequesterUserID     uuid.UUID              `gorm:"not null"`
+	Method              AssetDestructionMethod `gorm:"not null"`
+	DestroyedAt         time.Time              `gorm:"not null"`
+	CertificateFilename string                 `gorm:"not null"`
+	Status              AssetDestructionStatus `gorm:"not null;index"`
+	ReviewerUserID      *uuid.UUID
+	ReviewerComments    *string `gorm:"type:text"`
+	ReviewedAt          *time.Time
+
+	// Relationships
+	Asset         Asset `gorm:"foreignKey:AssetID"`
+	RequesterUser User  `gorm:"foreignKey:RequesterUserID"`
+	ReviewerUser  *User `gorm:"foreignKey:ReviewerUserID"`
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+type StudyDocumentCategory = string
+
+// Evidence document of a study e.g. a data management plan. Each upload
+// is stored as a new version so earlier evidence is retained
+type StudyDocument struct {
+	ModelAuditable
+	StudyID       uuid.UUID             `gorm:"not null;index"`
+	CreatorUserID uuid.UUID             `gorm:"not null"`
+	Category      StudyDocumentCategory `gorm:"not null"`
+	Title         string                `gorm:"not null"`
+
+	// Relationships
+	Study       Study                  `gorm:"foreignKey:StudyID"`
+	CreatorUser User                   `gorm:"foreignKey:CreatorUserID"`
+	Versions    []StudyDocumentVersion `gorm:"foreignKey:DocumentID"`
+}
+
+// Uploaded version of a study document. The content is stored in S3 under the version ID
+type StudyDocumentVersion struct {
+	Model
+	DocumentID    uuid.UUID `gorm:"not null;uniqueIndex:idx_study_document_version"`
+	Version       int       `gorm:"not null;uniqueIndex:idx_study_document_version"`
+	Filename      string    `gorm:"not null"`
+	CreatorUserID uuid.UUID `gorm:"not null"`
+
+	// Relationships
+	Document    StudyDocument `gorm:"foreignKey:DocumentID"`
+	CreatorUser User          `gorm:"foreignKey:CreatorUserID"`
+}
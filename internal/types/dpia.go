@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DpiaStatus = string
+
+const (
+	DpiaStatusDraft     = "draft"      // Being completed by the study owner/admins
+	DpiaStatusSubmitted = "submitted"  // Awaiting DPO sign-off
+	DpiaStatusSignedOff = "signed_off" // Signed off by the DPO until the review date
+)
+
+// Data Protection Impact Assessment of a study
+type Dpia struct {
+	ModelAuditable
+	StudyID           uuid.UUID  `gorm:"not null;uniqueIndex"`
+	Status            DpiaStatus `gorm:"not null;default:'draft'"`
+	TriggerReason     string     `gorm:"not null"` // Why the DPIA was required e.g. special category data
+	SignedOffByUserID *uuid.UUID
+	SignedOffAt       *time.Time
+	ReviewDate        *time.Time // Set at sign-off. The DPIA must be reviewed before this date
+
+	// Relationships
+	Study           Study        `gorm:"foreignKey:StudyID"`
+	SignedOffByUser *User        `gorm:"foreignKey:SignedOffByUserID"`
+	Answers         []DpiaAnswer `gorm:"foreignKey:DpiaID"`
+	Risks           []DpiaRisk   `gorm:"foreignKey:DpiaID"`
+}
+
+func (d Dpia) IsSignedOff() bool {
+	return d.Status == DpiaStatusSignedOff
+}
+
+type DpiaAnswer struct {
+	Model
+	DpiaID   uuid.UUID `gorm:"not null;index"`
+	Question string    `gorm:"not null"` // Question key e.g. processing_description
+	Answer   string    `gorm:"type:text;not null"`
+
+	// Relationships
+	Dpia Dpia `gorm:"foreignKey:DpiaID"`
+}
+
+type DpiaRiskLevel = string
+
+const (
+	DpiaRiskLevelLow    = "low"
+	DpiaRiskLevelMedium = "medium"
+	DpiaRiskLevelHigh   = "high"
+)
+
+// Identified risk to data subjects and how it is mitigated
+type DpiaRisk struct {
+	Model
+	DpiaID       uuid.UUID     `gorm:"not null;index"`
+	Description  string        `gorm:"type:text;not null"`
+	Likelihood   DpiaRiskLevel `gorm:"not null"`
+	Severity     DpiaRiskLevel `gorm:"not null"`
+	Mitigation   string        `gorm:"type:text;not null"`
+	ResidualRisk DpiaRiskLevel `gorm:"not null"` // After the mitigation has been applied
+
+	// Relationships
+	Dpia Dpia `gorm:"foreignKey:DpiaID"`
+}
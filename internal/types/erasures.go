@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErasureSubjectKindStudy = ErasureSubjectKind("study")
+	ErasureSubjectKindUser  = ErasureSubjectKind("user")
+
+	ErasureStatusPending   = ErasureStatus("pending")
+	ErasureStatusCompleted = ErasureStatus("completed")
+	ErasureStatusRejected  = ErasureStatus("rejected")
+)
+
+type ErasureSubjectKind string
+
+type ErasureStatus string
+
+// Request to permanently remove a study or the personal data of a user. Once
+// completed the erasure is kept as a tombstone proving it happened, so it must
+// not reference the subject other than by ID
+type Erasure struct {
+	Model
+	UpdatedAt       time.Time
+	SubjectKind     ErasureSubjectKind `gorm:"not null;index"`
+	SubjectID       uuid.UUID          `gorm:"not null;index"`
+	Reason          string             `gorm:"not null"`
+	Status          ErasureStatus      `gorm:"not null;index"`
+	RequesterUserID uuid.UUID          `gorm:"not null"`
+	ReviewerUserID  *uuid.UUID         // Second administrator who approved or rejected
+	Manifest        ErasureManifest    `gorm:"serializer:json"`
+	CompletedAt     *time.Time
+	Cleanup         *ErasureCleanup `gorm:"serializer:json"` // Left to remove after completion, nil once done
+
+	// Relationships
+	RequesterUser User  `gorm:"foreignKey:RequesterUserID"`
+	ReviewerUser  *User `gorm:"foreignKey:ReviewerUserID"`
+}
+
+// What an erasure will remove or, once completed, did remove
+type ErasureManifest struct {
+	Rows    map[string]int `json:"rows"`    // number of rows per table
+	Objects []string       `json:"objects"` // keys of stored objects
+}
+
+// Stored objects and roles of a completed erasure which are removed after its
+// rows, so a failure to remove them can be retried
+type ErasureCleanup struct {
+	Objects    []ErasureObject `json:"objects"`
+	ProjectIDs []uuid.UUID     `json:"projectIds"` // projects whose roles must be removed
+	Roles      bool            `json:"roles"`      // whether the roles of the subject remain
+}
+
+type ErasureObject struct {
+	ID   uuid.UUID `json:"id"`
+	Kind string    `json:"kind"`
+}
+
+func (c *ErasureCleanup) Done() bool {
+	return len(c.Objects) == 0 && !c.Roles
+}
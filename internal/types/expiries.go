@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AssetExpiryReviewStatusPending              = AssetExpiryReviewStatus("pending")
+	AssetExpiryReviewStatusDestructionConfirmed = AssetExpiryReviewStatus("destruction_confirmed")
+	AssetExpiryReviewStatusExtended             = AssetExpiryReviewStatus("extended")
+)
+
+type AssetExpiryReviewStatus string
+
+// Task raised for the study owner when the expiry of an asset is enforced. It
+// is resolved by confirming the asset will be destroyed or by extending the
+// expiry with a justification
+type AssetExpiryReview struct {
+	Model
+	UpdatedAt          time.Time
+	AssetID            uuid.UUID               `gorm:"not null;index"`
+	ExpiresAt          time.Time               `gorm:"not null"` // Expiry which was enforced
+	DetachedProjectIDs []uuid.UUID             `gorm:"serializer:json"`
+	Status             AssetExpiryReviewStatus `gorm:"not null;index"`
+	ResolverUserID     *uuid.UUID
+	Justification      *string `gorm:"type:text"`
+	ExtendedExpiresAt  *time.Time
+	ResolvedAt         *time.Time
+
+	// Relationships
+	Asset        Asset `gorm:"foreignKey:AssetID"`
+	ResolverUser *User `gorm:"foreignKey:ResolverUserID"`
+}
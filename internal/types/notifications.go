@@ -9,16 +9,22 @@ import (
 type NotificationKind string
 
 const (
-	NotificationKindCompleteProfile  = NotificationKind("complete-profile")
-	NotificationKindContractExpiry   = NotificationKind("contract-expiry")
-	NotificationKindAssetExpiry      = NotificationKind("asset-expiry")
-	NotificationKindTrainingExpiry   = NotificationKind("training-expiry")
-	NotificationKindIaaAssignment    = NotificationKind("iaa-assignment")
-	NotificationKindStdyAffirmation  = NotificationKind("study-affirmation")
-	NotificationKindStudyReview      = NotificationKind("study-review")
-	NotificationKindStudyOwnerChange = NotificationKind("study-owner-change")
-	NotificationKindUserNameChange   = NotificationKind("user-name-change")
-	NotificationKindProjectDeployed  = NotificationKind("project-deployed")
+	NotificationKindCompleteProfile   = NotificationKind("complete-profile")
+	NotificationKindContractExpiry    = NotificationKind("contract-expiry")
+	NotificationKindAssetExpiry       = NotificationKind("asset-expiry")
+	NotificationKindTrainingExpiry    = NotificationKind("training-expiry")
+	NotificationKindIaaAssignment     = NotificationKind("iaa-assignment")
+	NotificationKindStdyAffirmation   = NotificationKind("study-affirmation")
+	NotificationKindStudyReview       = NotificationKind("study-review")
+	NotificationKindStudyOwnerChange  = NotificationKind("study-owner-change")
+	NotificationKindUserNameChange    = NotificationKind("user-name-change")
+	NotificationKindProjectDeployed   = NotificationKind("project-deployed")
+	NotificationKindDpiaReview        = NotificationKind("dpia-review")
+	NotificationKindApprovalExpiry    = NotificationKind("approval-expiry")
+	NotificationKindProjectSuspended  = NotificationKind("project-suspended")
+	NotificationKindAssetExpiryReview = NotificationKind("asset-expiry-review")
+	NotificationKindMalwareDetected   = NotificationKind("malware-detected")
+	NotificationKindObjectIntegrity   = NotificationKind("object-integrity")
 )
 
 type Notification struct {
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StoredObjectStatus string
+
+const (
+	StoredObjectStatusOK      = StoredObjectStatus("ok")
+	StoredObjectStatusCorrupt = StoredObjectStatus("corrupt") // Content does not match its digest
+	StoredObjectStatusMissing = StoredObjectStatus("missing") // No longer exists in the bucket
+)
+
+// Integrity record of an object stored in S3, taken over its plaintext content at upload
+type StoredObject struct {
+	Model
+	UpdatedAt  time.Time
+	Kind       string             `gorm:"not null;uniqueIndex:idx_stored_object"`
+	ObjectID   uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_stored_object"`
+	Sha256     string             `gorm:"not null"` // Hex encoded
+	NumBytes   int64              `gorm:"not null"`
+	Status     StoredObjectStatus `gorm:"not null;default:ok;index"`
+	VerifiedAt *time.Time
+}
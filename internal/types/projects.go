@@ -92,8 +92,27 @@ const (
 	ProjectTREStatusDeployed        ProjectTREStatus = "deployed"         // Deployed and available to use
 	ProjectTREStatusPendingDeletion ProjectTREStatus = "pending-deletion" // Requested delete but not yet deleted
 	ProjectTREStatusDeleted         ProjectTREStatus = "deleted"          // Project and all its data has been deleted
+	ProjectTREStatusSuspended       ProjectTREStatus = "suspended"        // Deployed but access removed until the study is signed off
 )
 
+type ProjectTRESuspensionAction string
+
+const (
+	ProjectTRESuspensionActionSuspend   = ProjectTRESuspensionAction("suspend")
+	ProjectTRESuspensionActionReinstate = ProjectTRESuspensionAction("reinstate")
+)
+
+// Audit record of a TRE project being suspended or reinstated. Immutable
+type ProjectTRESuspensionChangelog struct {
+	Model
+	ProjectTREID uuid.UUID                  `gorm:"not null;index"`
+	Action       ProjectTRESuspensionAction `gorm:"not null"`
+	Reason       string                     `gorm:"not null"`
+
+	// Relationships
+	ProjectTRE ProjectTRE `gorm:"foreignKey:ProjectTREID"`
+}
+
 type ProjectTRERoleName string
 
 const (
@@ -212,5 +231,6 @@ const (
 type ProjectDSHStatus string
 
 const (
-	ProjectDSHStatusActive = "active"
+	ProjectDSHStatusActive  = "active"
+	ProjectDSHStatusRetired = "retired" // Share no longer in use e.g. the study has closed
 )
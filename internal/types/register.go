@@ -0,0 +1,17 @@
+package types
+
+const (
+	AssetRegisterFormatCSV  = AssetRegisterFormat("csv")
+	AssetRegisterFormatXLSX = AssetRegisterFormat("xlsx")
+	AssetRegisterFormatJSON = AssetRegisterFormat("json")
+)
+
+type AssetRegisterFormat string
+
+// Export of the information asset register stored in S3 under its ID
+type AssetRegisterExport struct {
+	Model
+	Format    AssetRegisterFormat `gorm:"not null"`
+	Filename  string              `gorm:"not null"`
+	NumAssets int                 `gorm:"not null"`
+}
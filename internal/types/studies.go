@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -35,6 +37,10 @@ type Study struct {
 	InvolvesDataProcessingOutsideEea *bool               `gorm:""`
 	ApprovalStatus                   StudyApprovalStatus `gorm:"not null"`
 	Feedback                         *string             `gorm:"type:text"`
+	ReviewerUserID                   *uuid.UUID          `gorm:"index"` // IG ops staff member handling the review. Unassigned if nil
+	RiskScore                        *int                // Of the highest risk asset. Unrated if nil
+	RiskRating                       *StudyRiskRating    `gorm:"index"`
+	HighestAssetTier                 *int                // Of the active assets. Sets the signoff cadence. No assets if nil
 	LastSignoff                      *time.Time
 	// caseref sequence starts at 10000 for portal studies while 0-9999 is reserved for legacy studies that will be migrated from sharepoint
 	// study_caseref_seq defined in internal/graceful/db.go
@@ -42,10 +48,17 @@ type Study struct {
 
 	// Relationships
 	Owner           User                  `gorm:"foreignKey:OwnerUserID"`
+	Reviewer        *User                 `gorm:"foreignKey:ReviewerUserID"`
 	Assets          []Asset               `gorm:"foreignKey:StudyID"`
 	StudyAdmins     []StudyAdmin          `gorm:"foreignKey:StudyID"`
 	Contracts       []Contract            `gorm:"foreignKey:StudyID"`
 	OwnerChangelogs []StudyOwnerChangelog `gorm:"foreignKey:StudyID"`
+	Dpia            *Dpia                 `gorm:"foreignKey:StudyID"`
+	Approvals       []RegulatoryApproval  `gorm:"foreignKey:StudyID"`
+}
+
+func (s Study) IsClosed() bool {
+	return slices.Contains(StudyClosureApprovalStatuses, s.ApprovalStatus)
 }
 
 // Latest study owner changelog record. Optional
@@ -59,6 +72,15 @@ func (s Study) LatestOwnerChange() *StudyOwnerChangelog {
 	return latest
 }
 
+// Owner change request awaiting a decision. Optional
+func (s Study) PendingOwnerChange() *StudyOwnerChangelog {
+	latest := s.LatestOwnerChange()
+	if latest == nil || !latest.IsPending(s.OwnerUserID) {
+		return nil
+	}
+	return latest
+}
+
 type StudyApprovalStatus = string
 
 const (
@@ -66,30 +88,129 @@ const (
 	StudyApprovalStatusIncomplete = "Incomplete"
 	StudyApprovalStatusPending    = "Pending"
 	StudyApprovalStatusRejected   = "Rejected"
+	StudyApprovalStatusClosing    = "Closing"  // Research finished. Awaiting asset destruction/transfer and contract closure
+	StudyApprovalStatusClosed     = "Closed"   // Closure complete
+	StudyApprovalStatusArchived   = "Archived" // Closed and archived by IG
+)
+
+// Statuses of a study that has started or completed closure
+var StudyClosureApprovalStatuses = []StudyApprovalStatus{
+	StudyApprovalStatusClosing,
+	StudyApprovalStatusClosed,
+	StudyApprovalStatusArchived,
+}
+
+type StudyRiskRating = string
+
+const (
+	StudyRiskRatingManageable    = "manageable"
+	StudyRiskRatingUncomfortable = "uncomfortable"
+	StudyRiskRatingVulnerable    = "vulnerable"
+	StudyRiskRatingCritical      = "critical"
 )
 
 type StudyOwnerChangelogAction string
 
 const (
-	StudyOwnerChangelogActionRequest = "request"
-	StudyOwnerChangelogActionApprove = "approve"
+	StudyOwnerChangelogActionRequest = StudyOwnerChangelogAction("request")
+	StudyOwnerChangelogActionApprove = StudyOwnerChangelogAction("approve")
+	StudyOwnerChangelogActionReject  = StudyOwnerChangelogAction("reject")
+	StudyOwnerChangelogActionCancel  = StudyOwnerChangelogAction("cancel")
+	StudyOwnerChangelogActionExpire  = StudyOwnerChangelogAction("expire")
 )
 
 type StudyOwnerChangelog struct {
 	Model
 	StudyID    uuid.UUID                 `gorm:"not null;index"`
-	UserID     uuid.UUID                 `gorm:"not null;index"` // User took the action
+	UserID     *uuid.UUID                `gorm:"index"` // User took the action. Nil if automatic e.g. expiry
 	FromUserID uuid.UUID                 `gorm:"not null;index"`
 	ToUserID   uuid.UUID                 `gorm:"not null;index"`
 	Action     StudyOwnerChangelogAction `gorm:"not null"`
+	Reason     *string                   // Given on reject and cancel
 
 	// Relationships
 	Study    Study `gorm:"foreignKey:StudyID"`
-	User     User  `gorm:"foreignKey:UserID"`
+	User     *User `gorm:"foreignKey:UserID"`
 	FromUser User  `gorm:"foreignKey:FromUserID"`
 	ToUser   User  `gorm:"foreignKey:ToUserID"`
 }
 
+// Is this a request still awaiting a decision, given it is the latest
+// change and the current owner of the study
+func (c StudyOwnerChangelog) IsPending(ownerUserID uuid.UUID) bool {
+	return c.Action == StudyOwnerChangelogActionRequest && c.FromUserID == ownerUserID
+}
+
+// Immutable record of an attestation that the study details are up to date
+type StudySignoff struct {
+	Model
+	StudyID     uuid.UUID `gorm:"not null;index"`
+	UserID      uuid.UUID `gorm:"not null;index"` // User who signed off
+	AgreementID uuid.UUID `gorm:"not null;index"` // Version of the attestation text shown
+	Comments    *string   `gorm:"type:text"`
+
+	// Relationships
+	Study     Study     `gorm:"foreignKey:StudyID"`
+	User      User      `gorm:"foreignKey:UserID"`
+	Agreement Agreement `gorm:"foreignKey:AgreementID"`
+}
+
+// Immutable record of the fields changed by a single save of a study
+type StudyRevision struct {
+	Model
+	StudyID uuid.UUID `gorm:"not null;index"`
+	UserID  uuid.UUID `gorm:"not null;index"` // User who saved the study
+
+	// Relationships
+	Study   Study                 `gorm:"foreignKey:StudyID"`
+	User    User                  `gorm:"foreignKey:UserID"`
+	Changes []StudyRevisionChange `gorm:"foreignKey:RevisionID"`
+}
+
+type StudyRevisionChange struct {
+	Model
+	RevisionID uuid.UUID `gorm:"not null;index"`
+	Field      string    `gorm:"not null"` // JSON name of the study field e.g. title
+	OldValue   *string   `gorm:"type:text"`
+	NewValue   *string   `gorm:"type:text"`
+
+	// Relationships
+	Revision StudyRevision `gorm:"foreignKey:RevisionID"`
+}
+
+// Reviewer comment thread on a study field, or a field of one of its assets
+type StudyReviewThread struct {
+	Model
+	StudyID          uuid.UUID  `gorm:"not null;index"`
+	AssetID          *uuid.UUID `gorm:"index"` // Set if the thread is on an asset field
+	CreatorUserID    uuid.UUID  `gorm:"not null"`
+	Field            string     `gorm:"not null"` // JSON name of the field e.g. title
+	ResolvedAt       *time.Time
+	ResolvedByUserID *uuid.UUID
+
+	// Relationships
+	Study          Study                `gorm:"foreignKey:StudyID"`
+	Asset          *Asset               `gorm:"foreignKey:AssetID"`
+	CreatorUser    User                 `gorm:"foreignKey:CreatorUserID"`
+	ResolvedByUser *User                `gorm:"foreignKey:ResolvedByUserID"`
+	Comments       []StudyReviewComment `gorm:"foreignKey:ThreadID"`
+}
+
+func (t StudyReviewThread) IsResolved() bool {
+	return t.ResolvedAt != nil
+}
+
+type StudyReviewComment struct {
+	Model
+	ThreadID uuid.UUID `gorm:"not null;index"`
+	UserID   uuid.UUID `gorm:"not null"`
+	Body     string    `gorm:"type:text;not null"`
+
+	// Relationships
+	Thread StudyReviewThread `gorm:"foreignKey:ThreadID"`
+	User   User              `gorm:"foreignKey:UserID"`
+}
+
 // Queried via the DSH API
 type DSHStudyExportRecord struct {
 	Caseref        int
@@ -130,8 +251,9 @@ func (s StudyAdmin) IsDeleted() bool {
 type AssetStatus = string
 
 const (
-	AssetStatusActive    = "active"
-	AssetStatusDestroyed = "destroyed"
+	AssetStatusActive      = "active"
+	AssetStatusDestroyed   = "destroyed"
+	AssetStatusTransferred = "transferred" // Responsibility passed to another organisation. Only set by legacy imports as transfers between studies move the asset
 )
 
 type Asset struct {
@@ -145,6 +267,7 @@ type Asset struct {
 	Protection                    *string
 	Format                        string `gorm:"not null"`
 	ExpiresAt                     *time.Time
+	ExpiredAt                     *time.Time  // When the expiry was enforced. Cleared when it is extended
 	RequiresContract              bool        `gorm:"not null;default:false"`
 	HasDspt                       bool        `gorm:"not null;default:false"`
 	StoredOutsideUkEea            bool        `gorm:"not null;default:false"`
@@ -164,12 +287,17 @@ type Asset struct {
 	Locations   []AssetLocation `gorm:"foreignKey:AssetID"`
 	Contracts   []Contract      `gorm:"many2many:contract_assets;"`
 	DataTypes   []AssetDataType `gorm:"foreignKey:AssetID"`
+
+	InheritedContracts []Contract `gorm:"-"` // Of the assets it was derived from. Set by the studies service
 }
 
+// Free text locations of the asset
 func (a Asset) LocationStrings() []string {
 	locationsStrings := []string{}
 	for _, location := range a.Locations {
-		locationsStrings = append(locationsStrings, location.Location)
+		if location.Kind == AssetLocationKindOther {
+			locationsStrings = append(locationsStrings, location.Location)
+		}
 	}
 	return locationsStrings
 }
@@ -178,17 +306,62 @@ func (a Asset) IsDestroyed() bool {
 	return a.Status == AssetStatusDestroyed
 }
 
+func (a Asset) IsTransferred() bool {
+	return a.Status == AssetStatusTransferred
+}
+
+func (a Asset) IsExpired() bool {
+	return a.ExpiredAt != nil
+}
+
+type AssetLocationKind string
+
+const (
+	AssetLocationKindEnvironment    = AssetLocationKind("environment")
+	AssetLocationKindProject        = AssetLocationKind("project")
+	AssetLocationKindExternalSystem = AssetLocationKind("external_system")
+	AssetLocationKindOther          = AssetLocationKind("other")
+)
+
+// Place an asset is stored in. Environment and project locations reference the
+// portal record, with its name kept in Location
 type AssetLocation struct {
 	ModelAuditable
-	AssetID  uuid.UUID `gorm:"not null;index"`
-	Location string    `gorm:"not null"`
+	AssetID       uuid.UUID         `gorm:"not null;index"`
+	Kind          AssetLocationKind `gorm:"not null;default:other;index"`
+	Location      string            `gorm:"not null"`
+	EnvironmentID *uuid.UUID        `gorm:"index"`
+	ProjectID     *uuid.UUID        `gorm:"index"`
 
 	// Relationships
-	Asset Asset `gorm:"foreignKey:AssetID"`
+	Asset       Asset        `gorm:"foreignKey:AssetID"`
+	Environment *Environment `gorm:"foreignKey:EnvironmentID"`
+	Project     *Project     `gorm:"foreignKey:ProjectID"`
 }
 
 func (a AssetLocation) UniqueKey() string {
-	return fmt.Sprintf("%v%v", a.AssetID, a.Location)
+	return a.AssetID.String() + a.PlaceKey()
+}
+
+// Identifies the place independent of the asset stored there
+func (a AssetLocation) PlaceKey() string {
+	switch {
+	case a.EnvironmentID != nil:
+		return fmt.Sprintf("%v%v", a.Kind, *a.EnvironmentID)
+	case a.ProjectID != nil:
+		return fmt.Sprintf("%v%v", a.Kind, *a.ProjectID)
+	}
+	return fmt.Sprintf("%v%v", a.Kind, a.Location)
+}
+
+// Name of the location, preferring that of the referenced environment or project
+func (a AssetLocation) Name() string {
+	if a.Environment != nil {
+		return string(a.Environment.Name)
+	} else if a.Project != nil {
+		return a.Project.Name
+	}
+	return a.Location
 }
 
 func (a AssetLocation) IsDeleted() bool {
@@ -212,11 +385,34 @@ func (a AssetDataType) IsDeleted() bool {
 	return a.ModelAuditable.IsDeleted()
 }
 
+type AssetDerivationType = string
+
+const (
+	AssetDerivationTypeSubset        = "subset"
+	AssetDerivationTypePseudonymised = "pseudonymised"
+	AssetDerivationTypeAggregated    = "aggregated"
+	AssetDerivationTypeLinked        = "linked" // Combined with other assets
+)
+
+// Link from an asset to a parent asset it was derived from
+type AssetLink struct {
+	Model
+	ParentAssetID  uuid.UUID           `gorm:"not null;uniqueIndex:idx_asset_link"`
+	ChildAssetID   uuid.UUID           `gorm:"not null;uniqueIndex:idx_asset_link;index"`
+	DerivationType AssetDerivationType `gorm:"not null"`
+	CreatorUserID  uuid.UUID           `gorm:"not null"`
+
+	// Relationships
+	ParentAsset Asset `gorm:"foreignKey:ParentAssetID"`
+	ChildAsset  Asset `gorm:"foreignKey:ChildAssetID"`
+}
+
 type ContractStatus = string
 
 const (
-	ContractStatusActive = ContractStatus("active")
-	ContractStatusClosed = ContractStatus("closed")
+	ContractStatusPending = ContractStatus("pending") // Not yet in force e.g. a renewal
+	ContractStatusActive  = ContractStatus("active")
+	ContractStatusClosed  = ContractStatus("closed")
 )
 
 // Contract represents a PDF contract document associated with an asset
@@ -232,6 +428,9 @@ type Contract struct {
 	StartDate        *time.Time
 	ExpiryDate       *time.Time
 	RetentionEndDate *time.Time
+	Version          int `gorm:"not null;default:1"` // Of the current terms
+
+	PredecessorContractID *uuid.UUID `gorm:"type:uuid;index"` // Contract this renews, if any
 
 	// Relationships
 	Study         Study                    `gorm:"foreignKey:StudyID"`
@@ -239,14 +438,55 @@ type Contract struct {
 	SignatoryUser User                     `gorm:"foreignKey:SignatoryUserId"`
 	Assets        []Asset                  `gorm:"many2many:contract_assets;"` // autogen the contract_assets table
 	Objects       []ContractObjectMetadata `gorm:"foreignKey:ContractID"`
+	Successor     *Contract                `gorm:"foreignKey:PredecessorContractID"` // Renewal, if any
 }
 
+// Whether a renewal of the contract has been started. Requires the successor to be loaded
+func (c Contract) IsRenewed() bool {
+	return c.Successor != nil
+}
+
+type MalwareScanStatus string
+
+const (
+	MalwareScanStatusPending  = MalwareScanStatus("pending") // Quarantined until scanned
+	MalwareScanStatusClean    = MalwareScanStatus("clean")
+	MalwareScanStatusInfected = MalwareScanStatus("infected") // Kept in quarantine
+	MalwareScanStatusSkipped  = MalwareScanStatus("skipped")  // Stored while scanning was disabled
+)
+
 // Contract object is the metadata for a file object {pdf, docx} etc.
 type ContractObjectMetadata struct {
 	ModelAuditable
-	Filename   string    `gorm:"not null"`
-	ContractID uuid.UUID `gorm:"type:uuid;not null"`
+	Filename          string            `gorm:"not null"`
+	ContractID        uuid.UUID         `gorm:"type:uuid;not null"`
+	ContractVersionID *uuid.UUID        `gorm:"type:uuid;index"` // Set for the objects of an amendment
+	ScanStatus        MalwareScanStatus `gorm:"not null;default:skipped;index"`
+	ScanSignature     *string           // Malware found, if infected
+	ScannedAt         *time.Time
 
 	// Relationships
 	Contract Contract
 }
+
+// Whether the object is held in quarantine rather than with the clean objects
+func (o ContractObjectMetadata) IsQuarantined() bool {
+	return o.ScanStatus == MalwareScanStatusPending || o.ScanStatus == MalwareScanStatusInfected
+}
+
+func (o ContractObjectMetadata) IsPDF() bool {
+	return strings.HasSuffix(strings.ToLower(o.Filename), ".pdf")
+}
+
+// Record of a user downloading a contract object
+type ContractObjectDownload struct {
+	Model
+	ContractID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	ContractObjectID uuid.UUID `gorm:"type:uuid;not null"`
+	UserID           uuid.UUID `gorm:"type:uuid;not null"`
+	Watermarked      bool      `gorm:"not null"` // Stamped with the user, time and caseref
+
+	// Relationships
+	ContractObject ContractObjectMetadata
+	User           User
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,3 +13,42 @@ func TestAssetDestroyed(t *testing.T) {
 	assert.False(t, Asset{Status: AssetStatusActive}.IsDestroyed())
 	assert.True(t, Asset{Status: "destroyed"}.IsDestroyed())
 }
+
+func TestStudyIsClosed(t *testing.T) {
+	assert.False(t, Study{}.IsClosed())
+	assert.False(t, Study{ApprovalStatus: StudyApprovalStatusApproved}.IsClosed())
+	assert.True(t, Study{ApprovalStatus: StudyApprovalStatusClosing}.IsClosed())
+	assert.True(t, Study{ApprovalStatus: StudyApprovalStatusArchived}.IsClosed())
+}
+
+func TestStudyPendingOwnerChange(t *testing.T) {
+	owner, newOwner := uuid.New(), uuid.New()
+	request := StudyOwnerChangelog{FromUserID: owner, ToUserID: newOwner, Action: StudyOwnerChangelogActionRequest}
+	request.CreatedAt = time.Now().Add(-time.Hour)
+	study := Study{OwnerUserID: owner, OwnerChangelogs: []StudyOwnerChangelog{request}}
+	assert.Nil(t, Study{OwnerUserID: owner}.PendingOwnerChange())
+	assert.Equal(t, newOwner, study.PendingOwnerChange().ToUserID)
+
+	rejection := request
+	rejection.Action = StudyOwnerChangelogActionReject
+	rejection.CreatedAt = time.Now()
+	study.OwnerChangelogs = append(study.OwnerChangelogs, rejection)
+	assert.Nil(t, study.PendingOwnerChange())
+
+	study.OwnerChangelogs = []StudyOwnerChangelog{request}
+	study.OwnerUserID = newOwner // stale request
+	assert.Nil(t, study.PendingOwnerChange())
+}
+
+func TestAssetLocationKeys(t *testing.T) {
+	assetID, projectID := uuid.New(), uuid.New()
+	project := AssetLocation{AssetID: assetID, Kind: AssetLocationKindProject, ProjectID: &projectID, Location: "old name"}
+	renamed := project
+	renamed.Location = "new name"
+	assert.Equal(t, project.UniqueKey(), renamed.UniqueKey())
+	assert.NotEqual(t, project.PlaceKey(), AssetLocation{Kind: AssetLocationKindOther, Location: "old name"}.PlaceKey())
+
+	asset := Asset{Locations: []AssetLocation{project, {Kind: AssetLocationKindOther, Location: "email"}}}
+	assert.Equal(t, []string{"email"}, asset.LocationStrings())
+	assert.Equal(t, "new name", AssetLocation{Location: "old name", Project: &Project{Name: "new name"}}.Name())
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AssetTransferStatusPending   = AssetTransferStatus("pending")
+	AssetTransferStatusCompleted = AssetTransferStatus("completed")
+	AssetTransferStatusRejected  = AssetTransferStatus("rejected")
+)
+
+type AssetTransferStatus string
+
+// Transfer of an asset between studies. Approved by the owners of both studies
+// and then IG operations staff, after which the asset and its contracts are
+// moved. Completed transfers form the transfer history of an asset
+type AssetTransfer struct {
+	Model
+	UpdatedAt             time.Time
+	AssetID               uuid.UUID           `gorm:"not null;index"`
+	SourceStudyID         *uuid.UUID          `gorm:"index"` // Nil once the study has been erased
+	TargetStudyID         *uuid.UUID          `gorm:"index"` // Nil once the study has been erased
+	RequesterUserID       uuid.UUID           `gorm:"not null"`
+	Reason                string              `gorm:"type:text;not null"`
+	Status                AssetTransferStatus `gorm:"not null;index"`
+	SourceOwnerApprovedAt *time.Time
+	TargetOwnerApprovedAt *time.Time
+	ReviewerUserID        *uuid.UUID
+	ReviewedAt            *time.Time
+	ContractIDs           []uuid.UUID `gorm:"serializer:json"` // Moved with the asset on completion
+
+	// Relationships
+	Asset         Asset  `gorm:"foreignKey:AssetID"`
+	SourceStudy   *Study `gorm:"foreignKey:SourceStudyID"`
+	TargetStudy   *Study `gorm:"foreignKey:TargetStudyID"`
+	RequesterUser User   `gorm:"foreignKey:RequesterUserID"`
+	ReviewerUser  *User  `gorm:"foreignKey:ReviewerUserID"`
+}
+
+func (t AssetTransfer) IsFrom(studyID uuid.UUID) bool {
+	return t.SourceStudyID != nil && *t.SourceStudyID == studyID
+}
+
+func (t AssetTransfer) IsTo(studyID uuid.UUID) bool {
+	return t.TargetStudyID != nil && *t.TargetStudyID == studyID
+}
+
+func (t AssetTransfer) OwnersApproved() bool {
+	return t.SourceOwnerApprovedAt != nil && t.TargetOwnerApprovedAt != nil
+}
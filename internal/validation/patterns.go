@@ -18,4 +18,10 @@ var (
 	TokenNamePattern              = regexp.MustCompile(`^.{1,50}$`)                   // 1-50 characters, any content
 	OtherSignatoriesStringPattern = regexp.MustCompile(`^.{0,255}$`)                  // 1-255 characters, any content
 	UsersSearchQueryPattern       = regexp.MustCompile(`^\w[a-zA-Z0-9\-\.+@_\s]+\w$`) // >2 alphanumeric characters
+	ReviewCommentPattern          = regexp.MustCompile(`^[\s\S]{1,1000}$`)            // 1-1000 characters including newlines
+	FieldNamePattern              = regexp.MustCompile(`^[a-z][a-z_]{1,63}$`)         // snake_case JSON field name
+	DpiaTextPattern               = regexp.MustCompile(`^[\s\S]{1,1000}$`)            // 1-1000 characters including newlines
+	ApprovalReferencePattern      = regexp.MustCompile(`^.{2,100}$`)                  // 2-100 characters, any content
+	ApprovalConditionsPattern     = regexp.MustCompile(`^[\s\S]{0,1000}$`)            // <1001 characters including newlines
+	StudyDocumentTitlePattern     = regexp.MustCompile(`^.{2,100}$`)                  // 2-100 characters, any content
 )
@@ -1,4 +1,4 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-export { deleteProjectsTreByProjectId, deleteStudiesByStudyIdAssetsByAssetId, deleteStudiesByStudyIdContractsByContractId, deleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectId, deleteTokensByEnvironmentByTokenId, getAgreementsByAgreementType, getAuth, getEnvironments, getLogout, getNotifications, getProfile, getProfileAgreements, getProfileTraining, getProjects, getProjectsDshByProjectId, getProjectsTre, getProjectsTreByProjectId, getStudies, getStudiesByStudyId, getStudiesByStudyIdAgreements, getStudiesByStudyIdAssets, getStudiesByStudyIdAssetsByAssetId, getStudiesByStudyIdAssetsByAssetIdContracts, getStudiesByStudyIdContracts, getStudiesByStudyIdContractsByContractId, getStudiesByStudyIdContractsByContractIdObjectsByContractObjectId, getTokensByEnvironment, getUsers, getUsersByUserId, getUsersLookup, getUsersMetrics, type Options, patchProjectsTreByProjectIdPending, patchStudiesByStudyIdPending, postFeedback, postNotificationsByNotificationIdRead, postNotificationsRead, postProfile, postProfileAgreements, postProfileTraining, postProjectsTre, postProjectsTreAdminByProjectIdApprove, postProjectsTreAdminImport, postStudies, postStudiesAdminByStudyIdAssetsImport, postStudiesAdminByStudyIdContractsImport, postStudiesAdminByStudyIdOwnerApprove, postStudiesAdminByStudyIdOwnerRequest, postStudiesAdminByStudyIdReview, postStudiesAdminImport, postStudiesByStudyIdAgreements, postStudiesByStudyIdAssets, postStudiesByStudyIdContracts, postStudiesByStudyIdContractsByContractIdObjects, postStudiesByStudyIdOwnerRequest, postStudiesByStudyIdSignoff, postTokensByEnvironment, postUsersApprovedResearchersImportCsv, postUsersByUserIdTraining, postUsersInvite, putProjectsTreByProjectId, putStudiesByStudyId, putStudiesByStudyIdAssetsByAssetId, putStudiesByStudyIdContractsByContractId, putUsersByUserIdAttributes } from './sdk.gen';
-export type { Agreement, AgreementConfirmation, AgreementType, Asset, AssetBase, AssetIdParam, AssetImport, Auth, ClientOptions, ConfirmedAgreement, Contract, ContractBase, ContractIdParam, ContractImport, ContractObject, ContractObjectIdParam, ContractObjectMetadata, DeleteProjectsTreByProjectIdData, DeleteProjectsTreByProjectIdErrors, DeleteProjectsTreByProjectIdResponse, DeleteProjectsTreByProjectIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdError, DeleteStudiesByStudyIdAssetsByAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdResponse, DeleteStudiesByStudyIdAssetsByAssetIdResponses, DeleteStudiesByStudyIdContractsByContractIdData, DeleteStudiesByStudyIdContractsByContractIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdResponse, DeleteStudiesByStudyIdContractsByContractIdResponses, DeleteTokensByEnvironmentByTokenIdData, DeleteTokensByEnvironmentByTokenIdErrors, DeleteTokensByEnvironmentByTokenIdResponse, DeleteTokensByEnvironmentByTokenIdResponses, Environment, EnvironmentName, EnvironmentParam, Feedback, GetAgreementsByAgreementTypeData, GetAgreementsByAgreementTypeErrors, GetAgreementsByAgreementTypeResponse, GetAgreementsByAgreementTypeResponses, GetAuthData, GetAuthErrors, GetAuthResponse, GetAuthResponses, GetEnvironmentsData, GetEnvironmentsErrors, GetEnvironmentsResponse, GetEnvironmentsResponses, GetLogoutData, GetLogoutErrors, GetLogoutResponses, GetNotificationsData, GetNotificationsErrors, GetNotificationsResponse, GetNotificationsResponses, GetProfileAgreementsData, GetProfileAgreementsErrors, GetProfileAgreementsResponse, GetProfileAgreementsResponses, GetProfileData, GetProfileErrors, GetProfileResponse, GetProfileResponses, GetProfileTrainingData, GetProfileTrainingErrors, GetProfileTrainingResponse, GetProfileTrainingResponses, GetProjectsData, GetProjectsDshByProjectIdData, GetProjectsDshByProjectIdErrors, GetProjectsDshByProjectIdResponse, GetProjectsDshByProjectIdResponses, GetProjectsErrors, GetProjectsResponse, GetProjectsResponses, GetProjectsTreByProjectIdData, GetProjectsTreByProjectIdErrors, GetProjectsTreByProjectIdResponse, GetProjectsTreByProjectIdResponses, GetProjectsTreData, GetProjectsTreErrors, GetProjectsTreResponse, GetProjectsTreResponses, GetStudiesByStudyIdAgreementsData, GetStudiesByStudyIdAgreementsErrors, GetStudiesByStudyIdAgreementsResponse, GetStudiesByStudyIdAgreementsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsData, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, GetStudiesByStudyIdAssetsByAssetIdContractsResponse, GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdData, GetStudiesByStudyIdAssetsByAssetIdErrors, GetStudiesByStudyIdAssetsByAssetIdResponse, GetStudiesByStudyIdAssetsByAssetIdResponses, GetStudiesByStudyIdAssetsData, GetStudiesByStudyIdAssetsErrors, GetStudiesByStudyIdAssetsResponse, GetStudiesByStudyIdAssetsResponses, GetStudiesByStudyIdContractsByContractIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdResponse, GetStudiesByStudyIdContractsByContractIdResponses, GetStudiesByStudyIdContractsData, GetStudiesByStudyIdContractsErrors, GetStudiesByStudyIdContractsResponse, GetStudiesByStudyIdContractsResponses, GetStudiesByStudyIdData, GetStudiesByStudyIdErrors, GetStudiesByStudyIdResponse, GetStudiesByStudyIdResponses, GetStudiesData, GetStudiesErrors, GetStudiesResponse, GetStudiesResponses, GetTokensByEnvironmentData, GetTokensByEnvironmentErrors, GetTokensByEnvironmentResponse, GetTokensByEnvironmentResponses, GetUsersByUserIdData, GetUsersByUserIdErrors, GetUsersByUserIdResponse, GetUsersByUserIdResponses, GetUsersData, GetUsersErrors, GetUsersLookupData, GetUsersLookupErrors, GetUsersLookupResponse, GetUsersLookupResponses, GetUsersMetricsData, GetUsersMetricsResponse, GetUsersMetricsResponses, GetUsersResponse, GetUsersResponses, Notification, NotificationKind, NotificationsReadAll, PatchProjectsTreByProjectIdPendingData, PatchProjectsTreByProjectIdPendingErrors, PatchProjectsTreByProjectIdPendingResponses, PatchStudiesByStudyIdPendingData, PatchStudiesByStudyIdPendingErrors, PatchStudiesByStudyIdPendingResponses, PostFeedbackData, PostFeedbackErrors, PostFeedbackResponse, PostFeedbackResponses, PostNotificationsByNotificationIdReadData, PostNotificationsByNotificationIdReadErrors, PostNotificationsByNotificationIdReadResponse, PostNotificationsByNotificationIdReadResponses, PostNotificationsReadData, PostNotificationsReadErrors, PostNotificationsReadResponse, PostNotificationsReadResponses, PostProfileAgreementsData, PostProfileAgreementsErrors, PostProfileAgreementsResponse, PostProfileAgreementsResponses, PostProfileData, PostProfileErrors, PostProfileResponse, PostProfileResponses, PostProfileTrainingData, PostProfileTrainingErrors, PostProfileTrainingResponse, PostProfileTrainingResponses, PostProjectsTreAdminByProjectIdApproveData, PostProjectsTreAdminByProjectIdApproveErrors, PostProjectsTreAdminByProjectIdApproveResponses, PostProjectsTreAdminImportData, PostProjectsTreAdminImportErrors, PostProjectsTreAdminImportResponse, PostProjectsTreAdminImportResponses, PostProjectsTreData, PostProjectsTreError, PostProjectsTreErrors, PostProjectsTreResponse, PostProjectsTreResponses, PostStudiesAdminByStudyIdAssetsImportData, PostStudiesAdminByStudyIdAssetsImportResponse, PostStudiesAdminByStudyIdAssetsImportResponses, PostStudiesAdminByStudyIdContractsImportData, PostStudiesAdminByStudyIdContractsImportResponse, PostStudiesAdminByStudyIdContractsImportResponses, PostStudiesAdminByStudyIdOwnerApproveData, PostStudiesAdminByStudyIdOwnerApproveErrors, PostStudiesAdminByStudyIdOwnerApproveResponses, PostStudiesAdminByStudyIdOwnerRequestData, PostStudiesAdminByStudyIdOwnerRequestErrors, PostStudiesAdminByStudyIdOwnerRequestResponses, PostStudiesAdminByStudyIdReviewData, PostStudiesAdminByStudyIdReviewErrors, PostStudiesAdminByStudyIdReviewResponses, PostStudiesAdminImportData, PostStudiesAdminImportResponse, PostStudiesAdminImportResponses, PostStudiesByStudyIdAgreementsData, PostStudiesByStudyIdAgreementsErrors, PostStudiesByStudyIdAgreementsResponses, PostStudiesByStudyIdAssetsData, PostStudiesByStudyIdAssetsError, PostStudiesByStudyIdAssetsErrors, PostStudiesByStudyIdAssetsResponse, PostStudiesByStudyIdAssetsResponses, PostStudiesByStudyIdContractsByContractIdObjectsData, PostStudiesByStudyIdContractsByContractIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdObjectsResponse, PostStudiesByStudyIdContractsByContractIdObjectsResponses, PostStudiesByStudyIdContractsData, PostStudiesByStudyIdContractsError, PostStudiesByStudyIdContractsErrors, PostStudiesByStudyIdContractsResponse, PostStudiesByStudyIdContractsResponses, PostStudiesByStudyIdOwnerRequestData, PostStudiesByStudyIdOwnerRequestErrors, PostStudiesByStudyIdOwnerRequestResponses, PostStudiesByStudyIdSignoffData, PostStudiesByStudyIdSignoffErrors, PostStudiesByStudyIdSignoffResponses, PostStudiesData, PostStudiesError, PostStudiesErrors, PostStudiesResponses, PostTokensByEnvironmentData, PostTokensByEnvironmentErrors, PostTokensByEnvironmentResponse, PostTokensByEnvironmentResponses, PostUsersApprovedResearchersImportCsvData, PostUsersApprovedResearchersImportCsvErrors, PostUsersApprovedResearchersImportCsvResponse, PostUsersApprovedResearchersImportCsvResponses, PostUsersByUserIdTrainingData, PostUsersByUserIdTrainingErrors, PostUsersByUserIdTrainingResponse, PostUsersByUserIdTrainingResponses, PostUsersInviteData, PostUsersInviteErrors, PostUsersInviteResponses, Profile, ProfileTraining, ProfileTrainingResponse, ProfileTrainingUpdate, ProfileUpdate, ProfileUpdateResponse, Project, ProjectDsh, ProjectDshMember, ProjectDshRole, ProjectDshStatus, ProjectIdParam, ProjectTre, ProjectTreBase, ProjectTreImport, ProjectTreMember, ProjectTreRequest, ProjectTreRoleName, ProjectTreStatus, ProjectTreUpdate, ProjectTreUserDesktopConfig, PutProjectsTreByProjectIdData, PutProjectsTreByProjectIdError, PutProjectsTreByProjectIdErrors, PutProjectsTreByProjectIdResponses, PutStudiesByStudyIdAssetsByAssetIdData, PutStudiesByStudyIdAssetsByAssetIdError, PutStudiesByStudyIdAssetsByAssetIdErrors, PutStudiesByStudyIdAssetsByAssetIdResponse, PutStudiesByStudyIdAssetsByAssetIdResponses, PutStudiesByStudyIdContractsByContractIdData, PutStudiesByStudyIdContractsByContractIdError, PutStudiesByStudyIdContractsByContractIdErrors, PutStudiesByStudyIdContractsByContractIdResponse, PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdData, PutStudiesByStudyIdError, PutStudiesByStudyIdErrors, PutStudiesByStudyIdResponses, PutUsersByUserIdAttributesData, PutUsersByUserIdAttributesErrors, PutUsersByUserIdAttributesResponses, Study, StudyAgreements, StudyApprovalStatus, StudyBase, StudyIdParam, StudyImport, StudyOwnerUpdate, StudyRequest, StudyReview, Token, TokenRequest, TokenWithValue, TrainingKind, TrainingRecord, User, UserAgreements, UserData, UserDataLookup, UserFindParam, UserIdParam, UserMetrics, UserTrainingUpdate, ValidationError } from './types.gen';
+export { deleteProjectsTreByProjectId, deleteStudiesAdminByStudyIdReviewer, deleteStudiesByStudyIdApprovalsByApprovalId, deleteStudiesByStudyIdAssetsByAssetId, deleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetId, deleteStudiesByStudyIdContractsByContractId, deleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectId, deleteStudiesByStudyIdDocumentsByDocumentId, deleteTokensByEnvironmentByTokenId, getAgreementsByAgreementType, getAuth, getEnvironments, getErasures, getErasuresByErasureId, getLogout, getNotifications, getProfile, getProfileAgreements, getProfileTraining, getProjects, getProjectsDshByProjectId, getProjectsTre, getProjectsTreByProjectId, getSearch, getStudies, getStudiesAdminAssetDestructions, getStudiesAdminAssetLocations, getStudiesAdminAssetRegister, getStudiesAdminAssetRegisterExports, getStudiesAdminAssetRegisterExportsByExportIdFile, getStudiesAdminAssetTransfers, getStudiesAdminByStudyIdContractsByContractIdDownloads, getStudiesAdminOwnerChanges, getStudiesByStudyId, getStudiesByStudyIdAgreements, getStudiesByStudyIdApprovals, getStudiesByStudyIdApprovalsByApprovalIdLetter, getStudiesByStudyIdAssetExpiryReviews, getStudiesByStudyIdAssets, getStudiesByStudyIdAssetsByAssetId, getStudiesByStudyIdAssetsByAssetIdContracts, getStudiesByStudyIdAssetsByAssetIdDestructions, getStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificate, getStudiesByStudyIdAssetsByAssetIdTransfers, getStudiesByStudyIdClosure, getStudiesByStudyIdContracts, getStudiesByStudyIdContractsByContractId, getStudiesByStudyIdContractsByContractIdObjectsByContractObjectId, getStudiesByStudyIdContractsByContractIdVersions, getStudiesByStudyIdDocuments, getStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionId, getStudiesByStudyIdDpia, getStudiesByStudyIdLineage, getStudiesByStudyIdReviewThreads, getStudiesByStudyIdRevisions, getStudiesByStudyIdRevisionsDiff, getStudiesByStudyIdSignoffs, getStudiesByStudyIdTransfers, getTokensByEnvironment, getUsers, getUsersByUserId, getUsersLookup, getUsersMetrics, type Options, patchProjectsTreByProjectIdPending, patchStudiesByStudyIdPending, postErasures, postErasuresByErasureIdApprove, postErasuresByErasureIdReject, postFeedback, postNotificationsByNotificationIdRead, postNotificationsRead, postProfile, postProfileAgreements, postProfileTraining, postProjectsTre, postProjectsTreAdminByProjectIdApprove, postProjectsTreAdminImport, postStudies, postStudiesAdminAssetTransfersByTransferIdApprove, postStudiesAdminAssetTransfersByTransferIdReject, postStudiesAdminByStudyIdArchive, postStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApprove, postStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdReject, postStudiesAdminByStudyIdAssetsImport, postStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApprove, postStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdReject, postStudiesAdminByStudyIdContractsImport, postStudiesAdminByStudyIdDpiaSignoff, postStudiesAdminByStudyIdOwnerApprove, postStudiesAdminByStudyIdOwnerCancel, postStudiesAdminByStudyIdOwnerReject, postStudiesAdminByStudyIdOwnerRequest, postStudiesAdminByStudyIdReview, postStudiesAdminByStudyIdReviewerClaim, postStudiesAdminByStudyIdReviewThreads, postStudiesAdminByStudyIdReviewThreadsByReviewThreadIdComments, postStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolve, postStudiesAdminImport, postStudiesAdminImportBulk, postStudiesByStudyIdAgreements, postStudiesByStudyIdApprovals, postStudiesByStudyIdApprovalsByApprovalIdLetter, postStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolve, postStudiesByStudyIdAssets, postStudiesByStudyIdAssetsByAssetIdDestructions, postStudiesByStudyIdAssetsByAssetIdParents, postStudiesByStudyIdAssetsByAssetIdTransfers, postStudiesByStudyIdClosure, postStudiesByStudyIdClosureComplete, postStudiesByStudyIdContracts, postStudiesByStudyIdContractsByContractIdObjects, postStudiesByStudyIdContractsByContractIdRenew, postStudiesByStudyIdContractsByContractIdVersions, postStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjects, postStudiesByStudyIdDocuments, postStudiesByStudyIdDocumentsByDocumentIdVersions, postStudiesByStudyIdDpiaSubmit, postStudiesByStudyIdOwnerCancel, postStudiesByStudyIdOwnerRequest, postStudiesByStudyIdReviewThreadsByReviewThreadIdComments, postStudiesByStudyIdSignoff, postStudiesByStudyIdTransfersByTransferIdApprove, postStudiesByStudyIdTransfersByTransferIdReject, postTokensByEnvironment, postUsersApprovedResearchersImportCsv, postUsersByUserIdTraining, postUsersInvite, putProjectsTreByProjectId, putStudiesAdminByStudyIdReviewer, putStudiesByStudyId, putStudiesByStudyIdApprovalsByApprovalId, putStudiesByStudyIdAssetsByAssetId, putStudiesByStudyIdContractsByContractId, putStudiesByStudyIdDpia, putUsersByUserIdAttributes } from './sdk.gen';
+export type { Agreement, AgreementConfirmation, AgreementType, ApprovalIdParam, Asset, AssetBase, AssetDerivationType, AssetDestruction, AssetDestructionMethod, AssetDestructionRequest, AssetDestructionReview, AssetDestructionStatus, AssetExpiryOutcome, AssetExpiryResolution, AssetExpiryReview, AssetExpiryReviewStatus, AssetIdParam, AssetImport, AssetLineage, AssetLineageNode, AssetLink, AssetLinkRequest, AssetLocationKind, AssetLocationReference, AssetLocationReport, AssetLocationReportAsset, AssetRegisterEntry, AssetRegisterExport, AssetRegisterFormat, AssetTransfer, AssetTransferRequest, AssetTransferStatus, Auth, ClientOptions, ConfirmedAgreement, Contract, ContractAmendmentRequest, ContractAmendmentReview, ContractBase, ContractIdParam, ContractImport, ContractObject, ContractObjectDownload, ContractObjectIdParam, ContractObjectMetadata, ContractVersion, ContractVersionIdParam, ContractVersionStatus, DeleteProjectsTreByProjectIdData, DeleteProjectsTreByProjectIdErrors, DeleteProjectsTreByProjectIdResponse, DeleteProjectsTreByProjectIdResponses, DeleteStudiesAdminByStudyIdReviewerData, DeleteStudiesAdminByStudyIdReviewerErrors, DeleteStudiesAdminByStudyIdReviewerResponses, DeleteStudiesByStudyIdApprovalsByApprovalIdData, DeleteStudiesByStudyIdApprovalsByApprovalIdErrors, DeleteStudiesByStudyIdApprovalsByApprovalIdResponse, DeleteStudiesByStudyIdApprovalsByApprovalIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdError, DeleteStudiesByStudyIdAssetsByAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponse, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdResponse, DeleteStudiesByStudyIdAssetsByAssetIdResponses, DeleteStudiesByStudyIdContractsByContractIdData, DeleteStudiesByStudyIdContractsByContractIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdResponse, DeleteStudiesByStudyIdContractsByContractIdResponses, DeleteStudiesByStudyIdDocumentsByDocumentIdData, DeleteStudiesByStudyIdDocumentsByDocumentIdErrors, DeleteStudiesByStudyIdDocumentsByDocumentIdResponse, DeleteStudiesByStudyIdDocumentsByDocumentIdResponses, DeleteTokensByEnvironmentByTokenIdData, DeleteTokensByEnvironmentByTokenIdErrors, DeleteTokensByEnvironmentByTokenIdResponse, DeleteTokensByEnvironmentByTokenIdResponses, DestructionIdParam, DocumentIdParam, DocumentVersionIdParam, Dpia, DpiaAnswer, DpiaAnswerUpdate, DpiaRisk, DpiaRiskLevel, DpiaSignoff, DpiaStatus, DpiaUpdate, Environment, EnvironmentName, EnvironmentParam, Erasure, ErasureIdParam, ErasureManifest, ErasureRequest, ErasureStatus, ErasureSubjectKind, ExpiryReviewIdParam, ExportIdParam, Feedback, GetAgreementsByAgreementTypeData, GetAgreementsByAgreementTypeErrors, GetAgreementsByAgreementTypeResponse, GetAgreementsByAgreementTypeResponses, GetAuthData, GetAuthErrors, GetAuthResponse, GetAuthResponses, GetEnvironmentsData, GetEnvironmentsErrors, GetEnvironmentsResponse, GetEnvironmentsResponses, GetErasuresByErasureIdData, GetErasuresByErasureIdErrors, GetErasuresByErasureIdResponse, GetErasuresByErasureIdResponses, GetErasuresData, GetErasuresErrors, GetErasuresResponse, GetErasuresResponses, GetLogoutData, GetLogoutErrors, GetLogoutResponses, GetNotificationsData, GetNotificationsErrors, GetNotificationsResponse, GetNotificationsResponses, GetProfileAgreementsData, GetProfileAgreementsErrors, GetProfileAgreementsResponse, GetProfileAgreementsResponses, GetProfileData, GetProfileErrors, GetProfileResponse, GetProfileResponses, GetProfileTrainingData, GetProfileTrainingErrors, GetProfileTrainingResponse, GetProfileTrainingResponses, GetProjectsData, GetProjectsDshByProjectIdData, GetProjectsDshByProjectIdErrors, GetProjectsDshByProjectIdResponse, GetProjectsDshByProjectIdResponses, GetProjectsErrors, GetProjectsResponse, GetProjectsResponses, GetProjectsTreByProjectIdData, GetProjectsTreByProjectIdErrors, GetProjectsTreByProjectIdResponse, GetProjectsTreByProjectIdResponses, GetProjectsTreData, GetProjectsTreErrors, GetProjectsTreResponse, GetProjectsTreResponses, GetSearchData, GetSearchError, GetSearchErrors, GetSearchResponse, GetSearchResponses, GetStudiesAdminAssetDestructionsData, GetStudiesAdminAssetDestructionsErrors, GetStudiesAdminAssetDestructionsResponse, GetStudiesAdminAssetDestructionsResponses, GetStudiesAdminAssetLocationsData, GetStudiesAdminAssetLocationsError, GetStudiesAdminAssetLocationsErrors, GetStudiesAdminAssetLocationsResponse, GetStudiesAdminAssetLocationsResponses, GetStudiesAdminAssetRegisterData, GetStudiesAdminAssetRegisterError, GetStudiesAdminAssetRegisterErrors, GetStudiesAdminAssetRegisterExportsByExportIdFileData, GetStudiesAdminAssetRegisterExportsByExportIdFileErrors, GetStudiesAdminAssetRegisterExportsByExportIdFileResponse, GetStudiesAdminAssetRegisterExportsByExportIdFileResponses, GetStudiesAdminAssetRegisterExportsData, GetStudiesAdminAssetRegisterExportsErrors, GetStudiesAdminAssetRegisterExportsResponse, GetStudiesAdminAssetRegisterExportsResponses, GetStudiesAdminAssetRegisterResponse, GetStudiesAdminAssetRegisterResponses, GetStudiesAdminAssetTransfersData, GetStudiesAdminAssetTransfersErrors, GetStudiesAdminAssetTransfersResponse, GetStudiesAdminAssetTransfersResponses, GetStudiesAdminByStudyIdContractsByContractIdDownloadsData, GetStudiesAdminByStudyIdContractsByContractIdDownloadsErrors, GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponse, GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses, GetStudiesAdminOwnerChangesData, GetStudiesAdminOwnerChangesErrors, GetStudiesAdminOwnerChangesResponse, GetStudiesAdminOwnerChangesResponses, GetStudiesByStudyIdAgreementsData, GetStudiesByStudyIdAgreementsErrors, GetStudiesByStudyIdAgreementsResponse, GetStudiesByStudyIdAgreementsResponses, GetStudiesByStudyIdApprovalsByApprovalIdLetterData, GetStudiesByStudyIdApprovalsByApprovalIdLetterErrors, GetStudiesByStudyIdApprovalsByApprovalIdLetterResponse, GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses, GetStudiesByStudyIdApprovalsData, GetStudiesByStudyIdApprovalsErrors, GetStudiesByStudyIdApprovalsResponse, GetStudiesByStudyIdApprovalsResponses, GetStudiesByStudyIdAssetExpiryReviewsData, GetStudiesByStudyIdAssetExpiryReviewsErrors, GetStudiesByStudyIdAssetExpiryReviewsResponse, GetStudiesByStudyIdAssetExpiryReviewsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsData, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, GetStudiesByStudyIdAssetsByAssetIdContractsResponse, GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdData, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateData, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateErrors, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponse, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsData, GetStudiesByStudyIdAssetsByAssetIdDestructionsErrors, GetStudiesByStudyIdAssetsByAssetIdDestructionsResponse, GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses, GetStudiesByStudyIdAssetsByAssetIdErrors, GetStudiesByStudyIdAssetsByAssetIdResponse, GetStudiesByStudyIdAssetsByAssetIdResponses, GetStudiesByStudyIdAssetsByAssetIdTransfersData, GetStudiesByStudyIdAssetsByAssetIdTransfersErrors, GetStudiesByStudyIdAssetsByAssetIdTransfersResponse, GetStudiesByStudyIdAssetsByAssetIdTransfersResponses, GetStudiesByStudyIdAssetsData, GetStudiesByStudyIdAssetsErrors, GetStudiesByStudyIdAssetsResponse, GetStudiesByStudyIdAssetsResponses, GetStudiesByStudyIdClosureData, GetStudiesByStudyIdClosureErrors, GetStudiesByStudyIdClosureResponse, GetStudiesByStudyIdClosureResponses, GetStudiesByStudyIdContractsByContractIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdError, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdResponse, GetStudiesByStudyIdContractsByContractIdResponses, GetStudiesByStudyIdContractsByContractIdVersionsData, GetStudiesByStudyIdContractsByContractIdVersionsErrors, GetStudiesByStudyIdContractsByContractIdVersionsResponse, GetStudiesByStudyIdContractsByContractIdVersionsResponses, GetStudiesByStudyIdContractsData, GetStudiesByStudyIdContractsErrors, GetStudiesByStudyIdContractsResponse, GetStudiesByStudyIdContractsResponses, GetStudiesByStudyIdData, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdData, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdErrors, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponse, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses, GetStudiesByStudyIdDocumentsData, GetStudiesByStudyIdDocumentsErrors, GetStudiesByStudyIdDocumentsResponse, GetStudiesByStudyIdDocumentsResponses, GetStudiesByStudyIdDpiaData, GetStudiesByStudyIdDpiaErrors, GetStudiesByStudyIdDpiaResponse, GetStudiesByStudyIdDpiaResponses, GetStudiesByStudyIdErrors, GetStudiesByStudyIdLineageData, GetStudiesByStudyIdLineageErrors, GetStudiesByStudyIdLineageResponse, GetStudiesByStudyIdLineageResponses, GetStudiesByStudyIdResponse, GetStudiesByStudyIdResponses, GetStudiesByStudyIdReviewThreadsData, GetStudiesByStudyIdReviewThreadsErrors, GetStudiesByStudyIdReviewThreadsResponse, GetStudiesByStudyIdReviewThreadsResponses, GetStudiesByStudyIdRevisionsData, GetStudiesByStudyIdRevisionsDiffData, GetStudiesByStudyIdRevisionsDiffError, GetStudiesByStudyIdRevisionsDiffErrors, GetStudiesByStudyIdRevisionsDiffResponse, GetStudiesByStudyIdRevisionsDiffResponses, GetStudiesByStudyIdRevisionsErrors, GetStudiesByStudyIdRevisionsResponse, GetStudiesByStudyIdRevisionsResponses, GetStudiesByStudyIdSignoffsData, GetStudiesByStudyIdSignoffsErrors, GetStudiesByStudyIdSignoffsResponse, GetStudiesByStudyIdSignoffsResponses, GetStudiesByStudyIdTransfersData, GetStudiesByStudyIdTransfersErrors, GetStudiesByStudyIdTransfersResponse, GetStudiesByStudyIdTransfersResponses, GetStudiesData, GetStudiesErrors, GetStudiesResponse, GetStudiesResponses, GetTokensByEnvironmentData, GetTokensByEnvironmentErrors, GetTokensByEnvironmentResponse, GetTokensByEnvironmentResponses, GetUsersByUserIdData, GetUsersByUserIdErrors, GetUsersByUserIdResponse, GetUsersByUserIdResponses, GetUsersData, GetUsersErrors, GetUsersLookupData, GetUsersLookupErrors, GetUsersLookupResponse, GetUsersLookupResponses, GetUsersMetricsData, GetUsersMetricsResponse, GetUsersMetricsResponses, GetUsersResponse, GetUsersResponses, MalwareScanStatus, Notification, NotificationKind, NotificationsReadAll, ParentAssetIdParam, PatchProjectsTreByProjectIdPendingData, PatchProjectsTreByProjectIdPendingErrors, PatchProjectsTreByProjectIdPendingResponses, PatchStudiesByStudyIdPendingData, PatchStudiesByStudyIdPendingErrors, PatchStudiesByStudyIdPendingResponses, PostErasuresByErasureIdApproveData, PostErasuresByErasureIdApproveError, PostErasuresByErasureIdApproveErrors, PostErasuresByErasureIdApproveResponse, PostErasuresByErasureIdApproveResponses, PostErasuresByErasureIdRejectData, PostErasuresByErasureIdRejectError, PostErasuresByErasureIdRejectErrors, PostErasuresByErasureIdRejectResponse, PostErasuresByErasureIdRejectResponses, PostErasuresData, PostErasuresError, PostErasuresErrors, PostErasuresResponse, PostErasuresResponses, PostFeedbackData, PostFeedbackErrors, PostFeedbackResponse, PostFeedbackResponses, PostNotificationsByNotificationIdReadData, PostNotificationsByNotificationIdReadErrors, PostNotificationsByNotificationIdReadResponse, PostNotificationsByNotificationIdReadResponses, PostNotificationsReadData, PostNotificationsReadErrors, PostNotificationsReadResponse, PostNotificationsReadResponses, PostProfileAgreementsData, PostProfileAgreementsErrors, PostProfileAgreementsResponse, PostProfileAgreementsResponses, PostProfileData, PostProfileErrors, PostProfileResponse, PostProfileResponses, PostProfileTrainingData, PostProfileTrainingErrors, PostProfileTrainingResponse, PostProfileTrainingResponses, PostProjectsTreAdminByProjectIdApproveData, PostProjectsTreAdminByProjectIdApproveErrors, PostProjectsTreAdminByProjectIdApproveResponses, PostProjectsTreAdminImportData, PostProjectsTreAdminImportErrors, PostProjectsTreAdminImportResponse, PostProjectsTreAdminImportResponses, PostProjectsTreData, PostProjectsTreError, PostProjectsTreErrors, PostProjectsTreResponse, PostProjectsTreResponses, PostStudiesAdminAssetTransfersByTransferIdApproveData, PostStudiesAdminAssetTransfersByTransferIdApproveError, PostStudiesAdminAssetTransfersByTransferIdApproveErrors, PostStudiesAdminAssetTransfersByTransferIdApproveResponse, PostStudiesAdminAssetTransfersByTransferIdApproveResponses, PostStudiesAdminAssetTransfersByTransferIdRejectData, PostStudiesAdminAssetTransfersByTransferIdRejectError, PostStudiesAdminAssetTransfersByTransferIdRejectErrors, PostStudiesAdminAssetTransfersByTransferIdRejectResponse, PostStudiesAdminAssetTransfersByTransferIdRejectResponses, PostStudiesAdminByStudyIdArchiveData, PostStudiesAdminByStudyIdArchiveError, PostStudiesAdminByStudyIdArchiveErrors, PostStudiesAdminByStudyIdArchiveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveData, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveError, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponse, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectData, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectError, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponse, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses, PostStudiesAdminByStudyIdAssetsImportData, PostStudiesAdminByStudyIdAssetsImportResponse, PostStudiesAdminByStudyIdAssetsImportResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveData, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveError, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponse, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectData, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectError, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponse, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses, PostStudiesAdminByStudyIdContractsImportData, PostStudiesAdminByStudyIdContractsImportResponse, PostStudiesAdminByStudyIdContractsImportResponses, PostStudiesAdminByStudyIdDpiaSignoffData, PostStudiesAdminByStudyIdDpiaSignoffError, PostStudiesAdminByStudyIdDpiaSignoffErrors, PostStudiesAdminByStudyIdDpiaSignoffResponses, PostStudiesAdminByStudyIdOwnerApproveData, PostStudiesAdminByStudyIdOwnerApproveErrors, PostStudiesAdminByStudyIdOwnerApproveResponses, PostStudiesAdminByStudyIdOwnerCancelData, PostStudiesAdminByStudyIdOwnerCancelError, PostStudiesAdminByStudyIdOwnerCancelErrors, PostStudiesAdminByStudyIdOwnerCancelResponses, PostStudiesAdminByStudyIdOwnerRejectData, PostStudiesAdminByStudyIdOwnerRejectError, PostStudiesAdminByStudyIdOwnerRejectErrors, PostStudiesAdminByStudyIdOwnerRejectResponses, PostStudiesAdminByStudyIdOwnerRequestData, PostStudiesAdminByStudyIdOwnerRequestErrors, PostStudiesAdminByStudyIdOwnerRequestResponses, PostStudiesAdminByStudyIdReviewData, PostStudiesAdminByStudyIdReviewerClaimData, PostStudiesAdminByStudyIdReviewerClaimError, PostStudiesAdminByStudyIdReviewerClaimErrors, PostStudiesAdminByStudyIdReviewerClaimResponses, PostStudiesAdminByStudyIdReviewErrors, PostStudiesAdminByStudyIdReviewResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsData, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsError, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveData, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveErrors, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveResponses, PostStudiesAdminByStudyIdReviewThreadsData, PostStudiesAdminByStudyIdReviewThreadsError, PostStudiesAdminByStudyIdReviewThreadsErrors, PostStudiesAdminByStudyIdReviewThreadsResponse, PostStudiesAdminByStudyIdReviewThreadsResponses, PostStudiesAdminImportBulkData, PostStudiesAdminImportBulkError, PostStudiesAdminImportBulkErrors, PostStudiesAdminImportBulkResponse, PostStudiesAdminImportBulkResponses, PostStudiesAdminImportData, PostStudiesAdminImportResponse, PostStudiesAdminImportResponses, PostStudiesByStudyIdAgreementsData, PostStudiesByStudyIdAgreementsErrors, PostStudiesByStudyIdAgreementsResponses, PostStudiesByStudyIdApprovalsByApprovalIdLetterData, PostStudiesByStudyIdApprovalsByApprovalIdLetterError, PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors, PostStudiesByStudyIdApprovalsByApprovalIdLetterResponse, PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses, PostStudiesByStudyIdApprovalsData, PostStudiesByStudyIdApprovalsError, PostStudiesByStudyIdApprovalsErrors, PostStudiesByStudyIdApprovalsResponse, PostStudiesByStudyIdApprovalsResponses, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveData, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveError, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponse, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses, PostStudiesByStudyIdAssetsByAssetIdDestructionsData, PostStudiesByStudyIdAssetsByAssetIdDestructionsError, PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors, PostStudiesByStudyIdAssetsByAssetIdDestructionsResponse, PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses, PostStudiesByStudyIdAssetsByAssetIdParentsData, PostStudiesByStudyIdAssetsByAssetIdParentsError, PostStudiesByStudyIdAssetsByAssetIdParentsErrors, PostStudiesByStudyIdAssetsByAssetIdParentsResponse, PostStudiesByStudyIdAssetsByAssetIdParentsResponses, PostStudiesByStudyIdAssetsByAssetIdTransfersData, PostStudiesByStudyIdAssetsByAssetIdTransfersError, PostStudiesByStudyIdAssetsByAssetIdTransfersErrors, PostStudiesByStudyIdAssetsByAssetIdTransfersResponse, PostStudiesByStudyIdAssetsByAssetIdTransfersResponses, PostStudiesByStudyIdAssetsData, PostStudiesByStudyIdAssetsError, PostStudiesByStudyIdAssetsErrors, PostStudiesByStudyIdAssetsResponse, PostStudiesByStudyIdAssetsResponses, PostStudiesByStudyIdClosureCompleteData, PostStudiesByStudyIdClosureCompleteError, PostStudiesByStudyIdClosureCompleteErrors, PostStudiesByStudyIdClosureCompleteResponses, PostStudiesByStudyIdClosureData, PostStudiesByStudyIdClosureError, PostStudiesByStudyIdClosureErrors, PostStudiesByStudyIdClosureResponses, PostStudiesByStudyIdContractsByContractIdObjectsData, PostStudiesByStudyIdContractsByContractIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdObjectsResponse, PostStudiesByStudyIdContractsByContractIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdRenewData, PostStudiesByStudyIdContractsByContractIdRenewError, PostStudiesByStudyIdContractsByContractIdRenewErrors, PostStudiesByStudyIdContractsByContractIdRenewResponse, PostStudiesByStudyIdContractsByContractIdRenewResponses, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsData, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsError, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponse, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdVersionsData, PostStudiesByStudyIdContractsByContractIdVersionsError, PostStudiesByStudyIdContractsByContractIdVersionsErrors, PostStudiesByStudyIdContractsByContractIdVersionsResponse, PostStudiesByStudyIdContractsByContractIdVersionsResponses, PostStudiesByStudyIdContractsData, PostStudiesByStudyIdContractsError, PostStudiesByStudyIdContractsErrors, PostStudiesByStudyIdContractsResponse, PostStudiesByStudyIdContractsResponses, PostStudiesByStudyIdDocumentsByDocumentIdVersionsData, PostStudiesByStudyIdDocumentsByDocumentIdVersionsError, PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors, PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponse, PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses, PostStudiesByStudyIdDocumentsData, PostStudiesByStudyIdDocumentsError, PostStudiesByStudyIdDocumentsErrors, PostStudiesByStudyIdDocumentsResponse, PostStudiesByStudyIdDocumentsResponses, PostStudiesByStudyIdDpiaSubmitData, PostStudiesByStudyIdDpiaSubmitError, PostStudiesByStudyIdDpiaSubmitErrors, PostStudiesByStudyIdDpiaSubmitResponses, PostStudiesByStudyIdOwnerCancelData, PostStudiesByStudyIdOwnerCancelError, PostStudiesByStudyIdOwnerCancelErrors, PostStudiesByStudyIdOwnerCancelResponses, PostStudiesByStudyIdOwnerRequestData, PostStudiesByStudyIdOwnerRequestErrors, PostStudiesByStudyIdOwnerRequestResponses, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsData, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsError, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesByStudyIdSignoffData, PostStudiesByStudyIdSignoffError, PostStudiesByStudyIdSignoffErrors, PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdTransfersByTransferIdApproveData, PostStudiesByStudyIdTransfersByTransferIdApproveError, PostStudiesByStudyIdTransfersByTransferIdApproveErrors, PostStudiesByStudyIdTransfersByTransferIdApproveResponse, PostStudiesByStudyIdTransfersByTransferIdApproveResponses, PostStudiesByStudyIdTransfersByTransferIdRejectData, PostStudiesByStudyIdTransfersByTransferIdRejectError, PostStudiesByStudyIdTransfersByTransferIdRejectErrors, PostStudiesByStudyIdTransfersByTransferIdRejectResponse, PostStudiesByStudyIdTransfersByTransferIdRejectResponses, PostStudiesData, PostStudiesError, PostStudiesErrors, PostStudiesResponses, PostTokensByEnvironmentData, PostTokensByEnvironmentErrors, PostTokensByEnvironmentResponse, PostTokensByEnvironmentResponses, PostUsersApprovedResearchersImportCsvData, PostUsersApprovedResearchersImportCsvErrors, PostUsersApprovedResearchersImportCsvResponse, PostUsersApprovedResearchersImportCsvResponses, PostUsersByUserIdTrainingData, PostUsersByUserIdTrainingErrors, PostUsersByUserIdTrainingResponse, PostUsersByUserIdTrainingResponses, PostUsersInviteData, PostUsersInviteErrors, PostUsersInviteResponses, Profile, ProfileTraining, ProfileTrainingResponse, ProfileTrainingUpdate, ProfileUpdate, ProfileUpdateResponse, Project, ProjectDsh, ProjectDshMember, ProjectDshRole, ProjectDshStatus, ProjectIdParam, ProjectTre, ProjectTreBase, ProjectTreImport, ProjectTreMember, ProjectTreRequest, ProjectTreRoleName, ProjectTreStatus, ProjectTreUpdate, ProjectTreUserDesktopConfig, PutProjectsTreByProjectIdData, PutProjectsTreByProjectIdError, PutProjectsTreByProjectIdErrors, PutProjectsTreByProjectIdResponses, PutStudiesAdminByStudyIdReviewerData, PutStudiesAdminByStudyIdReviewerError, PutStudiesAdminByStudyIdReviewerErrors, PutStudiesAdminByStudyIdReviewerResponses, PutStudiesByStudyIdApprovalsByApprovalIdData, PutStudiesByStudyIdApprovalsByApprovalIdError, PutStudiesByStudyIdApprovalsByApprovalIdErrors, PutStudiesByStudyIdApprovalsByApprovalIdResponse, PutStudiesByStudyIdApprovalsByApprovalIdResponses, PutStudiesByStudyIdAssetsByAssetIdData, PutStudiesByStudyIdAssetsByAssetIdError, PutStudiesByStudyIdAssetsByAssetIdErrors, PutStudiesByStudyIdAssetsByAssetIdResponse, PutStudiesByStudyIdAssetsByAssetIdResponses, PutStudiesByStudyIdContractsByContractIdData, PutStudiesByStudyIdContractsByContractIdError, PutStudiesByStudyIdContractsByContractIdErrors, PutStudiesByStudyIdContractsByContractIdResponse, PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdData, PutStudiesByStudyIdDpiaData, PutStudiesByStudyIdDpiaError, PutStudiesByStudyIdDpiaErrors, PutStudiesByStudyIdDpiaResponse, PutStudiesByStudyIdDpiaResponses, PutStudiesByStudyIdError, PutStudiesByStudyIdErrors, PutStudiesByStudyIdResponses, PutUsersByUserIdAttributesData, PutUsersByUserIdAttributesErrors, PutUsersByUserIdAttributesResponses, RegulatoryApproval, RegulatoryApprovalBase, RegulatoryApprovalLetter, RegulatoryApprovalType, ReviewThreadIdParam, SearchEntityType, SearchFacetCount, SearchFacets, SearchResult, SearchResults, Study, StudyAgreements, StudyApprovalStatus, StudyBase, StudyBulkImport, StudyBulkImportError, StudyBulkImportReport, StudyClosure, StudyClosureItem, StudyDocument, StudyDocumentCategory, StudyDocumentUpload, StudyDocumentVersion, StudyDocumentVersionUpload, StudyFieldChange, StudyIdParam, StudyImport, StudyOwnerChange, StudyOwnerChangeDecision, StudyOwnerUpdate, StudyRequest, StudyReview, StudyReviewComment, StudyReviewCommentRequest, StudyReviewerUpdate, StudyReviewThread, StudyReviewThreadRequest, StudyRevision, StudyRevisionDiff, StudyRiskRating, StudySignoff, StudySignoffRequest, Token, TokenRequest, TokenWithValue, TrainingKind, TrainingRecord, TransferIdParam, User, UserAgreements, UserData, UserDataLookup, UserFindParam, UserIdParam, UserMetrics, UserTrainingUpdate, ValidationError } from './types.gen';
@@ -2,7 +2,7 @@
 
 import { type Client, type ClientMeta, formDataBodySerializer, type Options as Options2, type RequestResult, type TDataShape } from './client';
 import { client } from './client.gen';
-import type { DeleteProjectsTreByProjectIdData, DeleteProjectsTreByProjectIdErrors, DeleteProjectsTreByProjectIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdResponses, DeleteStudiesByStudyIdContractsByContractIdData, DeleteStudiesByStudyIdContractsByContractIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdResponses, DeleteTokensByEnvironmentByTokenIdData, DeleteTokensByEnvironmentByTokenIdErrors, DeleteTokensByEnvironmentByTokenIdResponses, GetAgreementsByAgreementTypeData, GetAgreementsByAgreementTypeErrors, GetAgreementsByAgreementTypeResponses, GetAuthData, GetAuthErrors, GetAuthResponses, GetEnvironmentsData, GetEnvironmentsErrors, GetEnvironmentsResponses, GetLogoutData, GetLogoutErrors, GetLogoutResponses, GetNotificationsData, GetNotificationsErrors, GetNotificationsResponses, GetProfileAgreementsData, GetProfileAgreementsErrors, GetProfileAgreementsResponses, GetProfileData, GetProfileErrors, GetProfileResponses, GetProfileTrainingData, GetProfileTrainingErrors, GetProfileTrainingResponses, GetProjectsData, GetProjectsDshByProjectIdData, GetProjectsDshByProjectIdErrors, GetProjectsDshByProjectIdResponses, GetProjectsErrors, GetProjectsResponses, GetProjectsTreByProjectIdData, GetProjectsTreByProjectIdErrors, GetProjectsTreByProjectIdResponses, GetProjectsTreData, GetProjectsTreErrors, GetProjectsTreResponses, GetStudiesByStudyIdAgreementsData, GetStudiesByStudyIdAgreementsErrors, GetStudiesByStudyIdAgreementsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsData, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdData, GetStudiesByStudyIdAssetsByAssetIdErrors, GetStudiesByStudyIdAssetsByAssetIdResponses, GetStudiesByStudyIdAssetsData, GetStudiesByStudyIdAssetsErrors, GetStudiesByStudyIdAssetsResponses, GetStudiesByStudyIdContractsByContractIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdResponses, GetStudiesByStudyIdContractsData, GetStudiesByStudyIdContractsErrors, GetStudiesByStudyIdContractsResponses, GetStudiesByStudyIdData, GetStudiesByStudyIdErrors, GetStudiesByStudyIdResponses, GetStudiesData, GetStudiesErrors, GetStudiesResponses, GetTokensByEnvironmentData, GetTokensByEnvironmentErrors, GetTokensByEnvironmentResponses, GetUsersByUserIdData, GetUsersByUserIdErrors, GetUsersByUserIdResponses, GetUsersData, GetUsersErrors, GetUsersLookupData, GetUsersLookupErrors, GetUsersLookupResponses, GetUsersMetricsData, GetUsersMetricsResponses, GetUsersResponses, PatchProjectsTreByProjectIdPendingData, PatchProjectsTreByProjectIdPendingErrors, PatchProjectsTreByProjectIdPendingResponses, PatchStudiesByStudyIdPendingData, PatchStudiesByStudyIdPendingErrors, PatchStudiesByStudyIdPendingResponses, PostFeedbackData, PostFeedbackErrors, PostFeedbackResponses, PostNotificationsByNotificationIdReadData, PostNotificationsByNotificationIdReadErrors, PostNotificationsByNotificationIdReadResponses, PostNotificationsReadData, PostNotificationsReadErrors, PostNotificationsReadResponses, PostProfileAgreementsData, PostProfileAgreementsErrors, PostProfileAgreementsResponses, PostProfileData, PostProfileErrors, PostProfileResponses, PostProfileTrainingData, PostProfileTrainingErrors, PostProfileTrainingResponses, PostProjectsTreAdminByProjectIdApproveData, PostProjectsTreAdminByProjectIdApproveErrors, PostProjectsTreAdminByProjectIdApproveResponses, PostProjectsTreAdminImportData, PostProjectsTreAdminImportErrors, PostProjectsTreAdminImportResponses, PostProjectsTreData, PostProjectsTreErrors, PostProjectsTreResponses, PostStudiesAdminByStudyIdAssetsImportData, PostStudiesAdminByStudyIdAssetsImportResponses, PostStudiesAdminByStudyIdContractsImportData, PostStudiesAdminByStudyIdContractsImportResponses, PostStudiesAdminByStudyIdOwnerApproveData, PostStudiesAdminByStudyIdOwnerApproveErrors, PostStudiesAdminByStudyIdOwnerApproveResponses, PostStudiesAdminByStudyIdOwnerRequestData, PostStudiesAdminByStudyIdOwnerRequestErrors, PostStudiesAdminByStudyIdOwnerRequestResponses, PostStudiesAdminByStudyIdReviewData, PostStudiesAdminByStudyIdReviewErrors, PostStudiesAdminByStudyIdReviewResponses, PostStudiesAdminImportData, PostStudiesAdminImportResponses, PostStudiesByStudyIdAgreementsData, PostStudiesByStudyIdAgreementsErrors, PostStudiesByStudyIdAgreementsResponses, PostStudiesByStudyIdAssetsData, PostStudiesByStudyIdAssetsErrors, PostStudiesByStudyIdAssetsResponses, PostStudiesByStudyIdContractsByContractIdObjectsData, PostStudiesByStudyIdContractsByContractIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdObjectsResponses, PostStudiesByStudyIdContractsData, PostStudiesByStudyIdContractsErrors, PostStudiesByStudyIdContractsResponses, PostStudiesByStudyIdOwnerRequestData, PostStudiesByStudyIdOwnerRequestErrors, PostStudiesByStudyIdOwnerRequestResponses, PostStudiesByStudyIdSignoffData, PostStudiesByStudyIdSignoffErrors, PostStudiesByStudyIdSignoffResponses, PostStudiesData, PostStudiesErrors, PostStudiesResponses, PostTokensByEnvironmentData, PostTokensByEnvironmentErrors, PostTokensByEnvironmentResponses, PostUsersApprovedResearchersImportCsvData, PostUsersApprovedResearchersImportCsvErrors, PostUsersApprovedResearchersImportCsvResponses, PostUsersByUserIdTrainingData, PostUsersByUserIdTrainingErrors, PostUsersByUserIdTrainingResponses, PostUsersInviteData, PostUsersInviteErrors, PostUsersInviteResponses, PutProjectsTreByProjectIdData, PutProjectsTreByProjectIdErrors, PutProjectsTreByProjectIdResponses, PutStudiesByStudyIdAssetsByAssetIdData, PutStudiesByStudyIdAssetsByAssetIdErrors, PutStudiesByStudyIdAssetsByAssetIdResponses, PutStudiesByStudyIdContractsByContractIdData, PutStudiesByStudyIdContractsByContractIdErrors, PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdData, PutStudiesByStudyIdErrors, PutStudiesByStudyIdResponses, PutUsersByUserIdAttributesData, PutUsersByUserIdAttributesErrors, PutUsersByUserIdAttributesResponses } from './types.gen';
+import type { DeleteProjectsTreByProjectIdData, DeleteProjectsTreByProjectIdErrors, DeleteProjectsTreByProjectIdResponses, DeleteStudiesAdminByStudyIdReviewerData, DeleteStudiesAdminByStudyIdReviewerErrors, DeleteStudiesAdminByStudyIdReviewerResponses, DeleteStudiesByStudyIdApprovalsByApprovalIdData, DeleteStudiesByStudyIdApprovalsByApprovalIdErrors, DeleteStudiesByStudyIdApprovalsByApprovalIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdData, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdErrors, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdResponses, DeleteStudiesByStudyIdContractsByContractIdData, DeleteStudiesByStudyIdContractsByContractIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdResponses, DeleteStudiesByStudyIdDocumentsByDocumentIdData, DeleteStudiesByStudyIdDocumentsByDocumentIdErrors, DeleteStudiesByStudyIdDocumentsByDocumentIdResponses, DeleteTokensByEnvironmentByTokenIdData, DeleteTokensByEnvironmentByTokenIdErrors, DeleteTokensByEnvironmentByTokenIdResponses, GetAgreementsByAgreementTypeData, GetAgreementsByAgreementTypeErrors, GetAgreementsByAgreementTypeResponses, GetAuthData, GetAuthErrors, GetAuthResponses, GetEnvironmentsData, GetEnvironmentsErrors, GetEnvironmentsResponses, GetErasuresByErasureIdData, GetErasuresByErasureIdErrors, GetErasuresByErasureIdResponses, GetErasuresData, GetErasuresErrors, GetErasuresResponses, GetLogoutData, GetLogoutErrors, GetLogoutResponses, GetNotificationsData, GetNotificationsErrors, GetNotificationsResponses, GetProfileAgreementsData, GetProfileAgreementsErrors, GetProfileAgreementsResponses, GetProfileData, GetProfileErrors, GetProfileResponses, GetProfileTrainingData, GetProfileTrainingErrors, GetProfileTrainingResponses, GetProjectsData, GetProjectsDshByProjectIdData, GetProjectsDshByProjectIdErrors, GetProjectsDshByProjectIdResponses, GetProjectsErrors, GetProjectsResponses, GetProjectsTreByProjectIdData, GetProjectsTreByProjectIdErrors, GetProjectsTreByProjectIdResponses, GetProjectsTreData, GetProjectsTreErrors, GetProjectsTreResponses, GetSearchData, GetSearchErrors, GetSearchResponses, GetStudiesAdminAssetDestructionsData, GetStudiesAdminAssetDestructionsErrors, GetStudiesAdminAssetDestructionsResponses, GetStudiesAdminAssetLocationsData, GetStudiesAdminAssetLocationsErrors, GetStudiesAdminAssetLocationsResponses, GetStudiesAdminAssetRegisterData, GetStudiesAdminAssetRegisterErrors, GetStudiesAdminAssetRegisterExportsByExportIdFileData, GetStudiesAdminAssetRegisterExportsByExportIdFileErrors, GetStudiesAdminAssetRegisterExportsByExportIdFileResponses, GetStudiesAdminAssetRegisterExportsData, GetStudiesAdminAssetRegisterExportsErrors, GetStudiesAdminAssetRegisterExportsResponses, GetStudiesAdminAssetRegisterResponses, GetStudiesAdminAssetTransfersData, GetStudiesAdminAssetTransfersErrors, GetStudiesAdminAssetTransfersResponses, GetStudiesAdminByStudyIdContractsByContractIdDownloadsData, GetStudiesAdminByStudyIdContractsByContractIdDownloadsErrors, GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses, GetStudiesAdminOwnerChangesData, GetStudiesAdminOwnerChangesErrors, GetStudiesAdminOwnerChangesResponses, GetStudiesByStudyIdAgreementsData, GetStudiesByStudyIdAgreementsErrors, GetStudiesByStudyIdAgreementsResponses, GetStudiesByStudyIdApprovalsByApprovalIdLetterData, GetStudiesByStudyIdApprovalsByApprovalIdLetterErrors, GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses, GetStudiesByStudyIdApprovalsData, GetStudiesByStudyIdApprovalsErrors, GetStudiesByStudyIdApprovalsResponses, GetStudiesByStudyIdAssetExpiryReviewsData, GetStudiesByStudyIdAssetExpiryReviewsErrors, GetStudiesByStudyIdAssetExpiryReviewsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsData, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdData, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateData, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateErrors, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsData, GetStudiesByStudyIdAssetsByAssetIdDestructionsErrors, GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses, GetStudiesByStudyIdAssetsByAssetIdErrors, GetStudiesByStudyIdAssetsByAssetIdResponses, GetStudiesByStudyIdAssetsByAssetIdTransfersData, GetStudiesByStudyIdAssetsByAssetIdTransfersErrors, GetStudiesByStudyIdAssetsByAssetIdTransfersResponses, GetStudiesByStudyIdAssetsData, GetStudiesByStudyIdAssetsErrors, GetStudiesByStudyIdAssetsResponses, GetStudiesByStudyIdClosureData, GetStudiesByStudyIdClosureErrors, GetStudiesByStudyIdClosureResponses, GetStudiesByStudyIdContractsByContractIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdResponses, GetStudiesByStudyIdContractsByContractIdVersionsData, GetStudiesByStudyIdContractsByContractIdVersionsErrors, GetStudiesByStudyIdContractsByContractIdVersionsResponses, GetStudiesByStudyIdContractsData, GetStudiesByStudyIdContractsErrors, GetStudiesByStudyIdContractsResponses, GetStudiesByStudyIdData, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdData, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdErrors, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses, GetStudiesByStudyIdDocumentsData, GetStudiesByStudyIdDocumentsErrors, GetStudiesByStudyIdDocumentsResponses, GetStudiesByStudyIdDpiaData, GetStudiesByStudyIdDpiaErrors, GetStudiesByStudyIdDpiaResponses, GetStudiesByStudyIdErrors, GetStudiesByStudyIdLineageData, GetStudiesByStudyIdLineageErrors, GetStudiesByStudyIdLineageResponses, GetStudiesByStudyIdResponses, GetStudiesByStudyIdReviewThreadsData, GetStudiesByStudyIdReviewThreadsErrors, GetStudiesByStudyIdReviewThreadsResponses, GetStudiesByStudyIdRevisionsData, GetStudiesByStudyIdRevisionsDiffData, GetStudiesByStudyIdRevisionsDiffErrors, GetStudiesByStudyIdRevisionsDiffResponses, GetStudiesByStudyIdRevisionsErrors, GetStudiesByStudyIdRevisionsResponses, GetStudiesByStudyIdSignoffsData, GetStudiesByStudyIdSignoffsErrors, GetStudiesByStudyIdSignoffsResponses, GetStudiesByStudyIdTransfersData, GetStudiesByStudyIdTransfersErrors, GetStudiesByStudyIdTransfersResponses, GetStudiesData, GetStudiesErrors, GetStudiesResponses, GetTokensByEnvironmentData, GetTokensByEnvironmentErrors, GetTokensByEnvironmentResponses, GetUsersByUserIdData, GetUsersByUserIdErrors, GetUsersByUserIdResponses, GetUsersData, GetUsersErrors, GetUsersLookupData, GetUsersLookupErrors, GetUsersLookupResponses, GetUsersMetricsData, GetUsersMetricsResponses, GetUsersResponses, PatchProjectsTreByProjectIdPendingData, PatchProjectsTreByProjectIdPendingErrors, PatchProjectsTreByProjectIdPendingResponses, PatchStudiesByStudyIdPendingData, PatchStudiesByStudyIdPendingErrors, PatchStudiesByStudyIdPendingResponses, PostErasuresByErasureIdApproveData, PostErasuresByErasureIdApproveErrors, PostErasuresByErasureIdApproveResponses, PostErasuresByErasureIdRejectData, PostErasuresByErasureIdRejectErrors, PostErasuresByErasureIdRejectResponses, PostErasuresData, PostErasuresErrors, PostErasuresResponses, PostFeedbackData, PostFeedbackErrors, PostFeedbackResponses, PostNotificationsByNotificationIdReadData, PostNotificationsByNotificationIdReadErrors, PostNotificationsByNotificationIdReadResponses, PostNotificationsReadData, PostNotificationsReadErrors, PostNotificationsReadResponses, PostProfileAgreementsData, PostProfileAgreementsErrors, PostProfileAgreementsResponses, PostProfileData, PostProfileErrors, PostProfileResponses, PostProfileTrainingData, PostProfileTrainingErrors, PostProfileTrainingResponses, PostProjectsTreAdminByProjectIdApproveData, PostProjectsTreAdminByProjectIdApproveErrors, PostProjectsTreAdminByProjectIdApproveResponses, PostProjectsTreAdminImportData, PostProjectsTreAdminImportErrors, PostProjectsTreAdminImportResponses, PostProjectsTreData, PostProjectsTreErrors, PostProjectsTreResponses, PostStudiesAdminAssetTransfersByTransferIdApproveData, PostStudiesAdminAssetTransfersByTransferIdApproveErrors, PostStudiesAdminAssetTransfersByTransferIdApproveResponses, PostStudiesAdminAssetTransfersByTransferIdRejectData, PostStudiesAdminAssetTransfersByTransferIdRejectErrors, PostStudiesAdminAssetTransfersByTransferIdRejectResponses, PostStudiesAdminByStudyIdArchiveData, PostStudiesAdminByStudyIdArchiveErrors, PostStudiesAdminByStudyIdArchiveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveData, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectData, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses, PostStudiesAdminByStudyIdAssetsImportData, PostStudiesAdminByStudyIdAssetsImportResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveData, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectData, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses, PostStudiesAdminByStudyIdContractsImportData, PostStudiesAdminByStudyIdContractsImportResponses, PostStudiesAdminByStudyIdDpiaSignoffData, PostStudiesAdminByStudyIdDpiaSignoffErrors, PostStudiesAdminByStudyIdDpiaSignoffResponses, PostStudiesAdminByStudyIdOwnerApproveData, PostStudiesAdminByStudyIdOwnerApproveErrors, PostStudiesAdminByStudyIdOwnerApproveResponses, PostStudiesAdminByStudyIdOwnerCancelData, PostStudiesAdminByStudyIdOwnerCancelErrors, PostStudiesAdminByStudyIdOwnerCancelResponses, PostStudiesAdminByStudyIdOwnerRejectData, PostStudiesAdminByStudyIdOwnerRejectErrors, PostStudiesAdminByStudyIdOwnerRejectResponses, PostStudiesAdminByStudyIdOwnerRequestData, PostStudiesAdminByStudyIdOwnerRequestErrors, PostStudiesAdminByStudyIdOwnerRequestResponses, PostStudiesAdminByStudyIdReviewData, PostStudiesAdminByStudyIdReviewerClaimData, PostStudiesAdminByStudyIdReviewerClaimErrors, PostStudiesAdminByStudyIdReviewerClaimResponses, PostStudiesAdminByStudyIdReviewErrors, PostStudiesAdminByStudyIdReviewResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsData, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveData, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveErrors, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveResponses, PostStudiesAdminByStudyIdReviewThreadsData, PostStudiesAdminByStudyIdReviewThreadsErrors, PostStudiesAdminByStudyIdReviewThreadsResponses, PostStudiesAdminImportBulkData, PostStudiesAdminImportBulkErrors, PostStudiesAdminImportBulkResponses, PostStudiesAdminImportData, PostStudiesAdminImportResponses, PostStudiesByStudyIdAgreementsData, PostStudiesByStudyIdAgreementsErrors, PostStudiesByStudyIdAgreementsResponses, PostStudiesByStudyIdApprovalsByApprovalIdLetterData, PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors, PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses, PostStudiesByStudyIdApprovalsData, PostStudiesByStudyIdApprovalsErrors, PostStudiesByStudyIdApprovalsResponses, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveData, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses, PostStudiesByStudyIdAssetsByAssetIdDestructionsData, PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors, PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses, PostStudiesByStudyIdAssetsByAssetIdParentsData, PostStudiesByStudyIdAssetsByAssetIdParentsErrors, PostStudiesByStudyIdAssetsByAssetIdParentsResponses, PostStudiesByStudyIdAssetsByAssetIdTransfersData, PostStudiesByStudyIdAssetsByAssetIdTransfersErrors, PostStudiesByStudyIdAssetsByAssetIdTransfersResponses, PostStudiesByStudyIdAssetsData, PostStudiesByStudyIdAssetsErrors, PostStudiesByStudyIdAssetsResponses, PostStudiesByStudyIdClosureCompleteData, PostStudiesByStudyIdClosureCompleteErrors, PostStudiesByStudyIdClosureCompleteResponses, PostStudiesByStudyIdClosureData, PostStudiesByStudyIdClosureErrors, PostStudiesByStudyIdClosureResponses, PostStudiesByStudyIdContractsByContractIdObjectsData, PostStudiesByStudyIdContractsByContractIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdRenewData, PostStudiesByStudyIdContractsByContractIdRenewErrors, PostStudiesByStudyIdContractsByContractIdRenewResponses, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsData, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdVersionsData, PostStudiesByStudyIdContractsByContractIdVersionsErrors, PostStudiesByStudyIdContractsByContractIdVersionsResponses, PostStudiesByStudyIdContractsData, PostStudiesByStudyIdContractsErrors, PostStudiesByStudyIdContractsResponses, PostStudiesByStudyIdDocumentsByDocumentIdVersionsData, PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors, PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses, PostStudiesByStudyIdDocumentsData, PostStudiesByStudyIdDocumentsErrors, PostStudiesByStudyIdDocumentsResponses, PostStudiesByStudyIdDpiaSubmitData, PostStudiesByStudyIdDpiaSubmitErrors, PostStudiesByStudyIdDpiaSubmitResponses, PostStudiesByStudyIdOwnerCancelData, PostStudiesByStudyIdOwnerCancelErrors, PostStudiesByStudyIdOwnerCancelResponses, PostStudiesByStudyIdOwnerRequestData, PostStudiesByStudyIdOwnerRequestErrors, PostStudiesByStudyIdOwnerRequestResponses, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsData, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesByStudyIdSignoffData, PostStudiesByStudyIdSignoffErrors, PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdTransfersByTransferIdApproveData, PostStudiesByStudyIdTransfersByTransferIdApproveErrors, PostStudiesByStudyIdTransfersByTransferIdApproveResponses, PostStudiesByStudyIdTransfersByTransferIdRejectData, PostStudiesByStudyIdTransfersByTransferIdRejectErrors, PostStudiesByStudyIdTransfersByTransferIdRejectResponses, PostStudiesData, PostStudiesErrors, PostStudiesResponses, PostTokensByEnvironmentData, PostTokensByEnvironmentErrors, PostTokensByEnvironmentResponses, PostUsersApprovedResearchersImportCsvData, PostUsersApprovedResearchersImportCsvErrors, PostUsersApprovedResearchersImportCsvResponses, PostUsersByUserIdTrainingData, PostUsersByUserIdTrainingErrors, PostUsersByUserIdTrainingResponses, PostUsersInviteData, PostUsersInviteErrors, PostUsersInviteResponses, PutProjectsTreByProjectIdData, PutProjectsTreByProjectIdErrors, PutProjectsTreByProjectIdResponses, PutStudiesAdminByStudyIdReviewerData, PutStudiesAdminByStudyIdReviewerErrors, PutStudiesAdminByStudyIdReviewerResponses, PutStudiesByStudyIdApprovalsByApprovalIdData, PutStudiesByStudyIdApprovalsByApprovalIdErrors, PutStudiesByStudyIdApprovalsByApprovalIdResponses, PutStudiesByStudyIdAssetsByAssetIdData, PutStudiesByStudyIdAssetsByAssetIdErrors, PutStudiesByStudyIdAssetsByAssetIdResponses, PutStudiesByStudyIdContractsByContractIdData, PutStudiesByStudyIdContractsByContractIdErrors, PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdData, PutStudiesByStudyIdDpiaData, PutStudiesByStudyIdDpiaErrors, PutStudiesByStudyIdDpiaResponses, PutStudiesByStudyIdErrors, PutStudiesByStudyIdResponses, PutUsersByUserIdAttributesData, PutUsersByUserIdAttributesErrors, PutUsersByUserIdAttributesResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean, TResponse = unknown> = Options2<TData, ThrowOnError, TResponse> & {
     /**
@@ -202,6 +202,13 @@ export const postUsersInvite = <ThrowOnError extends boolean = false>(options: O
  */
 export const getUsersMetrics = <ThrowOnError extends boolean = false>(options?: Options<GetUsersMetricsData, ThrowOnError>): RequestResult<GetUsersMetricsResponses, unknown, ThrowOnError> => (options?.client ?? client).get<GetUsersMetricsResponses, unknown, ThrowOnError>({ url: '/users/metrics', ...options });
 
+/**
+ * Search the studies, assets, contracts and projects accessible to the current user. Matches
+ * titles, descriptions, third party names, asset locations, project names and study caserefs
+ *
+ */
+export const getSearch = <ThrowOnError extends boolean = false>(options: Options<GetSearchData, ThrowOnError>): RequestResult<GetSearchResponses, GetSearchErrors, ThrowOnError> => (options.client ?? client).get<GetSearchResponses, GetSearchErrors, ThrowOnError>({ url: '/search', ...options });
+
 /**
  * Get all studies accessible to the current user. Filtering is admin only
  */
@@ -248,6 +255,28 @@ export const postStudiesAdminByStudyIdReview = <ThrowOnError extends boolean = f
     }
 });
 
+/**
+ * Unassign the reviewer of a study so that it can be claimed
+ */
+export const deleteStudiesAdminByStudyIdReviewer = <ThrowOnError extends boolean = false>(options: Options<DeleteStudiesAdminByStudyIdReviewerData, ThrowOnError>): RequestResult<DeleteStudiesAdminByStudyIdReviewerResponses, DeleteStudiesAdminByStudyIdReviewerErrors, ThrowOnError> => (options.client ?? client).delete<DeleteStudiesAdminByStudyIdReviewerResponses, DeleteStudiesAdminByStudyIdReviewerErrors, ThrowOnError>({ url: '/studies/admin/{studyId}/reviewer', ...options });
+
+/**
+ * Assign, or reassign, the review of a study to an IG ops staff member
+ */
+export const putStudiesAdminByStudyIdReviewer = <ThrowOnError extends boolean = false>(options: Options<PutStudiesAdminByStudyIdReviewerData, ThrowOnError>): RequestResult<PutStudiesAdminByStudyIdReviewerResponses, PutStudiesAdminByStudyIdReviewerErrors, ThrowOnError> => (options.client ?? client).put<PutStudiesAdminByStudyIdReviewerResponses, PutStudiesAdminByStudyIdReviewerErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/reviewer',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Claim the review of a study for the current user
+ */
+export const postStudiesAdminByStudyIdReviewerClaim = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdReviewerClaimData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdReviewerClaimResponses, PostStudiesAdminByStudyIdReviewerClaimErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdReviewerClaimResponses, PostStudiesAdminByStudyIdReviewerClaimErrors, ThrowOnError>({ url: '/studies/admin/{studyId}/reviewer/claim', ...options });
+
 /**
  * Request an owner change
  */
@@ -272,6 +301,79 @@ export const postStudiesAdminByStudyIdOwnerApprove = <ThrowOnError extends boole
     }
 });
 
+/**
+ * Reject the pending owner change of a study
+ */
+export const postStudiesAdminByStudyIdOwnerReject = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdOwnerRejectData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdOwnerRejectResponses, PostStudiesAdminByStudyIdOwnerRejectErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdOwnerRejectResponses, PostStudiesAdminByStudyIdOwnerRejectErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/owner-reject',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Cancel the pending owner change of a study
+ */
+export const postStudiesAdminByStudyIdOwnerCancel = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdOwnerCancelData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdOwnerCancelResponses, PostStudiesAdminByStudyIdOwnerCancelErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdOwnerCancelResponses, PostStudiesAdminByStudyIdOwnerCancelErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/owner-cancel',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the owner changes awaiting a decision across all studies, oldest first
+ */
+export const getStudiesAdminOwnerChanges = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminOwnerChangesData, ThrowOnError>): RequestResult<GetStudiesAdminOwnerChangesResponses, GetStudiesAdminOwnerChangesErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminOwnerChangesResponses, GetStudiesAdminOwnerChangesErrors, ThrowOnError>({ url: '/studies/admin/owner-changes', ...options });
+
+/**
+ * Get the asset destruction requests awaiting approval across all studies, oldest first
+ */
+export const getStudiesAdminAssetDestructions = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminAssetDestructionsData, ThrowOnError>): RequestResult<GetStudiesAdminAssetDestructionsResponses, GetStudiesAdminAssetDestructionsErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminAssetDestructionsResponses, GetStudiesAdminAssetDestructionsErrors, ThrowOnError>({ url: '/studies/admin/asset-destructions', ...options });
+
+/**
+ * Get the active assets of all studies grouped by the location they are stored in
+ */
+export const getStudiesAdminAssetLocations = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminAssetLocationsData, ThrowOnError>): RequestResult<GetStudiesAdminAssetLocationsResponses, GetStudiesAdminAssetLocationsErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminAssetLocationsResponses, GetStudiesAdminAssetLocationsErrors, ThrowOnError>({ url: '/studies/admin/asset-locations', ...options });
+
+/**
+ * Export the information asset register of every asset across all studies, ordered by study caseref
+ * then asset title
+ *
+ */
+export const getStudiesAdminAssetRegister = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminAssetRegisterData, ThrowOnError>): RequestResult<GetStudiesAdminAssetRegisterResponses, GetStudiesAdminAssetRegisterErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminAssetRegisterResponses, GetStudiesAdminAssetRegisterErrors, ThrowOnError>({ url: '/studies/admin/asset-register', ...options });
+
+/**
+ * Get the scheduled exports of the asset register stored in S3, most recent first
+ */
+export const getStudiesAdminAssetRegisterExports = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminAssetRegisterExportsData, ThrowOnError>): RequestResult<GetStudiesAdminAssetRegisterExportsResponses, GetStudiesAdminAssetRegisterExportsErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminAssetRegisterExportsResponses, GetStudiesAdminAssetRegisterExportsErrors, ThrowOnError>({ url: '/studies/admin/asset-register/exports', ...options });
+
+/**
+ * Download a scheduled export of the asset register
+ */
+export const getStudiesAdminAssetRegisterExportsByExportIdFile = <ThrowOnError extends boolean = false>(options: Options<GetStudiesAdminAssetRegisterExportsByExportIdFileData, ThrowOnError>): RequestResult<GetStudiesAdminAssetRegisterExportsByExportIdFileResponses, GetStudiesAdminAssetRegisterExportsByExportIdFileErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesAdminAssetRegisterExportsByExportIdFileResponses, GetStudiesAdminAssetRegisterExportsByExportIdFileErrors, ThrowOnError>({ url: '/studies/admin/asset-register/exports/{exportId}/file', ...options });
+
+/**
+ * Get the asset transfers approved by both study owners and awaiting IG approval, oldest first
+ */
+export const getStudiesAdminAssetTransfers = <ThrowOnError extends boolean = false>(options?: Options<GetStudiesAdminAssetTransfersData, ThrowOnError>): RequestResult<GetStudiesAdminAssetTransfersResponses, GetStudiesAdminAssetTransfersErrors, ThrowOnError> => (options?.client ?? client).get<GetStudiesAdminAssetTransfersResponses, GetStudiesAdminAssetTransfersErrors, ThrowOnError>({ url: '/studies/admin/asset-transfers', ...options });
+
+/**
+ * Approve an asset transfer which both study owners have approved. The asset, its locations, data types
+ * and contracts are moved to the target study
+ *
+ */
+export const postStudiesAdminAssetTransfersByTransferIdApprove = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminAssetTransfersByTransferIdApproveData, ThrowOnError>): RequestResult<PostStudiesAdminAssetTransfersByTransferIdApproveResponses, PostStudiesAdminAssetTransfersByTransferIdApproveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminAssetTransfersByTransferIdApproveResponses, PostStudiesAdminAssetTransfersByTransferIdApproveErrors, ThrowOnError>({ url: '/studies/admin/asset-transfers/{transferId}/approve', ...options });
+
+/**
+ * Reject a pending asset transfer. The asset is unchanged
+ */
+export const postStudiesAdminAssetTransfersByTransferIdReject = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminAssetTransfersByTransferIdRejectData, ThrowOnError>): RequestResult<PostStudiesAdminAssetTransfersByTransferIdRejectResponses, PostStudiesAdminAssetTransfersByTransferIdRejectErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminAssetTransfersByTransferIdRejectResponses, PostStudiesAdminAssetTransfersByTransferIdRejectErrors, ThrowOnError>({ url: '/studies/admin/asset-transfers/{transferId}/reject', ...options });
+
 /**
  * Tempoary endpoint to import a study object. Idempotent. Updates on caseref
  */
@@ -308,6 +410,24 @@ export const postStudiesAdminByStudyIdContractsImport = <ThrowOnError extends bo
     }
 });
 
+/**
+ * Import studies, assets and contracts from either an XLSX workbook with studies, assets
+ * and contracts sheets or from one CSV file per sheet. The first row of each sheet names
+ * the columns with the fields of StudyImport, AssetImport and ContractImport. Asset and
+ * contract rows have a caseref column naming their study and lists are separated by
+ * semicolons. Every row is validated and either all rows are imported or none are
+ *
+ */
+export const postStudiesAdminImportBulk = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminImportBulkData, ThrowOnError>): RequestResult<PostStudiesAdminImportBulkResponses, PostStudiesAdminImportBulkErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminImportBulkResponses, PostStudiesAdminImportBulkErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/admin/import/bulk',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
 /**
  * Update study status to pending
  */
@@ -316,7 +436,19 @@ export const patchStudiesByStudyIdPending = <ThrowOnError extends boolean = fals
 /**
  * Record that the IAO has attested the study details are up to date, resetting the signoff timestamp
  */
-export const postStudiesByStudyIdSignoff = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdSignoffData, ThrowOnError>): RequestResult<PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdSignoffErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdSignoffErrors, ThrowOnError>({ url: '/studies/{studyId}/signoff', ...options });
+export const postStudiesByStudyIdSignoff = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdSignoffData, ThrowOnError>): RequestResult<PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdSignoffErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdSignoffResponses, PostStudiesByStudyIdSignoffErrors, ThrowOnError>({
+    url: '/studies/{studyId}/signoff',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the signoff history of a study, newest first
+ */
+export const getStudiesByStudyIdSignoffs = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdSignoffsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdSignoffsResponses, GetStudiesByStudyIdSignoffsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdSignoffsResponses, GetStudiesByStudyIdSignoffsErrors, ThrowOnError>({ url: '/studies/{studyId}/signoffs', ...options });
 
 /**
  * Request an owner change
@@ -330,6 +462,94 @@ export const postStudiesByStudyIdOwnerRequest = <ThrowOnError extends boolean =
     }
 });
 
+/**
+ * Cancel the pending owner change of a study
+ */
+export const postStudiesByStudyIdOwnerCancel = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdOwnerCancelData, ThrowOnError>): RequestResult<PostStudiesByStudyIdOwnerCancelResponses, PostStudiesByStudyIdOwnerCancelErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdOwnerCancelResponses, PostStudiesByStudyIdOwnerCancelErrors, ThrowOnError>({
+    url: '/studies/{studyId}/owner-cancel',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get all review comment threads on a study, including resolved threads
+ */
+export const getStudiesByStudyIdReviewThreads = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdReviewThreadsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdReviewThreadsResponses, GetStudiesByStudyIdReviewThreadsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdReviewThreadsResponses, GetStudiesByStudyIdReviewThreadsErrors, ThrowOnError>({ url: '/studies/{studyId}/review-threads', ...options });
+
+/**
+ * Reply to an unresolved review comment thread
+ */
+export const postStudiesByStudyIdReviewThreadsByReviewThreadIdComments = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, ThrowOnError>({
+    url: '/studies/{studyId}/review-threads/{reviewThreadId}/comments',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Start a review comment thread on a study field, or a field of one of its assets
+ */
+export const postStudiesAdminByStudyIdReviewThreads = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdReviewThreadsData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdReviewThreadsResponses, PostStudiesAdminByStudyIdReviewThreadsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdReviewThreadsResponses, PostStudiesAdminByStudyIdReviewThreadsErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/review-threads',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Reply to an unresolved review comment thread as a reviewer
+ */
+export const postStudiesAdminByStudyIdReviewThreadsByReviewThreadIdComments = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/review-threads/{reviewThreadId}/comments',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Resolve a review comment thread. Unresolved threads block resubmission of the study
+ */
+export const postStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolve = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveResponses, PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveErrors, ThrowOnError>({ url: '/studies/admin/{studyId}/review-threads/{reviewThreadId}/resolve', ...options });
+
+/**
+ * Get the field level revision history of a study, oldest first
+ */
+export const getStudiesByStudyIdRevisions = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdRevisionsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdRevisionsResponses, GetStudiesByStudyIdRevisionsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdRevisionsResponses, GetStudiesByStudyIdRevisionsErrors, ThrowOnError>({ url: '/studies/{studyId}/revisions', ...options });
+
+/**
+ * Get the fields changed between two revisions of a study
+ */
+export const getStudiesByStudyIdRevisionsDiff = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdRevisionsDiffData, ThrowOnError>): RequestResult<GetStudiesByStudyIdRevisionsDiffResponses, GetStudiesByStudyIdRevisionsDiffErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdRevisionsDiffResponses, GetStudiesByStudyIdRevisionsDiffErrors, ThrowOnError>({ url: '/studies/{studyId}/revisions/diff', ...options });
+
+/**
+ * Get the outstanding items which must be resolved before a study can be closed
+ */
+export const getStudiesByStudyIdClosure = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdClosureData, ThrowOnError>): RequestResult<GetStudiesByStudyIdClosureResponses, GetStudiesByStudyIdClosureErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdClosureResponses, GetStudiesByStudyIdClosureErrors, ThrowOnError>({ url: '/studies/{studyId}/closure', ...options });
+
+/**
+ * Start closing an approved study. Requests deletion of its TRE projects and retires its DSH shares
+ */
+export const postStudiesByStudyIdClosure = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdClosureData, ThrowOnError>): RequestResult<PostStudiesByStudyIdClosureResponses, PostStudiesByStudyIdClosureErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdClosureResponses, PostStudiesByStudyIdClosureErrors, ThrowOnError>({ url: '/studies/{studyId}/closure', ...options });
+
+/**
+ * Close a study once every asset has been destroyed or transferred and every contract closed
+ */
+export const postStudiesByStudyIdClosureComplete = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdClosureCompleteData, ThrowOnError>): RequestResult<PostStudiesByStudyIdClosureCompleteResponses, PostStudiesByStudyIdClosureCompleteErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdClosureCompleteResponses, PostStudiesByStudyIdClosureCompleteErrors, ThrowOnError>({ url: '/studies/{studyId}/closure/complete', ...options });
+
+/**
+ * Archive a closed study
+ */
+export const postStudiesAdminByStudyIdArchive = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdArchiveData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdArchiveResponses, PostStudiesAdminByStudyIdArchiveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdArchiveResponses, PostStudiesAdminByStudyIdArchiveErrors, ThrowOnError>({ url: '/studies/admin/{studyId}/archive', ...options });
+
 /**
  * Get all available environments with their tier mappings
  */
@@ -406,6 +626,40 @@ export const postProjectsTreAdminImport = <ThrowOnError extends boolean = false>
  */
 export const getProjectsDshByProjectId = <ThrowOnError extends boolean = false>(options: Options<GetProjectsDshByProjectIdData, ThrowOnError>): RequestResult<GetProjectsDshByProjectIdResponses, GetProjectsDshByProjectIdErrors, ThrowOnError> => (options.client ?? client).get<GetProjectsDshByProjectIdResponses, GetProjectsDshByProjectIdErrors, ThrowOnError>({ url: '/projects/dsh/{projectId}', ...options });
 
+/**
+ * Get the Data Protection Impact Assessment (DPIA) of a study
+ */
+export const getStudiesByStudyIdDpia = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdDpiaData, ThrowOnError>): RequestResult<GetStudiesByStudyIdDpiaResponses, GetStudiesByStudyIdDpiaErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdDpiaResponses, GetStudiesByStudyIdDpiaErrors, ThrowOnError>({ url: '/studies/{studyId}/dpia', ...options });
+
+/**
+ * Create or update the DPIA of a study. Updating a submitted or signed off DPIA returns it to draft
+ */
+export const putStudiesByStudyIdDpia = <ThrowOnError extends boolean = false>(options: Options<PutStudiesByStudyIdDpiaData, ThrowOnError>): RequestResult<PutStudiesByStudyIdDpiaResponses, PutStudiesByStudyIdDpiaErrors, ThrowOnError> => (options.client ?? client).put<PutStudiesByStudyIdDpiaResponses, PutStudiesByStudyIdDpiaErrors, ThrowOnError>({
+    url: '/studies/{studyId}/dpia',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Submit a completed DPIA for sign-off by the Data Protection Officer (DPO)
+ */
+export const postStudiesByStudyIdDpiaSubmit = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdDpiaSubmitData, ThrowOnError>): RequestResult<PostStudiesByStudyIdDpiaSubmitResponses, PostStudiesByStudyIdDpiaSubmitErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdDpiaSubmitResponses, PostStudiesByStudyIdDpiaSubmitErrors, ThrowOnError>({ url: '/studies/{studyId}/dpia/submit', ...options });
+
+/**
+ * Sign off a submitted DPIA as the Data Protection Officer (DPO) until a review date
+ */
+export const postStudiesAdminByStudyIdDpiaSignoff = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdDpiaSignoffData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdDpiaSignoffResponses, PostStudiesAdminByStudyIdDpiaSignoffErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdDpiaSignoffResponses, PostStudiesAdminByStudyIdDpiaSignoffErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/dpia/signoff',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Get all assets for a specific study
  */
@@ -450,6 +704,169 @@ export const putStudiesByStudyIdAssetsByAssetId = <ThrowOnError extends boolean
  */
 export const getStudiesByStudyIdAssetsByAssetIdContracts = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdAssetsByAssetIdContractsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdAssetsByAssetIdContractsResponses, GetStudiesByStudyIdAssetsByAssetIdContractsErrors, ThrowOnError>({ url: '/studies/{studyId}/assets/{assetId}/contracts', ...options });
 
+/**
+ * Link an asset to a parent asset it was derived from. The derived asset inherits the contracts of
+ * its parents, and its tier must be within that allowed by the derivation from the parent. Only linked
+ * assets may have a higher tier than their parent
+ *
+ */
+export const postStudiesByStudyIdAssetsByAssetIdParents = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdAssetsByAssetIdParentsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdAssetsByAssetIdParentsResponses, PostStudiesByStudyIdAssetsByAssetIdParentsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdAssetsByAssetIdParentsResponses, PostStudiesByStudyIdAssetsByAssetIdParentsErrors, ThrowOnError>({
+    url: '/studies/{studyId}/assets/{assetId}/parents',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Remove the link between an asset and one of its parent assets
+ */
+export const deleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetId = <ThrowOnError extends boolean = false>(options: Options<DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdData, ThrowOnError>): RequestResult<DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdErrors, ThrowOnError> => (options.client ?? client).delete<DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses, DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdErrors, ThrowOnError>({ url: '/studies/{studyId}/assets/{assetId}/parents/{parentAssetId}', ...options });
+
+/**
+ * Get the destruction requests of an asset, most recent first
+ */
+export const getStudiesByStudyIdAssetsByAssetIdDestructions = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdAssetsByAssetIdDestructionsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsErrors, ThrowOnError>({ url: '/studies/{studyId}/assets/{assetId}/destructions', ...options });
+
+/**
+ * Request that an asset is marked as destroyed, with a certificate of destruction as evidence.
+ * The asset is destroyed once the request is approved by IG operations staff
+ *
+ */
+export const postStudiesByStudyIdAssetsByAssetIdDestructions = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdAssetsByAssetIdDestructionsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses, PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses, PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/{studyId}/assets/{assetId}/destructions',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
+/**
+ * Download the certificate of destruction of a destruction request
+ */
+export const getStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificate = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateData, ThrowOnError>): RequestResult<GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses, GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateErrors, ThrowOnError>({ url: '/studies/{studyId}/assets/{assetId}/destructions/{destructionId}/certificate', ...options });
+
+/**
+ * Approve a pending destruction request. The asset is marked as destroyed, removed from all
+ * projects and can no longer be modified
+ *
+ */
+export const postStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApprove = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/approve',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Reject a pending destruction request. The asset is unchanged
+ */
+export const postStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdReject = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses, PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/reject',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the downloads of the objects of a contract by any user, most recent first
+ */
+export const getStudiesAdminByStudyIdContractsByContractIdDownloads = <ThrowOnError extends boolean = false>(options: Options<GetStudiesAdminByStudyIdContractsByContractIdDownloadsData, ThrowOnError>): RequestResult<GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses, GetStudiesAdminByStudyIdContractsByContractIdDownloadsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses, GetStudiesAdminByStudyIdContractsByContractIdDownloadsErrors, ThrowOnError>({ url: '/studies/admin/{studyId}/contracts/{contractId}/downloads', ...options });
+
+/**
+ * Approve a pending contract amendment. Its terms become those of the contract on its effective
+ * date, immediately if that date has passed
+ *
+ */
+export const postStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApprove = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/approve',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Reject a pending contract amendment. The contract is unchanged
+ */
+export const postStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdReject = <ThrowOnError extends boolean = false>(options: Options<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectData, ThrowOnError>): RequestResult<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses, PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors, ThrowOnError>({
+    url: '/studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/reject',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the transfer history of an asset, most recent first
+ */
+export const getStudiesByStudyIdAssetsByAssetIdTransfers = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdAssetsByAssetIdTransfersData, ThrowOnError>): RequestResult<GetStudiesByStudyIdAssetsByAssetIdTransfersResponses, GetStudiesByStudyIdAssetsByAssetIdTransfersErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdAssetsByAssetIdTransfersResponses, GetStudiesByStudyIdAssetsByAssetIdTransfersErrors, ThrowOnError>({ url: '/studies/{studyId}/assets/{assetId}/transfers', ...options });
+
+/**
+ * Request the transfer of an asset to another study. The transfer must be approved by the owners of
+ * both studies and then by IG operations staff
+ *
+ */
+export const postStudiesByStudyIdAssetsByAssetIdTransfers = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdAssetsByAssetIdTransfersData, ThrowOnError>): RequestResult<PostStudiesByStudyIdAssetsByAssetIdTransfersResponses, PostStudiesByStudyIdAssetsByAssetIdTransfersErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdAssetsByAssetIdTransfersResponses, PostStudiesByStudyIdAssetsByAssetIdTransfersErrors, ThrowOnError>({
+    url: '/studies/{studyId}/assets/{assetId}/transfers',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the asset transfers into or out of a study, most recent first
+ */
+export const getStudiesByStudyIdTransfers = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdTransfersData, ThrowOnError>): RequestResult<GetStudiesByStudyIdTransfersResponses, GetStudiesByStudyIdTransfersErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdTransfersResponses, GetStudiesByStudyIdTransfersErrors, ThrowOnError>({ url: '/studies/{studyId}/transfers', ...options });
+
+/**
+ * Approve a pending asset transfer into or out of a study as the owner of the study
+ */
+export const postStudiesByStudyIdTransfersByTransferIdApprove = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdTransfersByTransferIdApproveData, ThrowOnError>): RequestResult<PostStudiesByStudyIdTransfersByTransferIdApproveResponses, PostStudiesByStudyIdTransfersByTransferIdApproveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdTransfersByTransferIdApproveResponses, PostStudiesByStudyIdTransfersByTransferIdApproveErrors, ThrowOnError>({ url: '/studies/{studyId}/transfers/{transferId}/approve', ...options });
+
+/**
+ * Reject a pending asset transfer into or out of a study as the owner of the study
+ */
+export const postStudiesByStudyIdTransfersByTransferIdReject = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdTransfersByTransferIdRejectData, ThrowOnError>): RequestResult<PostStudiesByStudyIdTransfersByTransferIdRejectResponses, PostStudiesByStudyIdTransfersByTransferIdRejectErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdTransfersByTransferIdRejectResponses, PostStudiesByStudyIdTransfersByTransferIdRejectErrors, ThrowOnError>({ url: '/studies/{studyId}/transfers/{transferId}/reject', ...options });
+
+/**
+ * Get the expiry reviews raised for the assets of a study when their expiry was enforced,
+ * pending reviews first then most recent first
+ *
+ */
+export const getStudiesByStudyIdAssetExpiryReviews = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdAssetExpiryReviewsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdAssetExpiryReviewsResponses, GetStudiesByStudyIdAssetExpiryReviewsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdAssetExpiryReviewsResponses, GetStudiesByStudyIdAssetExpiryReviewsErrors, ThrowOnError>({ url: '/studies/{studyId}/asset-expiry-reviews', ...options });
+
+/**
+ * Resolve a pending expiry review by confirming the asset will be destroyed, or by extending
+ * its expiry with a justification. Extending clears the expired flag of the asset but does
+ * not reattach it to any TRE projects it was removed from
+ *
+ */
+export const postStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolve = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveData, ThrowOnError>): RequestResult<PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses, PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors, ThrowOnError>({
+    url: '/studies/{studyId}/asset-expiry-reviews/{expiryReviewId}/resolve',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the lineage graph of the assets of a study
+ */
+export const getStudiesByStudyIdLineage = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdLineageData, ThrowOnError>): RequestResult<GetStudiesByStudyIdLineageResponses, GetStudiesByStudyIdLineageErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdLineageResponses, GetStudiesByStudyIdLineageErrors, ThrowOnError>({ url: '/studies/{studyId}/lineage', ...options });
+
 /**
  * Log the user out
  */
@@ -500,7 +917,9 @@ export const deleteStudiesByStudyIdContractsByContractId = <ThrowOnError extends
 export const getStudiesByStudyIdContractsByContractId = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdContractsByContractIdData, ThrowOnError>): RequestResult<GetStudiesByStudyIdContractsByContractIdResponses, unknown, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdContractsByContractIdResponses, unknown, ThrowOnError>({ url: '/studies/{studyId}/contracts/{contractId}', ...options });
 
 /**
- * Update contract metadata
+ * Update contract metadata. The terms of a contract in force, i.e. other than its retention end date,
+ * can only be changed by requesting an amendment. A pending contract e.g. a renewal may be corrected
+ *
  */
 export const putStudiesByStudyIdContractsByContractId = <ThrowOnError extends boolean = false>(options: Options<PutStudiesByStudyIdContractsByContractIdData, ThrowOnError>): RequestResult<PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdContractsByContractIdErrors, ThrowOnError> => (options.client ?? client).put<PutStudiesByStudyIdContractsByContractIdResponses, PutStudiesByStudyIdContractsByContractIdErrors, ThrowOnError>({
     url: '/studies/{studyId}/contracts/{contractId}',
@@ -524,12 +943,180 @@ export const postStudiesByStudyIdContractsByContractIdObjects = <ThrowOnError ex
     }
 });
 
+/**
+ * Get the version history of a contract, from the original terms through each amendment, in
+ * version order
+ *
+ */
+export const getStudiesByStudyIdContractsByContractIdVersions = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdContractsByContractIdVersionsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdContractsByContractIdVersionsResponses, GetStudiesByStudyIdContractsByContractIdVersionsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdContractsByContractIdVersionsResponses, GetStudiesByStudyIdContractsByContractIdVersionsErrors, ThrowOnError>({ url: '/studies/{studyId}/contracts/{contractId}/versions', ...options });
+
+/**
+ * Request an amendment of a contract as a new version with the complete amended terms. The
+ * contract is unchanged until the amendment is approved and its effective date has passed
+ *
+ */
+export const postStudiesByStudyIdContractsByContractIdVersions = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdContractsByContractIdVersionsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdContractsByContractIdVersionsResponses, PostStudiesByStudyIdContractsByContractIdVersionsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdContractsByContractIdVersionsResponses, PostStudiesByStudyIdContractsByContractIdVersionsErrors, ThrowOnError>({
+    url: '/studies/{studyId}/contracts/{contractId}/versions',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Upload a contract object e.g. PDF of a pending amendment
+ */
+export const postStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjects = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses, PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/{studyId}/contracts/{contractId}/versions/{contractVersionId}/objects',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
 /**
  * Delete a contract object e.g. PDF
  */
 export const deleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectId = <ThrowOnError extends boolean = false>(options: Options<DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, ThrowOnError>): RequestResult<DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, ThrowOnError> => (options.client ?? client).delete<DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, ThrowOnError>({ url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}', ...options });
 
 /**
- * Get a contract object e.g. PDF
+ * Get a contract object e.g. PDF. Objects which are not scanned clean are refused. Each page of a PDF
+ * is watermarked with the downloading user, the time and the study caseref. Every download is recorded
+ *
  */
 export const getStudiesByStudyIdContractsByContractIdObjectsByContractObjectId = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData, ThrowOnError>): RequestResult<GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses, GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors, ThrowOnError>({ url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}', ...options });
+
+/**
+ * Renew an active contract by creating a pending successor pre-filled with its terms and assets. The
+ * renewed contract is closed when the successor is made active and no longer raises expiry reminders
+ *
+ */
+export const postStudiesByStudyIdContractsByContractIdRenew = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdContractsByContractIdRenewData, ThrowOnError>): RequestResult<PostStudiesByStudyIdContractsByContractIdRenewResponses, PostStudiesByStudyIdContractsByContractIdRenewErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdContractsByContractIdRenewResponses, PostStudiesByStudyIdContractsByContractIdRenewErrors, ThrowOnError>({ url: '/studies/{studyId}/contracts/{contractId}/renew', ...options });
+
+/**
+ * Get the ethics and regulatory approvals of a study
+ */
+export const getStudiesByStudyIdApprovals = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdApprovalsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdApprovalsResponses, GetStudiesByStudyIdApprovalsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdApprovalsResponses, GetStudiesByStudyIdApprovalsErrors, ThrowOnError>({ url: '/studies/{studyId}/approvals', ...options });
+
+/**
+ * Record an ethics or regulatory approval of a study
+ */
+export const postStudiesByStudyIdApprovals = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdApprovalsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdApprovalsResponses, PostStudiesByStudyIdApprovalsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdApprovalsResponses, PostStudiesByStudyIdApprovalsErrors, ThrowOnError>({
+    url: '/studies/{studyId}/approvals',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete an ethics or regulatory approval, including its letter
+ */
+export const deleteStudiesByStudyIdApprovalsByApprovalId = <ThrowOnError extends boolean = false>(options: Options<DeleteStudiesByStudyIdApprovalsByApprovalIdData, ThrowOnError>): RequestResult<DeleteStudiesByStudyIdApprovalsByApprovalIdResponses, DeleteStudiesByStudyIdApprovalsByApprovalIdErrors, ThrowOnError> => (options.client ?? client).delete<DeleteStudiesByStudyIdApprovalsByApprovalIdResponses, DeleteStudiesByStudyIdApprovalsByApprovalIdErrors, ThrowOnError>({ url: '/studies/{studyId}/approvals/{approvalId}', ...options });
+
+/**
+ * Update an ethics or regulatory approval
+ */
+export const putStudiesByStudyIdApprovalsByApprovalId = <ThrowOnError extends boolean = false>(options: Options<PutStudiesByStudyIdApprovalsByApprovalIdData, ThrowOnError>): RequestResult<PutStudiesByStudyIdApprovalsByApprovalIdResponses, PutStudiesByStudyIdApprovalsByApprovalIdErrors, ThrowOnError> => (options.client ?? client).put<PutStudiesByStudyIdApprovalsByApprovalIdResponses, PutStudiesByStudyIdApprovalsByApprovalIdErrors, ThrowOnError>({
+    url: '/studies/{studyId}/approvals/{approvalId}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Download the letter of an approval
+ */
+export const getStudiesByStudyIdApprovalsByApprovalIdLetter = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdApprovalsByApprovalIdLetterData, ThrowOnError>): RequestResult<GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses, GetStudiesByStudyIdApprovalsByApprovalIdLetterErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses, GetStudiesByStudyIdApprovalsByApprovalIdLetterErrors, ThrowOnError>({ url: '/studies/{studyId}/approvals/{approvalId}/letter', ...options });
+
+/**
+ * Upload the letter of an approval e.g. PDF, replacing any existing letter
+ */
+export const postStudiesByStudyIdApprovalsByApprovalIdLetter = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdApprovalsByApprovalIdLetterData, ThrowOnError>): RequestResult<PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses, PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses, PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/{studyId}/approvals/{approvalId}/letter',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
+/**
+ * Get the evidence documents of a study, with their versions newest first
+ */
+export const getStudiesByStudyIdDocuments = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdDocumentsData, ThrowOnError>): RequestResult<GetStudiesByStudyIdDocumentsResponses, GetStudiesByStudyIdDocumentsErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdDocumentsResponses, GetStudiesByStudyIdDocumentsErrors, ThrowOnError>({ url: '/studies/{studyId}/documents', ...options });
+
+/**
+ * Upload a new evidence document to a study e.g. a data management plan
+ */
+export const postStudiesByStudyIdDocuments = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdDocumentsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdDocumentsResponses, PostStudiesByStudyIdDocumentsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdDocumentsResponses, PostStudiesByStudyIdDocumentsErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/{studyId}/documents',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
+/**
+ * Delete an evidence document, including all of its versions
+ */
+export const deleteStudiesByStudyIdDocumentsByDocumentId = <ThrowOnError extends boolean = false>(options: Options<DeleteStudiesByStudyIdDocumentsByDocumentIdData, ThrowOnError>): RequestResult<DeleteStudiesByStudyIdDocumentsByDocumentIdResponses, DeleteStudiesByStudyIdDocumentsByDocumentIdErrors, ThrowOnError> => (options.client ?? client).delete<DeleteStudiesByStudyIdDocumentsByDocumentIdResponses, DeleteStudiesByStudyIdDocumentsByDocumentIdErrors, ThrowOnError>({ url: '/studies/{studyId}/documents/{documentId}', ...options });
+
+/**
+ * Upload a new version of an evidence document
+ */
+export const postStudiesByStudyIdDocumentsByDocumentIdVersions = <ThrowOnError extends boolean = false>(options: Options<PostStudiesByStudyIdDocumentsByDocumentIdVersionsData, ThrowOnError>): RequestResult<PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses, PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors, ThrowOnError> => (options.client ?? client).post<PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses, PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/studies/{studyId}/documents/{documentId}/versions',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
+/**
+ * Download a version of an evidence document
+ */
+export const getStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionId = <ThrowOnError extends boolean = false>(options: Options<GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdData, ThrowOnError>): RequestResult<GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdErrors, ThrowOnError> => (options.client ?? client).get<GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses, GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdErrors, ThrowOnError>({ url: '/studies/{studyId}/documents/{documentId}/versions/{documentVersionId}', ...options });
+
+/**
+ * Get all erasure requests, most recent first
+ */
+export const getErasures = <ThrowOnError extends boolean = false>(options?: Options<GetErasuresData, ThrowOnError>): RequestResult<GetErasuresResponses, GetErasuresErrors, ThrowOnError> => (options?.client ?? client).get<GetErasuresResponses, GetErasuresErrors, ThrowOnError>({ url: '/erasures', ...options });
+
+/**
+ * Request the permanent erasure of a study or the personal data of a user. Must be approved by a second administrator
+ */
+export const postErasures = <ThrowOnError extends boolean = false>(options: Options<PostErasuresData, ThrowOnError>): RequestResult<PostErasuresResponses, PostErasuresErrors, ThrowOnError> => (options.client ?? client).post<PostErasuresResponses, PostErasuresErrors, ThrowOnError>({
+    url: '/erasures',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get an erasure request including the manifest of what will be, or was, removed
+ */
+export const getErasuresByErasureId = <ThrowOnError extends boolean = false>(options: Options<GetErasuresByErasureIdData, ThrowOnError>): RequestResult<GetErasuresByErasureIdResponses, GetErasuresByErasureIdErrors, ThrowOnError> => (options.client ?? client).get<GetErasuresByErasureIdResponses, GetErasuresByErasureIdErrors, ThrowOnError>({ url: '/erasures/{erasureId}', ...options });
+
+/**
+ * Approve a pending erasure, permanently removing its subject. Must be a different administrator to the requester
+ */
+export const postErasuresByErasureIdApprove = <ThrowOnError extends boolean = false>(options: Options<PostErasuresByErasureIdApproveData, ThrowOnError>): RequestResult<PostErasuresByErasureIdApproveResponses, PostErasuresByErasureIdApproveErrors, ThrowOnError> => (options.client ?? client).post<PostErasuresByErasureIdApproveResponses, PostErasuresByErasureIdApproveErrors, ThrowOnError>({ url: '/erasures/{erasureId}/approve', ...options });
+
+/**
+ * Reject a pending erasure
+ */
+export const postErasuresByErasureIdReject = <ThrowOnError extends boolean = false>(options: Options<PostErasuresByErasureIdRejectData, ThrowOnError>): RequestResult<PostErasuresByErasureIdRejectResponses, PostErasuresByErasureIdRejectErrors, ThrowOnError> => (options.client ?? client).post<PostErasuresByErasureIdRejectResponses, PostErasuresByErasureIdRejectErrors, ThrowOnError>({ url: '/erasures/{erasureId}/reject', ...options });
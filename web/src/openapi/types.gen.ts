@@ -12,7 +12,7 @@ export type Auth = {
      * - Additional dynamic resource-specific roles: Runtime roles like project_{id}_owner, study_{id}_admin
      *
      */
-    roles: Array<'admin' | 'base' | 'staff' | 'approved-researcher' | 'approved-staff-researcher' | 'information-asset-owner' | 'information-asset-administrator' | 'tre-ops-staff' | 'ig-ops-staff' | 'ig-admin' | 'dsh-ops-staff'>;
+    roles: Array<'admin' | 'base' | 'staff' | 'approved-researcher' | 'approved-staff-researcher' | 'information-asset-owner' | 'information-asset-administrator' | 'tre-ops-staff' | 'ig-ops-staff' | 'ig-admin' | 'dsh-ops-staff' | 'dpo'>;
 };
 
 export type Notification = {
@@ -33,7 +33,7 @@ export type NotificationsReadAll = {
     kind?: NotificationKind;
 };
 
-export type NotificationKind = 'complete-profile' | 'asset-expiry' | 'contract-expiry' | 'training-expiry' | 'iaa-assignment' | 'study-affirmation' | 'study-review' | 'study-owner-change' | 'user-name-change' | 'project-deployed';
+export type NotificationKind = 'complete-profile' | 'asset-expiry' | 'contract-expiry' | 'training-expiry' | 'iaa-assignment' | 'study-affirmation' | 'study-review' | 'study-owner-change' | 'user-name-change' | 'project-deployed' | 'dpia-review' | 'approval-expiry' | 'project-suspended' | 'asset-expiry-review' | 'malware-detected' | 'object-integrity';
 
 export type Profile = {
     username: string;
@@ -72,7 +72,7 @@ export type ConfirmedAgreement = {
     agreement_type: AgreementType;
 };
 
-export type AgreementType = 'approved-researcher' | 'study-owner' | 'study-administrator';
+export type AgreementType = 'approved-researcher' | 'study-owner' | 'study-administrator' | 'study-signoff';
 
 export type UserAgreements = {
     confirmed_agreements: Array<ConfirmedAgreement>;
@@ -196,6 +196,13 @@ export type AssetBase = {
      * Storage locations and touchpoints for the asset
      */
     locations: Array<string>;
+    /**
+     * Typed locations of the asset in addition to the free text `locations`. When set, replaces the existing
+     * environment, project and external system locations of the asset. Project locations are added when the
+     * asset is attached to a TRE project
+     *
+     */
+    location_references?: Array<AssetLocationReference>;
     /**
      * Types of data that are present in the asset
      */
@@ -235,7 +242,7 @@ export type AssetBase = {
     /**
      * Status of the asset
      */
-    status: 'active' | 'destroyed';
+    status: 'active' | 'destroyed' | 'transferred';
     /**
      * Whether disclosure of this asset result in major disruption to UCL
      */
@@ -286,6 +293,333 @@ export type Asset = AssetBase & {
      * List of contract IDs associated with the asset (empty array if none)
      */
     contract_ids: Array<string>;
+    /**
+     * Minimum tier derived from the answers about the asset
+     */
+    minimum_tier: number;
+    /**
+     * Risk score derived from the impact and likelihood of a leak of the asset
+     */
+    risk_score: number;
+    /**
+     * IDs of the contracts of the assets this asset was derived from, which also apply to it
+     */
+    inherited_contract_ids: Array<string>;
+    /**
+     * Time in RFC3339 format when the expiry of the asset was enforced. Unset once extended
+     */
+    expired_at?: string;
+};
+
+/**
+ * How a derived asset was created from its parent
+ */
+export type AssetDerivationType = 'subset' | 'pseudonymised' | 'aggregated' | 'linked';
+
+export type AssetLinkRequest = {
+    /**
+     * Asset the asset was derived from
+     */
+    parent_asset_id: string;
+    derivation_type: AssetDerivationType;
+};
+
+/**
+ * A link from an asset to a parent asset it was derived from
+ */
+export type AssetLink = {
+    /**
+     * Asset the child was derived from
+     */
+    parent_asset_id: string;
+    /**
+     * Derived asset
+     */
+    child_asset_id: string;
+    derivation_type: AssetDerivationType;
+    /**
+     * Time in RFC3339 format when the link was created
+     */
+    created_at: string;
+};
+
+/**
+ * How an asset was destroyed
+ */
+export type AssetDestructionMethod = 'secure_deletion' | 'cryptographic_erasure' | 'physical_destruction' | 'other';
+
+export type AssetDestructionStatus = 'pending' | 'approved' | 'rejected';
+
+export type AssetDestructionRequest = {
+    /**
+     * Certificate of destruction (e.g., PDF)
+     */
+    file: Blob | File;
+    method: AssetDestructionMethod;
+    /**
+     * Date the asset was destroyed in YYYY-MM-DD format
+     */
+    destroyed_at: string;
+};
+
+export type AssetDestructionReview = {
+    /**
+     * Comments of the reviewer on the request
+     */
+    comments?: string;
+};
+
+/**
+ * A request to mark an asset as destroyed
+ */
+export type AssetDestruction = {
+    id: string;
+    asset_id: string;
+    study_id: string;
+    method: AssetDestructionMethod;
+    /**
+     * Date the asset was destroyed in YYYY-MM-DD format
+     */
+    destroyed_at: string;
+    certificate_filename: string;
+    status: AssetDestructionStatus;
+    requester_username: string;
+    reviewer_username?: string;
+    reviewer_comments?: string;
+    /**
+     * Time in RFC3339 format when the request was approved or rejected
+     */
+    reviewed_at?: string;
+    /**
+     * Time in RFC3339 format when the request was created
+     */
+    created_at: string;
+};
+
+export type AssetTransferStatus = 'pending' | 'completed' | 'rejected';
+
+export type AssetTransferRequest = {
+    /**
+     * Study to transfer the asset to
+     */
+    target_study_id: string;
+    /**
+     * Why the asset is being transferred
+     */
+    reason: string;
+};
+
+/**
+ * A transfer of an asset from one study to another
+ */
+export type AssetTransfer = {
+    id: string;
+    asset_id: string;
+    asset_title: string;
+    /**
+     * Absent once the source study has been erased
+     */
+    source_study_id?: string;
+    /**
+     * Absent once the source study has been erased
+     */
+    source_study_title?: string;
+    /**
+     * Absent once the target study has been erased
+     */
+    target_study_id?: string;
+    /**
+     * Absent once the target study has been erased
+     */
+    target_study_title?: string;
+    reason: string;
+    status: AssetTransferStatus;
+    requester_username: string;
+    /**
+     * Time in RFC3339 format when the owner of the source study approved. Absent if not yet approved
+     */
+    source_owner_approved_at?: string;
+    /**
+     * Time in RFC3339 format when the owner of the target study approved. Absent if not yet approved
+     */
+    target_owner_approved_at?: string;
+    /**
+     * User who completed or rejected the transfer
+     */
+    reviewer_username?: string;
+    /**
+     * Time in RFC3339 format when the transfer was completed or rejected
+     */
+    reviewed_at?: string;
+    /**
+     * Contracts moved with the asset. Empty until the transfer is completed
+     */
+    contract_ids: Array<string>;
+    /**
+     * Time in RFC3339 format when the transfer was requested
+     */
+    created_at: string;
+};
+
+/**
+ * Kind of place an asset is stored in
+ */
+export type AssetLocationKind = 'environment' | 'project' | 'external_system' | 'other';
+
+/**
+ * A location of an asset
+ */
+export type AssetLocationReference = {
+    kind: AssetLocationKind;
+    /**
+     * Portal environment storing the asset. Required for environment locations
+     */
+    environment_id?: string;
+    /**
+     * Portal project of the study the asset is attached to. Required for project locations
+     */
+    project_id?: string;
+    /**
+     * Name of the external system or free text location. Required for external system and other locations and
+     * set by the portal for environments and projects
+     *
+     */
+    name?: string;
+};
+
+export type AssetLocationReportAsset = {
+    id: string;
+    title: string;
+    tier: number;
+    status: string;
+    study_id: string;
+    study_title: string;
+};
+
+/**
+ * The active assets stored in a location
+ */
+export type AssetLocationReport = {
+    location: AssetLocationReference;
+    assets: Array<AssetLocationReportAsset>;
+};
+
+export type AssetRegisterFormat = 'csv' | 'xlsx' | 'json';
+
+/**
+ * An asset in the information asset register
+ */
+export type AssetRegisterEntry = {
+    /**
+     * Case reference of the study
+     */
+    caseref: number;
+    study_id: string;
+    study_title: string;
+    /**
+     * Username of the study owner
+     */
+    owner_username: string;
+    asset_id: string;
+    asset_title: string;
+    tier: number;
+    /**
+     * Status of the asset
+     */
+    status: string;
+    classification_impact: string;
+    /**
+     * Legal basis for holding the asset
+     */
+    legal_basis?: string;
+    /**
+     * Additional condition for special category data
+     */
+    legal_basis_special?: string;
+    /**
+     * Names of the places the asset is stored
+     */
+    locations: Array<string>;
+    data_types: Array<string>;
+    /**
+     * Titles of the contracts covering the asset
+     */
+    contracts: Array<string>;
+    /**
+     * Retention expiry date of the asset
+     */
+    expires_at?: string;
+};
+
+/**
+ * An export of the asset register stored by the scheduled job
+ */
+export type AssetRegisterExport = {
+    id: string;
+    format: AssetRegisterFormat;
+    filename: string;
+    num_assets: number;
+    created_at: string;
+};
+
+export type AssetExpiryReviewStatus = 'pending' | 'destruction_confirmed' | 'extended';
+
+export type AssetExpiryOutcome = 'destroy' | 'extend';
+
+export type AssetExpiryResolution = {
+    outcome: AssetExpiryOutcome;
+    /**
+     * Why the asset is being kept or how it will be destroyed. Required to extend
+     */
+    justification: string;
+    /**
+     * New retention expiry date of the asset in YYYY-MM-DD format. Required to extend
+     */
+    expires_at?: string;
+};
+
+/**
+ * Review raised for the study owner when the expiry of an asset was enforced
+ */
+export type AssetExpiryReview = {
+    id: string;
+    asset_id: string;
+    asset_title: string;
+    /**
+     * Time in RFC3339 format of the expiry which was enforced
+     */
+    expires_at: string;
+    /**
+     * Projects the asset was removed from when its expiry was enforced
+     */
+    detached_project_ids: Array<string>;
+    status: AssetExpiryReviewStatus;
+    resolver_username?: string;
+    justification?: string;
+    /**
+     * Time in RFC3339 format of the new expiry if it was extended
+     */
+    extended_expires_at?: string;
+    resolved_at?: string;
+    created_at: string;
+};
+
+export type AssetLineageNode = {
+    id: string;
+    title: string;
+    tier: number;
+    /**
+     * Status of the asset
+     */
+    status: string;
+};
+
+/**
+ * Graph of the assets of a study and the links between them
+ */
+export type AssetLineage = {
+    assets: Array<AssetLineageNode>;
+    links: Array<AssetLink>;
 };
 
 /**
@@ -416,6 +750,9 @@ export type Study = StudyBase & {
      */
     updated_at: string;
     approval_status: StudyApprovalStatus;
+    /**
+     * Latest reviewer feedback. Deprecated in favour of review threads
+     */
     feedback?: string;
     /**
      * Time in RFC3339 format representing when the IAO last confirmed Study details and project access up to date
@@ -429,8 +766,31 @@ export type Study = StudyBase & {
      * Username of the owner who has been requested to be assigned for this study, which is pending approval
      */
     pending_new_owner_username?: string;
+    /**
+     * Username of the IG ops staff member reviewing the study. Absent if unassigned
+     */
+    reviewer_username?: string;
+    /**
+     * Risk score of the highest risk asset. Absent if the study has no assets
+     */
+    risk_score?: number;
+    risk_rating?: StudyRiskRating;
+    dpia_status?: DpiaStatus;
+    /**
+     * Number of days a signoff is valid for, set by the highest tier of the active study assets
+     */
+    signoff_validity_days: number;
+    /**
+     * Time in RFC3339 format when the latest signoff expires. Absent if never signed off
+     */
+    signoff_expires_at?: string;
 };
 
+/**
+ * Rating derived from the study risk score
+ */
+export type StudyRiskRating = 'manageable' | 'uncomfortable' | 'vulnerable' | 'critical';
+
 export type StudyOwnerUpdate = {
     /**
      * Username to change the owner of the study to. Must exist
@@ -438,57 +798,391 @@ export type StudyOwnerUpdate = {
     username: string;
 };
 
-export type StudyImport = {
-    title: string;
-    description?: string;
-    additional_study_admin_username?: string;
-    data_controller_organisation: string;
-    involves_ucl_sponsorship?: boolean;
-    involves_cag?: boolean;
-    cag_reference?: string;
-    involves_ethics_approval?: boolean;
-    involves_hra_approval?: boolean;
-    iras_id?: string;
-    is_nhs_associated?: boolean;
-    involves_nhs_england?: boolean;
-    nhs_england_reference?: string;
-    involves_mnca?: boolean;
-    requires_dspt?: boolean;
-    requires_dbs?: boolean;
-    is_data_protection_office_registered?: boolean;
-    data_protection_number?: string;
-    involves_third_party?: boolean;
-    involves_external_users?: boolean;
-    involves_participant_consent?: boolean;
-    involves_indirect_data_collection?: boolean;
-    involves_data_processing_outside_eea?: boolean;
-    owner_username: string;
+export type StudySignoffRequest = {
     /**
-     * Time in RFC3339 format when the study was created
+     * ID of the study-signoff agreement version shown to the IAO. Defaults to the latest
+     */
+    agreement_id?: string;
+    /**
+     * Optional comments from the IAO
+     */
+    comments?: string;
+};
+
+/**
+ * An immutable record of an attestation that the study details are up to date
+ */
+export type StudySignoff = {
+    id: string;
+    /**
+     * Time in RFC3339 format when the study was signed off
      */
     created_at: string;
     /**
-     * Time in RFC3339 format when the study was last updated
+     * Username of the user who signed off the study
      */
-    updated_at: string;
-    approval_status: string;
-    feedback?: string;
-    last_signoff?: string;
-    caseref: number;
+    username: string;
     /**
-     * Time in RFC3339 format when the owner agreed to the IAO agreement
+     * ID of the attestation agreement version shown
      */
-    owner_agreed_at?: string;
+    agreement_id: string;
+    comments?: string;
+};
+
+export type StudyOwnerChangeDecision = {
     /**
-     * Time in RFC3339 format when the owner agreed to the IAA agreement
+     * Why the owner change was rejected or cancelled
      */
-    admin_agreed_at?: string;
+    reason: string;
 };
 
-export type AssetImport = {
-    title: string;
-    description: string;
-    tier: number;
+/**
+ * An owner change request awaiting a decision
+ */
+export type StudyOwnerChange = {
+    /**
+     * Study UUID
+     */
+    study_id: string;
+    study_title: string;
+    /**
+     * Username of the current owner
+     */
+    from_username: string;
+    /**
+     * Username of the proposed owner
+     */
+    to_username: string;
+    /**
+     * Username of the user who requested the change
+     */
+    requested_by_username?: string;
+    /**
+     * Time in RFC3339 format when the change was requested
+     */
+    requested_at: string;
+    /**
+     * Time in RFC3339 format after which the request expires if not approved
+     */
+    expires_at: string;
+};
+
+export type StudyFieldChange = {
+    /**
+     * Name of the changed study field e.g. title
+     */
+    field: string;
+    /**
+     * Value before the change. Absent if the field was unset
+     */
+    old_value?: string;
+    /**
+     * Value after the change. Absent if the field was unset
+     */
+    new_value?: string;
+};
+
+export type StudyReviewThreadRequest = {
+    /**
+     * JSON name of the field the thread is about e.g. title, or "general" for the study as a whole
+     */
+    field: string;
+    /**
+     * UUID of the asset if the field is an asset field
+     */
+    asset_id?: string;
+    /**
+     * Initial comment of the thread
+     */
+    comment: string;
+};
+
+export type StudyReviewCommentRequest = {
+    comment: string;
+};
+
+export type StudyReviewComment = {
+    id: string;
+    /**
+     * Time in RFC3339 format when the comment was made
+     */
+    created_at: string;
+    /**
+     * Username of the comment author
+     */
+    username: string;
+    comment: string;
+};
+
+/**
+ * A reviewer comment thread, oldest comment first
+ */
+export type StudyReviewThread = {
+    id: string;
+    /**
+     * Time in RFC3339 format when the thread was started
+     */
+    created_at: string;
+    /**
+     * Username of the reviewer who started the thread
+     */
+    creator_username: string;
+    /**
+     * JSON name of the field the thread is about e.g. title, or "general" for the study as a whole
+     */
+    field: string;
+    /**
+     * UUID of the asset if the field is an asset field
+     */
+    asset_id?: string;
+    /**
+     * Time in RFC3339 format when the thread was resolved. Absent if unresolved
+     */
+    resolved_at?: string;
+    /**
+     * Username of the reviewer who resolved the thread
+     */
+    resolved_by_username?: string;
+    comments: Array<StudyReviewComment>;
+};
+
+/**
+ * An immutable record of the fields changed by a single save of a study
+ */
+export type StudyRevision = {
+    /**
+     * Unique identifier for the revision
+     */
+    id: string;
+    /**
+     * Time in RFC3339 format when the study was saved
+     */
+    created_at: string;
+    /**
+     * Username of the user who saved the study
+     */
+    username: string;
+    changes: Array<StudyFieldChange>;
+};
+
+export type StudyRevisionDiff = {
+    from_revision_id: string;
+    to_revision_id: string;
+    changes: Array<StudyFieldChange>;
+};
+
+export type StudyClosureItem = {
+    id: string;
+    title: string;
+};
+
+export type StudyClosure = {
+    /**
+     * Whether the study is closing and has no outstanding assets or contracts
+     */
+    can_complete: boolean;
+    /**
+     * Assets not yet destroyed or transferred
+     */
+    outstanding_assets: Array<StudyClosureItem>;
+    /**
+     * Contracts not yet closed
+     */
+    outstanding_contracts: Array<StudyClosureItem>;
+    /**
+     * TRE projects not yet deleted. Deletion is requested when closure starts and does not block closure
+     */
+    outstanding_projects: Array<StudyClosureItem>;
+};
+
+export type SearchEntityType = 'study' | 'asset' | 'contract' | 'project';
+
+export type SearchResult = {
+    entity_type: SearchEntityType;
+    id: string;
+    /**
+     * Title of the item. The name of a project
+     */
+    title: string;
+    /**
+     * Status of the item. Absent if the item has no status
+     */
+    status?: string;
+    /**
+     * Tier of an asset
+     */
+    tier?: number;
+    /**
+     * Study the item belongs to. The item itself for a study
+     */
+    study_id: string;
+    study_title: string;
+    study_caseref: number;
+};
+
+export type SearchFacetCount = {
+    value: string;
+    count: number;
+};
+
+/**
+ * Number of matching items by each filter value. Each facet applies every filter except its own
+ */
+export type SearchFacets = {
+    entity_types: Array<SearchFacetCount>;
+    statuses: Array<SearchFacetCount>;
+    tiers: Array<SearchFacetCount>;
+};
+
+export type SearchResults = {
+    items: Array<SearchResult>;
+    /**
+     * Number of matching items across all pages
+     */
+    total: number;
+    facets: SearchFacets;
+};
+
+/**
+ * Status of a DPIA. Absent on a study without a DPIA
+ */
+export type DpiaStatus = 'draft' | 'submitted' | 'signed_off';
+
+export type DpiaRiskLevel = 'low' | 'medium' | 'high';
+
+export type DpiaAnswer = {
+    /**
+     * Key of the question e.g. processing_description
+     */
+    question: string;
+    /**
+     * Question text to show to the user
+     */
+    prompt: string;
+    /**
+     * Empty if not yet answered
+     */
+    answer: string;
+};
+
+export type DpiaAnswerUpdate = {
+    question: string;
+    answer: string;
+};
+
+export type DpiaRisk = {
+    /**
+     * Risk to the rights and freedoms of data subjects
+     */
+    description: string;
+    likelihood: DpiaRiskLevel;
+    severity: DpiaRiskLevel;
+    /**
+     * Measures taken to reduce the risk
+     */
+    mitigation: string;
+    residual_risk: DpiaRiskLevel;
+};
+
+export type Dpia = {
+    status: DpiaStatus;
+    /**
+     * Why the DPIA is required
+     */
+    trigger_reason: string;
+    /**
+     * Answers to every DPIA question, in order
+     */
+    answers: Array<DpiaAnswer>;
+    risks: Array<DpiaRisk>;
+    /**
+     * Username of the DPO who signed off the DPIA
+     */
+    signed_off_by_username?: string;
+    /**
+     * Time in RFC3339 format of the sign-off
+     */
+    signed_off_at?: string;
+    /**
+     * Date in YYYY-MM-DD format by which the DPIA must be reviewed
+     */
+    review_date?: string;
+    /**
+     * Time in RFC3339 format of the last update
+     */
+    updated_at: string;
+};
+
+export type DpiaUpdate = {
+    answers: Array<DpiaAnswerUpdate>;
+    risks: Array<DpiaRisk>;
+};
+
+export type DpiaSignoff = {
+    /**
+     * Date in YYYY-MM-DD format by which the DPIA must be reviewed
+     */
+    review_date: string;
+};
+
+export type StudyReviewerUpdate = {
+    /**
+     * Username of the IG ops staff member to review the study
+     */
+    username: string;
+};
+
+export type StudyImport = {
+    title: string;
+    description?: string;
+    additional_study_admin_username?: string;
+    data_controller_organisation: string;
+    involves_ucl_sponsorship?: boolean;
+    involves_cag?: boolean;
+    cag_reference?: string;
+    involves_ethics_approval?: boolean;
+    involves_hra_approval?: boolean;
+    iras_id?: string;
+    is_nhs_associated?: boolean;
+    involves_nhs_england?: boolean;
+    nhs_england_reference?: string;
+    involves_mnca?: boolean;
+    requires_dspt?: boolean;
+    requires_dbs?: boolean;
+    is_data_protection_office_registered?: boolean;
+    data_protection_number?: string;
+    involves_third_party?: boolean;
+    involves_external_users?: boolean;
+    involves_participant_consent?: boolean;
+    involves_indirect_data_collection?: boolean;
+    involves_data_processing_outside_eea?: boolean;
+    owner_username: string;
+    /**
+     * Time in RFC3339 format when the study was created
+     */
+    created_at: string;
+    /**
+     * Time in RFC3339 format when the study was last updated
+     */
+    updated_at: string;
+    approval_status: string;
+    feedback?: string;
+    last_signoff?: string;
+    caseref: number;
+    /**
+     * Time in RFC3339 format when the owner agreed to the IAO agreement
+     */
+    owner_agreed_at?: string;
+    /**
+     * Time in RFC3339 format when the owner agreed to the IAA agreement
+     */
+    admin_agreed_at?: string;
+};
+
+export type AssetImport = {
+    title: string;
+    description: string;
+    tier: number;
     locations: Array<string>;
     protection?: string;
     legal_basis?: string;
@@ -511,17 +1205,69 @@ export type ContractImport = {
     expiry_at?: string;
 };
 
+export type StudyBulkImport = {
+    /**
+     * XLSX workbook with studies, assets and/or contracts sheets
+     */
+    workbook?: Blob | File;
+    /**
+     * CSV of studies
+     */
+    studies?: Blob | File;
+    /**
+     * CSV of assets
+     */
+    assets?: Blob | File;
+    /**
+     * CSV of contracts
+     */
+    contracts?: Blob | File;
+};
+
+export type StudyBulkImportError = {
+    /**
+     * Sheet containing the row e.g. studies
+     */
+    sheet: string;
+    /**
+     * Row number within the sheet, where the header is row 1
+     */
+    row: number;
+    message: string;
+};
+
+export type StudyBulkImportReport = {
+    dry_run: boolean;
+    /**
+     * Whether the rows were imported
+     */
+    committed: boolean;
+    /**
+     * Number of studies imported, or that would be imported in a dry run
+     */
+    studies: number;
+    /**
+     * Number of assets imported, or that would be imported in a dry run
+     */
+    assets: number;
+    /**
+     * Number of contracts imported, or that would be imported in a dry run
+     */
+    contracts: number;
+    errors: Array<StudyBulkImportError>;
+};
+
 /**
  * Current approval status
  */
-export type StudyApprovalStatus = 'Incomplete' | 'Pending' | 'Approved' | 'Rejected';
+export type StudyApprovalStatus = 'Incomplete' | 'Pending' | 'Approved' | 'Rejected' | 'Closing' | 'Closed' | 'Archived';
 
-export type ProjectTreStatus = 'incomplete' | 'pending-approval' | 'pending-creation' | 'deployed' | 'pending-update' | 'pending-deletion' | 'deleted';
+export type ProjectTreStatus = 'incomplete' | 'pending-approval' | 'pending-creation' | 'deployed' | 'pending-update' | 'pending-deletion' | 'deleted' | 'suspended';
 
 export type StudyReview = {
     status: StudyApprovalStatus;
     /**
-     * Feedback for the study
+     * Feedback for the study. Non-empty feedback starts a general review thread
      */
     feedback?: string;
 };
@@ -631,7 +1377,7 @@ export type ProjectDshMember = {
 
 export type ProjectDshRole = 'read' | 'write' | 'outbound';
 
-export type ProjectDshStatus = 'active';
+export type ProjectDshStatus = 'active' | 'retired';
 
 export type EnvironmentName = 'ARC Trusted Research Environment' | 'Data Safe Haven';
 
@@ -758,7 +1504,7 @@ export type ContractBase = {
     /**
      * Current status of the contract
      */
-    status: 'active' | 'closed';
+    status: 'pending' | 'active' | 'closed';
     /**
      * Contract start date in YYYY-MM-DD format
      */
@@ -785,6 +1531,10 @@ export type Contract = ContractBase & {
      * Unique identifier for the contract
      */
     id: string;
+    /**
+     * Version of the current effective terms. The original terms are version 1
+     */
+    version: number;
     /**
      * Time in RFC3339 format when the contract was created
      */
@@ -798,6 +1548,14 @@ export type Contract = ContractBase & {
      */
     study_id: string;
     objects_metadata: Array<ContractObjectMetadata>;
+    /**
+     * Unique identifier of the contract this renews, if any
+     */
+    predecessor_contract_id?: string;
+    /**
+     * Unique identifier of the renewal of this contract, if one has been started
+     */
+    successor_contract_id?: string;
 };
 
 export type ContractObject = {
@@ -813,12 +1571,216 @@ export type ContractObjectMetadata = {
      */
     id: string;
     filename: string;
+    /**
+     * Contract version the object was uploaded for, if any
+     */
+    contract_version_id?: string;
+    scan_status: MalwareScanStatus;
+    /**
+     * Time in RFC3339 format when the object was scanned for malware
+     */
+    scanned_at?: string;
     /**
      * Time in RFC3339 format when the contract was created
      */
     created_at: string;
 };
 
+/**
+ * Whether an uploaded file has been scanned for malware. Pending and infected files are
+ * quarantined and cannot be downloaded. Skipped files were uploaded while scanning was disabled
+ *
+ */
+export type MalwareScanStatus = 'pending' | 'clean' | 'infected' | 'skipped';
+
+export type ContractVersionStatus = 'pending' | 'approved' | 'rejected';
+
+export type ContractAmendmentRequest = {
+    /**
+     * Date in YYYY-MM-DD format from which the amended terms apply
+     */
+    effective_date: string;
+    /**
+     * Why the contract was varied
+     */
+    reason: string;
+    terms: ContractBase;
+};
+
+export type ContractAmendmentReview = {
+    /**
+     * Comments of the reviewer
+     */
+    comments?: string;
+};
+
+/**
+ * Record of a user downloading a contract object
+ */
+export type ContractObjectDownload = {
+    id: string;
+    contract_object_id: string;
+    filename: string;
+    username: string;
+    /**
+     * Whether the downloaded file was stamped with a watermark identifying the user
+     */
+    watermarked: boolean;
+    /**
+     * Time in RFC3339 format when the object was downloaded
+     */
+    created_at: string;
+};
+
+/**
+ * Terms of a contract from an effective date, either the original terms or an amendment
+ */
+export type ContractVersion = {
+    id: string;
+    contract_id: string;
+    /**
+     * Version number. The original terms are version 1
+     */
+    version: number;
+    status: ContractVersionStatus;
+    /**
+     * Date in YYYY-MM-DD format from which the terms apply
+     */
+    effective_date: string;
+    reason: string;
+    /**
+     * Fields of the terms which differ from the previous effective version
+     */
+    changed_fields: Array<string>;
+    terms: ContractBase;
+    objects_metadata: Array<ContractObjectMetadata>;
+    requester_username: string;
+    reviewer_username?: string;
+    reviewer_comments?: string;
+    reviewed_at?: string;
+    /**
+     * Time in RFC3339 format when the terms became the current terms of the contract
+     */
+    applied_at?: string;
+    created_at: string;
+};
+
+/**
+ * Kind of approval e.g. research ethics committee (ethics) or Health Research Authority (hra)
+ */
+export type RegulatoryApprovalType = 'ethics' | 'hra' | 'cag' | 'nhs-england' | 'mnca' | 'other';
+
+export type RegulatoryApprovalBase = {
+    type: RegulatoryApprovalType;
+    /**
+     * Reference issued with the approval e.g. an IRAS ID or CAG reference
+     */
+    reference: string;
+    /**
+     * Name of the committee or organisation which granted the approval
+     */
+    issuing_body: string;
+    /**
+     * Date the approval was granted in YYYY-MM-DD format
+     */
+    granted_date: string;
+    /**
+     * Date the approval expires in YYYY-MM-DD format. Absent if it does not expire
+     */
+    expiry_date?: string;
+    /**
+     * Any conditions attached to the approval
+     */
+    conditions?: string;
+};
+
+/**
+ * An ethics or regulatory approval of a study
+ */
+export type RegulatoryApproval = RegulatoryApprovalBase & {
+    id: string;
+    study_id: string;
+    /**
+     * Time in RFC3339 format when the approval was recorded
+     */
+    created_at: string;
+    /**
+     * Time in RFC3339 format when the approval was last updated
+     */
+    updated_at: string;
+    /**
+     * Filename of the uploaded approval letter. Absent if none has been uploaded
+     */
+    letter_filename?: string;
+};
+
+export type RegulatoryApprovalLetter = {
+    /**
+     * The approval letter to upload (e.g., PDF)
+     */
+    file: Blob | File;
+};
+
+/**
+ * Kind of evidence the document provides
+ */
+export type StudyDocumentCategory = 'ethics-letter' | 'dspt-evidence' | 'data-management-plan' | 'destruction-certificate' | 'other';
+
+export type StudyDocumentVersion = {
+    id: string;
+    /**
+     * Version number, starting at 1
+     */
+    version: number;
+    filename: string;
+    /**
+     * Username of the user who uploaded this version
+     */
+    creator_username: string;
+    /**
+     * Time in RFC3339 format when the version was uploaded
+     */
+    created_at: string;
+};
+
+export type StudyDocument = {
+    id: string;
+    study_id: string;
+    category: StudyDocumentCategory;
+    title: string;
+    /**
+     * Uploaded versions of the document, newest first
+     */
+    versions: Array<StudyDocumentVersion>;
+    /**
+     * Time in RFC3339 format when the document was created
+     */
+    created_at: string;
+    /**
+     * Time in RFC3339 format when the document was last updated
+     */
+    updated_at: string;
+};
+
+export type StudyDocumentUpload = {
+    /**
+     * The first version of the document to upload (e.g., PDF)
+     */
+    file: Blob | File;
+    category: StudyDocumentCategory;
+    /**
+     * Short title of the document
+     */
+    title: string;
+};
+
+export type StudyDocumentVersionUpload = {
+    /**
+     * The new version of the document to upload (e.g., PDF)
+     */
+    file: Blob | File;
+};
+
 export type TokenRequest = {
     name: string;
     valid_for_days: number;
@@ -841,6 +1803,60 @@ export type Feedback = {
     message: string;
 };
 
+/**
+ * Kind of record being erased
+ */
+export type ErasureSubjectKind = 'study' | 'user';
+
+export type ErasureStatus = 'pending' | 'completed' | 'rejected';
+
+export type ErasureRequest = {
+    subject_kind: ErasureSubjectKind;
+    /**
+     * UUID of the study or user to erase
+     */
+    subject_id: string;
+    /**
+     * Legal basis for the erasure e.g. a data subject request reference
+     */
+    reason: string;
+};
+
+export type ErasureManifest = {
+    /**
+     * Number of rows removed, or to be removed, from each table
+     */
+    rows: {
+        [key: string]: number;
+    };
+    /**
+     * Keys of the stored objects removed, or to be removed
+     */
+    objects: Array<string>;
+};
+
+export type Erasure = {
+    id: string;
+    subject_kind: ErasureSubjectKind;
+    subject_id: string;
+    reason: string;
+    status: ErasureStatus;
+    requester_username: string;
+    /**
+     * Administrator who approved or rejected the erasure
+     */
+    reviewer_username?: string;
+    manifest: ErasureManifest;
+    /**
+     * Time in RFC3339 format when the erasure was requested
+     */
+    created_at: string;
+    /**
+     * Time in RFC3339 format when the erasure was carried out
+     */
+    completed_at?: string;
+};
+
 /**
  * User UUID
  */
@@ -851,6 +1867,11 @@ export type UserIdParam = string;
  */
 export type StudyIdParam = string;
 
+/**
+ * Review thread UUID
+ */
+export type ReviewThreadIdParam = string;
+
 /**
  * Project UUID
  */
@@ -861,6 +1882,21 @@ export type ProjectIdParam = string;
  */
 export type AssetIdParam = string;
 
+/**
+ * Asset destruction request UUID
+ */
+export type DestructionIdParam = string;
+
+/**
+ * Asset transfer UUID
+ */
+export type TransferIdParam = string;
+
+/**
+ * Parent asset UUID
+ */
+export type ParentAssetIdParam = string;
+
 /**
  * Contract UUID
  */
@@ -871,6 +1907,41 @@ export type ContractIdParam = string;
  */
 export type ContractObjectIdParam = string;
 
+/**
+ * Contract version UUID
+ */
+export type ContractVersionIdParam = string;
+
+/**
+ * Regulatory approval UUID
+ */
+export type ApprovalIdParam = string;
+
+/**
+ * Study document UUID
+ */
+export type DocumentIdParam = string;
+
+/**
+ * Study document version UUID
+ */
+export type DocumentVersionIdParam = string;
+
+/**
+ * Erasure UUID
+ */
+export type ErasureIdParam = string;
+
+/**
+ * Asset expiry review UUID
+ */
+export type ExpiryReviewIdParam = string;
+
+/**
+ * Asset register export UUID
+ */
+export type ExportIdParam = string;
+
 /**
  * Short environment name
  */
@@ -1561,6 +2632,65 @@ export type GetUsersMetricsResponses = {
 
 export type GetUsersMetricsResponse = GetUsersMetricsResponses[keyof GetUsersMetricsResponses];
 
+export type GetSearchData = {
+    body?: never;
+    path?: never;
+    query: {
+        /**
+         * Text to search for
+         */
+        query: string;
+        /**
+         * Only return results of this type
+         */
+        entity_type?: SearchEntityType;
+        /**
+         * Only return results with this status e.g. approved, active
+         */
+        status?: string;
+        /**
+         * Only return results with this tier
+         */
+        tier?: number;
+        /**
+         * Maximum number of items to return
+         */
+        limit?: number;
+        /**
+         * Index of the first item to return
+         */
+        offset?: number;
+    };
+    url: '/search';
+};
+
+export type GetSearchErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetSearchError = GetSearchErrors[keyof GetSearchErrors];
+
+export type GetSearchResponses = {
+    200: SearchResults;
+};
+
+export type GetSearchResponse = GetSearchResponses[keyof GetSearchResponses];
+
 export type GetStudiesData = {
     body?: never;
     path?: never;
@@ -1593,6 +2723,14 @@ export type GetStudiesData = {
          * Username/name/email of a study administrator. e.g. ccxyz@ucl.ac.uk, Bob Smith, bob.smith@ucl.ac.uk
          */
         administrator?: string;
+        /**
+         * Filter by review assignment. "me" for studies assigned to the current user, "unassigned" for those with no reviewer
+         */
+        reviewer?: 'me' | 'unassigned';
+        /**
+         * Filter by study risk rating
+         */
+        risk_rating?: StudyRiskRating;
         /**
          * Maximum number of items to return
          */
@@ -1784,8 +2922,8 @@ export type PostStudiesAdminByStudyIdReviewResponses = {
     201: unknown;
 };
 
-export type PostStudiesAdminByStudyIdOwnerRequestData = {
-    body: StudyOwnerUpdate;
+export type DeleteStudiesAdminByStudyIdReviewerData = {
+    body?: never;
     path: {
         /**
          * Study UUID
@@ -1793,10 +2931,10 @@ export type PostStudiesAdminByStudyIdOwnerRequestData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/admin/{studyId}/owner-request';
+    url: '/studies/admin/{studyId}/reviewer';
 };
 
-export type PostStudiesAdminByStudyIdOwnerRequestErrors = {
+export type DeleteStudiesAdminByStudyIdReviewerErrors = {
     /**
      * Forbidden
      */
@@ -1811,15 +2949,15 @@ export type PostStudiesAdminByStudyIdOwnerRequestErrors = {
     500: unknown;
 };
 
-export type PostStudiesAdminByStudyIdOwnerRequestResponses = {
+export type DeleteStudiesAdminByStudyIdReviewerResponses = {
     /**
-     * Owner change request successful
+     * Reviewer unassigned successfully
      */
     200: unknown;
 };
 
-export type PostStudiesAdminByStudyIdOwnerApproveData = {
-    body: StudyOwnerUpdate;
+export type PutStudiesAdminByStudyIdReviewerData = {
+    body: StudyReviewerUpdate;
     path: {
         /**
          * Study UUID
@@ -1827,10 +2965,14 @@ export type PostStudiesAdminByStudyIdOwnerApproveData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/admin/{studyId}/owner-approve';
+    url: '/studies/admin/{studyId}/reviewer';
 };
 
-export type PostStudiesAdminByStudyIdOwnerApproveErrors = {
+export type PutStudiesAdminByStudyIdReviewerErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
@@ -1845,52 +2987,57 @@ export type PostStudiesAdminByStudyIdOwnerApproveErrors = {
     500: unknown;
 };
 
-export type PostStudiesAdminByStudyIdOwnerApproveResponses = {
+export type PutStudiesAdminByStudyIdReviewerError = PutStudiesAdminByStudyIdReviewerErrors[keyof PutStudiesAdminByStudyIdReviewerErrors];
+
+export type PutStudiesAdminByStudyIdReviewerResponses = {
     /**
-     * Owner update approval successful
+     * Reviewer assigned successfully
      */
     200: unknown;
 };
 
-export type PostStudiesAdminImportData = {
-    body: StudyImport;
-    path?: never;
+export type PostStudiesAdminByStudyIdReviewerClaimData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
     query?: never;
-    url: '/studies/admin/import';
+    url: '/studies/admin/{studyId}/reviewer/claim';
 };
 
-export type PostStudiesAdminImportResponses = {
+export type PostStudiesAdminByStudyIdReviewerClaimErrors = {
     /**
-     * OK
+     * Study is already being reviewed by another user
      */
-    200: Study;
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type PostStudiesAdminImportResponse = PostStudiesAdminImportResponses[keyof PostStudiesAdminImportResponses];
-
-export type PostStudiesAdminByStudyIdAssetsImportData = {
-    body: AssetImport;
-    path: {
-        /**
-         * Study UUID
-         */
-        studyId: string;
-    };
-    query?: never;
-    url: '/studies/admin/{studyId}/assets/import';
-};
+export type PostStudiesAdminByStudyIdReviewerClaimError = PostStudiesAdminByStudyIdReviewerClaimErrors[keyof PostStudiesAdminByStudyIdReviewerClaimErrors];
 
-export type PostStudiesAdminByStudyIdAssetsImportResponses = {
+export type PostStudiesAdminByStudyIdReviewerClaimResponses = {
     /**
-     * OK
+     * Review claimed successfully
      */
-    200: Asset;
+    200: unknown;
 };
 
-export type PostStudiesAdminByStudyIdAssetsImportResponse = PostStudiesAdminByStudyIdAssetsImportResponses[keyof PostStudiesAdminByStudyIdAssetsImportResponses];
-
-export type PostStudiesAdminByStudyIdContractsImportData = {
-    body: ContractImport;
+export type PostStudiesAdminByStudyIdOwnerRequestData = {
+    body: StudyOwnerUpdate;
     path: {
         /**
          * Study UUID
@@ -1898,20 +3045,33 @@ export type PostStudiesAdminByStudyIdContractsImportData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/admin/{studyId}/contracts/import';
+    url: '/studies/admin/{studyId}/owner-request';
 };
 
-export type PostStudiesAdminByStudyIdContractsImportResponses = {
+export type PostStudiesAdminByStudyIdOwnerRequestErrors = {
     /**
-     * OK
+     * Forbidden
      */
-    200: Contract;
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type PostStudiesAdminByStudyIdContractsImportResponse = PostStudiesAdminByStudyIdContractsImportResponses[keyof PostStudiesAdminByStudyIdContractsImportResponses];
+export type PostStudiesAdminByStudyIdOwnerRequestResponses = {
+    /**
+     * Owner change request successful
+     */
+    200: unknown;
+};
 
-export type PatchStudiesByStudyIdPendingData = {
-    body?: never;
+export type PostStudiesAdminByStudyIdOwnerApproveData = {
+    body: StudyOwnerUpdate;
     path: {
         /**
          * Study UUID
@@ -1919,10 +3079,10 @@ export type PatchStudiesByStudyIdPendingData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/pending';
+    url: '/studies/admin/{studyId}/owner-approve';
 };
 
-export type PatchStudiesByStudyIdPendingErrors = {
+export type PostStudiesAdminByStudyIdOwnerApproveErrors = {
     /**
      * Forbidden
      */
@@ -1931,17 +3091,21 @@ export type PatchStudiesByStudyIdPendingErrors = {
      * Study not found
      */
     404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type PatchStudiesByStudyIdPendingResponses = {
+export type PostStudiesAdminByStudyIdOwnerApproveResponses = {
     /**
-     * Study review submitted successfully
+     * Owner update approval successful
      */
-    201: unknown;
+    200: unknown;
 };
 
-export type PostStudiesByStudyIdSignoffData = {
-    body?: never;
+export type PostStudiesAdminByStudyIdOwnerRejectData = {
+    body: StudyOwnerChangeDecision;
     path: {
         /**
          * Study UUID
@@ -1949,16 +3113,20 @@ export type PostStudiesByStudyIdSignoffData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/signoff';
+    url: '/studies/admin/{studyId}/owner-reject';
 };
 
-export type PostStudiesByStudyIdSignoffErrors = {
+export type PostStudiesAdminByStudyIdOwnerRejectErrors = {
+    /**
+     * Invalid rejection
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Study not found
+     * Study or pending owner change not found
      */
     404: unknown;
     /**
@@ -1967,15 +3135,17 @@ export type PostStudiesByStudyIdSignoffErrors = {
     500: unknown;
 };
 
-export type PostStudiesByStudyIdSignoffResponses = {
+export type PostStudiesAdminByStudyIdOwnerRejectError = PostStudiesAdminByStudyIdOwnerRejectErrors[keyof PostStudiesAdminByStudyIdOwnerRejectErrors];
+
+export type PostStudiesAdminByStudyIdOwnerRejectResponses = {
     /**
-     * Signoff recorded successfully
+     * Owner change rejected successfully
      */
     200: unknown;
 };
 
-export type PostStudiesByStudyIdOwnerRequestData = {
-    body: StudyOwnerUpdate;
+export type PostStudiesAdminByStudyIdOwnerCancelData = {
+    body: StudyOwnerChangeDecision;
     path: {
         /**
          * Study UUID
@@ -1983,16 +3153,20 @@ export type PostStudiesByStudyIdOwnerRequestData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/owner-request';
+    url: '/studies/admin/{studyId}/owner-cancel';
 };
 
-export type PostStudiesByStudyIdOwnerRequestErrors = {
+export type PostStudiesAdminByStudyIdOwnerCancelErrors = {
+    /**
+     * Invalid cancellation
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Study not found
+     * Study or pending owner change not found
      */
     404: unknown;
     /**
@@ -2001,45 +3175,47 @@ export type PostStudiesByStudyIdOwnerRequestErrors = {
     500: unknown;
 };
 
-export type PostStudiesByStudyIdOwnerRequestResponses = {
+export type PostStudiesAdminByStudyIdOwnerCancelError = PostStudiesAdminByStudyIdOwnerCancelErrors[keyof PostStudiesAdminByStudyIdOwnerCancelErrors];
+
+export type PostStudiesAdminByStudyIdOwnerCancelResponses = {
     /**
-     * Owner change request successful
+     * Owner change cancelled successfully
      */
     200: unknown;
 };
 
-export type GetEnvironmentsData = {
+export type GetStudiesAdminOwnerChangesData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/environments';
+    url: '/studies/admin/owner-changes';
 };
 
-export type GetEnvironmentsErrors = {
+export type GetStudiesAdminOwnerChangesErrors = {
     /**
-     * Internal server error
+     * Forbidden
      */
-    500: unknown;
+    403: unknown;
     /**
-     * Unexpected error
+     * Internal server error
      */
-    default: unknown;
+    500: unknown;
 };
 
-export type GetEnvironmentsResponses = {
-    200: Array<Environment>;
+export type GetStudiesAdminOwnerChangesResponses = {
+    200: Array<StudyOwnerChange>;
 };
 
-export type GetEnvironmentsResponse = GetEnvironmentsResponses[keyof GetEnvironmentsResponses];
+export type GetStudiesAdminOwnerChangesResponse = GetStudiesAdminOwnerChangesResponses[keyof GetStudiesAdminOwnerChangesResponses];
 
-export type GetProjectsData = {
+export type GetStudiesAdminAssetDestructionsData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/projects';
+    url: '/studies/admin/asset-destructions';
 };
 
-export type GetProjectsErrors = {
+export type GetStudiesAdminAssetDestructionsErrors = {
     /**
      * Forbidden
      */
@@ -2048,26 +3224,31 @@ export type GetProjectsErrors = {
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type GetProjectsResponses = {
-    200: Array<Project>;
+export type GetStudiesAdminAssetDestructionsResponses = {
+    200: Array<AssetDestruction>;
 };
 
-export type GetProjectsResponse = GetProjectsResponses[keyof GetProjectsResponses];
+export type GetStudiesAdminAssetDestructionsResponse = GetStudiesAdminAssetDestructionsResponses[keyof GetStudiesAdminAssetDestructionsResponses];
 
-export type GetProjectsTreData = {
+export type GetStudiesAdminAssetLocationsData = {
     body?: never;
     path?: never;
-    query?: never;
-    url: '/projects/tre';
+    query?: {
+        /**
+         * Only include locations of this kind
+         */
+        kind?: AssetLocationKind;
+    };
+    url: '/studies/admin/asset-locations';
 };
 
-export type GetProjectsTreErrors = {
+export type GetStudiesAdminAssetLocationsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
@@ -2076,26 +3257,37 @@ export type GetProjectsTreErrors = {
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type GetProjectsTreResponses = {
-    200: Array<ProjectTre>;
+export type GetStudiesAdminAssetLocationsError = GetStudiesAdminAssetLocationsErrors[keyof GetStudiesAdminAssetLocationsErrors];
+
+export type GetStudiesAdminAssetLocationsResponses = {
+    200: Array<AssetLocationReport>;
 };
 
-export type GetProjectsTreResponse = GetProjectsTreResponses[keyof GetProjectsTreResponses];
+export type GetStudiesAdminAssetLocationsResponse = GetStudiesAdminAssetLocationsResponses[keyof GetStudiesAdminAssetLocationsResponses];
 
-export type PostProjectsTreData = {
-    body: ProjectTreRequest;
+export type GetStudiesAdminAssetRegisterData = {
+    body?: never;
     path?: never;
-    query?: never;
-    url: '/projects/tre';
+    query?: {
+        /**
+         * Format of the export. Defaults to csv
+         */
+        format?: AssetRegisterFormat;
+        /**
+         * Only include assets with this status
+         */
+        status?: 'active' | 'destroyed' | 'transferred';
+        /**
+         * Only include assets with this tier
+         */
+        tier?: number;
+    };
+    url: '/studies/admin/asset-register';
 };
 
-export type PostProjectsTreErrors = {
+export type GetStudiesAdminAssetRegisterErrors = {
     /**
      * Validation error
      */
@@ -2108,113 +3300,116 @@ export type PostProjectsTreErrors = {
      * Internal server error
      */
     500: unknown;
+};
+
+export type GetStudiesAdminAssetRegisterError = GetStudiesAdminAssetRegisterErrors[keyof GetStudiesAdminAssetRegisterErrors];
+
+export type GetStudiesAdminAssetRegisterResponses = {
     /**
-     * Unexpected error
+     * The register as a file in the requested format
      */
-    default: unknown;
+    200: Blob | File;
 };
 
-export type PostProjectsTreError = PostProjectsTreErrors[keyof PostProjectsTreErrors];
+export type GetStudiesAdminAssetRegisterResponse = GetStudiesAdminAssetRegisterResponses[keyof GetStudiesAdminAssetRegisterResponses];
 
-export type PostProjectsTreResponses = {
+export type GetStudiesAdminAssetRegisterExportsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/studies/admin/asset-register/exports';
+};
+
+export type GetStudiesAdminAssetRegisterExportsErrors = {
     /**
-     * TRE project created successfully
+     * Forbidden
      */
-    201: ProjectTre;
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type PostProjectsTreResponse = PostProjectsTreResponses[keyof PostProjectsTreResponses];
+export type GetStudiesAdminAssetRegisterExportsResponses = {
+    200: Array<AssetRegisterExport>;
+};
 
-export type DeleteProjectsTreByProjectIdData = {
+export type GetStudiesAdminAssetRegisterExportsResponse = GetStudiesAdminAssetRegisterExportsResponses[keyof GetStudiesAdminAssetRegisterExportsResponses];
+
+export type GetStudiesAdminAssetRegisterExportsByExportIdFileData = {
     body?: never;
     path: {
         /**
-         * Project UUID
+         * Asset register export UUID
          */
-        projectId: string;
+        exportId: string;
     };
     query?: never;
-    url: '/projects/tre/{projectId}';
+    url: '/studies/admin/asset-register/exports/{exportId}/file';
 };
 
-export type DeleteProjectsTreByProjectIdErrors = {
+export type GetStudiesAdminAssetRegisterExportsByExportIdFileErrors = {
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Project not found
+     * Export not found
      */
     404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type DeleteProjectsTreByProjectIdResponses = {
+export type GetStudiesAdminAssetRegisterExportsByExportIdFileResponses = {
     /**
-     * Project deleted successfully
+     * OK
      */
-    204: void;
+    200: Blob | File;
 };
 
-export type DeleteProjectsTreByProjectIdResponse = DeleteProjectsTreByProjectIdResponses[keyof DeleteProjectsTreByProjectIdResponses];
+export type GetStudiesAdminAssetRegisterExportsByExportIdFileResponse = GetStudiesAdminAssetRegisterExportsByExportIdFileResponses[keyof GetStudiesAdminAssetRegisterExportsByExportIdFileResponses];
 
-export type GetProjectsTreByProjectIdData = {
+export type GetStudiesAdminAssetTransfersData = {
     body?: never;
-    path: {
-        /**
-         * Project UUID
-         */
-        projectId: string;
-    };
+    path?: never;
     query?: never;
-    url: '/projects/tre/{projectId}';
+    url: '/studies/admin/asset-transfers';
 };
 
-export type GetProjectsTreByProjectIdErrors = {
+export type GetStudiesAdminAssetTransfersErrors = {
     /**
      * Forbidden
      */
     403: unknown;
-    /**
-     * Project not found
-     */
-    404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type GetProjectsTreByProjectIdResponses = {
-    200: ProjectTre;
+export type GetStudiesAdminAssetTransfersResponses = {
+    200: Array<AssetTransfer>;
 };
 
-export type GetProjectsTreByProjectIdResponse = GetProjectsTreByProjectIdResponses[keyof GetProjectsTreByProjectIdResponses];
+export type GetStudiesAdminAssetTransfersResponse = GetStudiesAdminAssetTransfersResponses[keyof GetStudiesAdminAssetTransfersResponses];
 
-export type PutProjectsTreByProjectIdData = {
-    body: ProjectTreUpdate;
+export type PostStudiesAdminAssetTransfersByTransferIdApproveData = {
+    body?: never;
     path: {
         /**
-         * Project UUID
+         * Asset transfer UUID
          */
-        projectId: string;
+        transferId: string;
     };
     query?: never;
-    url: '/projects/tre/{projectId}';
+    url: '/studies/admin/asset-transfers/{transferId}/approve';
 };
 
-export type PutProjectsTreByProjectIdErrors = {
+export type PostStudiesAdminAssetTransfersByTransferIdApproveErrors = {
     /**
      * Validation error
      */
@@ -2224,7 +3419,7 @@ export type PutProjectsTreByProjectIdErrors = {
      */
     403: unknown;
     /**
-     * Project not found
+     * Transfer not found
      */
     404: unknown;
     /**
@@ -2237,34 +3432,37 @@ export type PutProjectsTreByProjectIdErrors = {
     default: unknown;
 };
 
-export type PutProjectsTreByProjectIdError = PutProjectsTreByProjectIdErrors[keyof PutProjectsTreByProjectIdErrors];
+export type PostStudiesAdminAssetTransfersByTransferIdApproveError = PostStudiesAdminAssetTransfersByTransferIdApproveErrors[keyof PostStudiesAdminAssetTransfersByTransferIdApproveErrors];
 
-export type PutProjectsTreByProjectIdResponses = {
-    /**
-     * Project updated successfully
-     */
-    200: unknown;
+export type PostStudiesAdminAssetTransfersByTransferIdApproveResponses = {
+    200: AssetTransfer;
 };
 
-export type PatchProjectsTreByProjectIdPendingData = {
+export type PostStudiesAdminAssetTransfersByTransferIdApproveResponse = PostStudiesAdminAssetTransfersByTransferIdApproveResponses[keyof PostStudiesAdminAssetTransfersByTransferIdApproveResponses];
+
+export type PostStudiesAdminAssetTransfersByTransferIdRejectData = {
     body?: never;
     path: {
         /**
-         * Project UUID
+         * Asset transfer UUID
          */
-        projectId: string;
+        transferId: string;
     };
     query?: never;
-    url: '/projects/tre/{projectId}/pending';
+    url: '/studies/admin/asset-transfers/{transferId}/reject';
 };
 
-export type PatchProjectsTreByProjectIdPendingErrors = {
+export type PostStudiesAdminAssetTransfersByTransferIdRejectErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Project not found
+     * Transfer not found
      */
     404: unknown;
     /**
@@ -2277,34 +3475,2714 @@ export type PatchProjectsTreByProjectIdPendingErrors = {
     default: unknown;
 };
 
-export type PatchProjectsTreByProjectIdPendingResponses = {
-    /**
-     * Project submitted successfully
-     */
-    200: unknown;
+export type PostStudiesAdminAssetTransfersByTransferIdRejectError = PostStudiesAdminAssetTransfersByTransferIdRejectErrors[keyof PostStudiesAdminAssetTransfersByTransferIdRejectErrors];
+
+export type PostStudiesAdminAssetTransfersByTransferIdRejectResponses = {
+    200: AssetTransfer;
 };
 
-export type PostProjectsTreAdminByProjectIdApproveData = {
-    body?: never;
-    path: {
-        /**
-         * Project UUID
+export type PostStudiesAdminAssetTransfersByTransferIdRejectResponse = PostStudiesAdminAssetTransfersByTransferIdRejectResponses[keyof PostStudiesAdminAssetTransfersByTransferIdRejectResponses];
+
+export type PostStudiesAdminImportData = {
+    body: StudyImport;
+    path?: never;
+    query?: never;
+    url: '/studies/admin/import';
+};
+
+export type PostStudiesAdminImportResponses = {
+    /**
+     * OK
+     */
+    200: Study;
+};
+
+export type PostStudiesAdminImportResponse = PostStudiesAdminImportResponses[keyof PostStudiesAdminImportResponses];
+
+export type PostStudiesAdminByStudyIdAssetsImportData = {
+    body: AssetImport;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/assets/import';
+};
+
+export type PostStudiesAdminByStudyIdAssetsImportResponses = {
+    /**
+     * OK
+     */
+    200: Asset;
+};
+
+export type PostStudiesAdminByStudyIdAssetsImportResponse = PostStudiesAdminByStudyIdAssetsImportResponses[keyof PostStudiesAdminByStudyIdAssetsImportResponses];
+
+export type PostStudiesAdminByStudyIdContractsImportData = {
+    body: ContractImport;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/contracts/import';
+};
+
+export type PostStudiesAdminByStudyIdContractsImportResponses = {
+    /**
+     * OK
+     */
+    200: Contract;
+};
+
+export type PostStudiesAdminByStudyIdContractsImportResponse = PostStudiesAdminByStudyIdContractsImportResponses[keyof PostStudiesAdminByStudyIdContractsImportResponses];
+
+export type PostStudiesAdminImportBulkData = {
+    body: StudyBulkImport;
+    path?: never;
+    query?: {
+        /**
+         * Validate and report on the import without committing it
+         */
+        dry_run?: boolean;
+    };
+    url: '/studies/admin/import/bulk';
+};
+
+export type PostStudiesAdminImportBulkErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type PostStudiesAdminImportBulkError = PostStudiesAdminImportBulkErrors[keyof PostStudiesAdminImportBulkErrors];
+
+export type PostStudiesAdminImportBulkResponses = {
+    /**
+     * Import report. The import was not committed if it has any errors
+     */
+    200: StudyBulkImportReport;
+};
+
+export type PostStudiesAdminImportBulkResponse = PostStudiesAdminImportBulkResponses[keyof PostStudiesAdminImportBulkResponses];
+
+export type PatchStudiesByStudyIdPendingData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/pending';
+};
+
+export type PatchStudiesByStudyIdPendingErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+};
+
+export type PatchStudiesByStudyIdPendingResponses = {
+    /**
+     * Study review submitted successfully
+     */
+    201: unknown;
+};
+
+export type PostStudiesByStudyIdSignoffData = {
+    body?: StudySignoffRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/signoff';
+};
+
+export type PostStudiesByStudyIdSignoffErrors = {
+    /**
+     * Invalid signoff
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type PostStudiesByStudyIdSignoffError = PostStudiesByStudyIdSignoffErrors[keyof PostStudiesByStudyIdSignoffErrors];
+
+export type PostStudiesByStudyIdSignoffResponses = {
+    /**
+     * Signoff recorded successfully
+     */
+    200: unknown;
+};
+
+export type GetStudiesByStudyIdSignoffsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/signoffs';
+};
+
+export type GetStudiesByStudyIdSignoffsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type GetStudiesByStudyIdSignoffsResponses = {
+    200: Array<StudySignoff>;
+};
+
+export type GetStudiesByStudyIdSignoffsResponse = GetStudiesByStudyIdSignoffsResponses[keyof GetStudiesByStudyIdSignoffsResponses];
+
+export type PostStudiesByStudyIdOwnerRequestData = {
+    body: StudyOwnerUpdate;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/owner-request';
+};
+
+export type PostStudiesByStudyIdOwnerRequestErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type PostStudiesByStudyIdOwnerRequestResponses = {
+    /**
+     * Owner change request successful
+     */
+    200: unknown;
+};
+
+export type PostStudiesByStudyIdOwnerCancelData = {
+    body: StudyOwnerChangeDecision;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/owner-cancel';
+};
+
+export type PostStudiesByStudyIdOwnerCancelErrors = {
+    /**
+     * Invalid cancellation
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or pending owner change not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type PostStudiesByStudyIdOwnerCancelError = PostStudiesByStudyIdOwnerCancelErrors[keyof PostStudiesByStudyIdOwnerCancelErrors];
+
+export type PostStudiesByStudyIdOwnerCancelResponses = {
+    /**
+     * Owner change cancelled successfully
+     */
+    200: unknown;
+};
+
+export type GetStudiesByStudyIdReviewThreadsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/review-threads';
+};
+
+export type GetStudiesByStudyIdReviewThreadsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdReviewThreadsResponses = {
+    200: Array<StudyReviewThread>;
+};
+
+export type GetStudiesByStudyIdReviewThreadsResponse = GetStudiesByStudyIdReviewThreadsResponses[keyof GetStudiesByStudyIdReviewThreadsResponses];
+
+export type PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsData = {
+    body: StudyReviewCommentRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Review thread UUID
+         */
+        reviewThreadId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/review-threads/{reviewThreadId}/comments';
+};
+
+export type PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Review thread not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsError = PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors[keyof PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsErrors];
+
+export type PostStudiesByStudyIdReviewThreadsByReviewThreadIdCommentsResponses = {
+    /**
+     * Comment created successfully
+     */
+    201: unknown;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsData = {
+    body: StudyReviewThreadRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/review-threads';
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsError = PostStudiesAdminByStudyIdReviewThreadsErrors[keyof PostStudiesAdminByStudyIdReviewThreadsErrors];
+
+export type PostStudiesAdminByStudyIdReviewThreadsResponses = {
+    /**
+     * Review thread created successfully
+     */
+    201: StudyReviewThread;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsResponse = PostStudiesAdminByStudyIdReviewThreadsResponses[keyof PostStudiesAdminByStudyIdReviewThreadsResponses];
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsData = {
+    body: StudyReviewCommentRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Review thread UUID
+         */
+        reviewThreadId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/review-threads/{reviewThreadId}/comments';
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Review thread not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsError = PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors[keyof PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsErrors];
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdCommentsResponses = {
+    /**
+     * Comment created successfully
+     */
+    201: unknown;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Review thread UUID
+         */
+        reviewThreadId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/review-threads/{reviewThreadId}/resolve';
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Review thread not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdReviewThreadsByReviewThreadIdResolveResponses = {
+    /**
+     * Review thread resolved successfully
+     */
+    200: unknown;
+};
+
+export type GetStudiesByStudyIdRevisionsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/revisions';
+};
+
+export type GetStudiesByStudyIdRevisionsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdRevisionsResponses = {
+    200: Array<StudyRevision>;
+};
+
+export type GetStudiesByStudyIdRevisionsResponse = GetStudiesByStudyIdRevisionsResponses[keyof GetStudiesByStudyIdRevisionsResponses];
+
+export type GetStudiesByStudyIdRevisionsDiffData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query: {
+        /**
+         * Revision UUID to compare from. Changes made in this revision are excluded
+         */
+        from: string;
+        /**
+         * Revision UUID to compare to. Changes made in this revision are included
+         */
+        to: string;
+    };
+    url: '/studies/{studyId}/revisions/diff';
+};
+
+export type GetStudiesByStudyIdRevisionsDiffErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or revision not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdRevisionsDiffError = GetStudiesByStudyIdRevisionsDiffErrors[keyof GetStudiesByStudyIdRevisionsDiffErrors];
+
+export type GetStudiesByStudyIdRevisionsDiffResponses = {
+    200: StudyRevisionDiff;
+};
+
+export type GetStudiesByStudyIdRevisionsDiffResponse = GetStudiesByStudyIdRevisionsDiffResponses[keyof GetStudiesByStudyIdRevisionsDiffResponses];
+
+export type GetStudiesByStudyIdClosureData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/closure';
+};
+
+export type GetStudiesByStudyIdClosureErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdClosureResponses = {
+    200: StudyClosure;
+};
+
+export type GetStudiesByStudyIdClosureResponse = GetStudiesByStudyIdClosureResponses[keyof GetStudiesByStudyIdClosureResponses];
+
+export type PostStudiesByStudyIdClosureData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/closure';
+};
+
+export type PostStudiesByStudyIdClosureErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdClosureError = PostStudiesByStudyIdClosureErrors[keyof PostStudiesByStudyIdClosureErrors];
+
+export type PostStudiesByStudyIdClosureResponses = {
+    /**
+     * Study closure started
+     */
+    200: unknown;
+};
+
+export type PostStudiesByStudyIdClosureCompleteData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/closure/complete';
+};
+
+export type PostStudiesByStudyIdClosureCompleteErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdClosureCompleteError = PostStudiesByStudyIdClosureCompleteErrors[keyof PostStudiesByStudyIdClosureCompleteErrors];
+
+export type PostStudiesByStudyIdClosureCompleteResponses = {
+    /**
+     * Study closed
+     */
+    200: unknown;
+};
+
+export type PostStudiesAdminByStudyIdArchiveData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/archive';
+};
+
+export type PostStudiesAdminByStudyIdArchiveErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdArchiveError = PostStudiesAdminByStudyIdArchiveErrors[keyof PostStudiesAdminByStudyIdArchiveErrors];
+
+export type PostStudiesAdminByStudyIdArchiveResponses = {
+    /**
+     * Study archived
+     */
+    200: unknown;
+};
+
+export type GetEnvironmentsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/environments';
+};
+
+export type GetEnvironmentsErrors = {
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetEnvironmentsResponses = {
+    200: Array<Environment>;
+};
+
+export type GetEnvironmentsResponse = GetEnvironmentsResponses[keyof GetEnvironmentsResponses];
+
+export type GetProjectsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/projects';
+};
+
+export type GetProjectsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetProjectsResponses = {
+    200: Array<Project>;
+};
+
+export type GetProjectsResponse = GetProjectsResponses[keyof GetProjectsResponses];
+
+export type GetProjectsTreData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/projects/tre';
+};
+
+export type GetProjectsTreErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetProjectsTreResponses = {
+    200: Array<ProjectTre>;
+};
+
+export type GetProjectsTreResponse = GetProjectsTreResponses[keyof GetProjectsTreResponses];
+
+export type PostProjectsTreData = {
+    body: ProjectTreRequest;
+    path?: never;
+    query?: never;
+    url: '/projects/tre';
+};
+
+export type PostProjectsTreErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostProjectsTreError = PostProjectsTreErrors[keyof PostProjectsTreErrors];
+
+export type PostProjectsTreResponses = {
+    /**
+     * TRE project created successfully
+     */
+    201: ProjectTre;
+};
+
+export type PostProjectsTreResponse = PostProjectsTreResponses[keyof PostProjectsTreResponses];
+
+export type DeleteProjectsTreByProjectIdData = {
+    body?: never;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/tre/{projectId}';
+};
+
+export type DeleteProjectsTreByProjectIdErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type DeleteProjectsTreByProjectIdResponses = {
+    /**
+     * Project deleted successfully
+     */
+    204: void;
+};
+
+export type DeleteProjectsTreByProjectIdResponse = DeleteProjectsTreByProjectIdResponses[keyof DeleteProjectsTreByProjectIdResponses];
+
+export type GetProjectsTreByProjectIdData = {
+    body?: never;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/tre/{projectId}';
+};
+
+export type GetProjectsTreByProjectIdErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetProjectsTreByProjectIdResponses = {
+    200: ProjectTre;
+};
+
+export type GetProjectsTreByProjectIdResponse = GetProjectsTreByProjectIdResponses[keyof GetProjectsTreByProjectIdResponses];
+
+export type PutProjectsTreByProjectIdData = {
+    body: ProjectTreUpdate;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/tre/{projectId}';
+};
+
+export type PutProjectsTreByProjectIdErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PutProjectsTreByProjectIdError = PutProjectsTreByProjectIdErrors[keyof PutProjectsTreByProjectIdErrors];
+
+export type PutProjectsTreByProjectIdResponses = {
+    /**
+     * Project updated successfully
+     */
+    200: unknown;
+};
+
+export type PatchProjectsTreByProjectIdPendingData = {
+    body?: never;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/tre/{projectId}/pending';
+};
+
+export type PatchProjectsTreByProjectIdPendingErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PatchProjectsTreByProjectIdPendingResponses = {
+    /**
+     * Project submitted successfully
+     */
+    200: unknown;
+};
+
+export type PostProjectsTreAdminByProjectIdApproveData = {
+    body?: never;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/tre/admin/{projectId}/approve';
+};
+
+export type PostProjectsTreAdminByProjectIdApproveErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostProjectsTreAdminByProjectIdApproveResponses = {
+    /**
+     * Project approved successfully
+     */
+    200: unknown;
+};
+
+export type PostProjectsTreAdminImportData = {
+    body: ProjectTreImport;
+    path?: never;
+    query?: never;
+    url: '/projects/tre/admin/import';
+};
+
+export type PostProjectsTreAdminImportErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostProjectsTreAdminImportResponses = {
+    /**
+     * Project imported
+     */
+    204: void;
+};
+
+export type PostProjectsTreAdminImportResponse = PostProjectsTreAdminImportResponses[keyof PostProjectsTreAdminImportResponses];
+
+export type GetProjectsDshByProjectIdData = {
+    body?: never;
+    path: {
+        /**
+         * Project UUID
+         */
+        projectId: string;
+    };
+    query?: never;
+    url: '/projects/dsh/{projectId}';
+};
+
+export type GetProjectsDshByProjectIdErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Project not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetProjectsDshByProjectIdResponses = {
+    200: ProjectDsh;
+};
+
+export type GetProjectsDshByProjectIdResponse = GetProjectsDshByProjectIdResponses[keyof GetProjectsDshByProjectIdResponses];
+
+export type GetStudiesByStudyIdDpiaData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/dpia';
+};
+
+export type GetStudiesByStudyIdDpiaErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or DPIA not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdDpiaResponses = {
+    200: Dpia;
+};
+
+export type GetStudiesByStudyIdDpiaResponse = GetStudiesByStudyIdDpiaResponses[keyof GetStudiesByStudyIdDpiaResponses];
+
+export type PutStudiesByStudyIdDpiaData = {
+    body: DpiaUpdate;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/dpia';
+};
+
+export type PutStudiesByStudyIdDpiaErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PutStudiesByStudyIdDpiaError = PutStudiesByStudyIdDpiaErrors[keyof PutStudiesByStudyIdDpiaErrors];
+
+export type PutStudiesByStudyIdDpiaResponses = {
+    200: Dpia;
+};
+
+export type PutStudiesByStudyIdDpiaResponse = PutStudiesByStudyIdDpiaResponses[keyof PutStudiesByStudyIdDpiaResponses];
+
+export type PostStudiesByStudyIdDpiaSubmitData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/dpia/submit';
+};
+
+export type PostStudiesByStudyIdDpiaSubmitErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdDpiaSubmitError = PostStudiesByStudyIdDpiaSubmitErrors[keyof PostStudiesByStudyIdDpiaSubmitErrors];
+
+export type PostStudiesByStudyIdDpiaSubmitResponses = {
+    /**
+     * DPIA submitted
+     */
+    200: unknown;
+};
+
+export type PostStudiesAdminByStudyIdDpiaSignoffData = {
+    body: DpiaSignoff;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/dpia/signoff';
+};
+
+export type PostStudiesAdminByStudyIdDpiaSignoffErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdDpiaSignoffError = PostStudiesAdminByStudyIdDpiaSignoffErrors[keyof PostStudiesAdminByStudyIdDpiaSignoffErrors];
+
+export type PostStudiesAdminByStudyIdDpiaSignoffResponses = {
+    /**
+     * DPIA signed off
+     */
+    200: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets';
+};
+
+export type GetStudiesByStudyIdAssetsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsResponses = {
+    200: Array<Asset>;
+};
+
+export type GetStudiesByStudyIdAssetsResponse = GetStudiesByStudyIdAssetsResponses[keyof GetStudiesByStudyIdAssetsResponses];
+
+export type PostStudiesByStudyIdAssetsData = {
+    body: AssetBase;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets';
+};
+
+export type PostStudiesByStudyIdAssetsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAssetsError = PostStudiesByStudyIdAssetsErrors[keyof PostStudiesByStudyIdAssetsErrors];
+
+export type PostStudiesByStudyIdAssetsResponses = {
+    /**
+     * Asset created successfully
+     */
+    201: Asset;
+};
+
+export type PostStudiesByStudyIdAssetsResponse = PostStudiesByStudyIdAssetsResponses[keyof PostStudiesByStudyIdAssetsResponses];
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}';
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdErrors = {
+    /**
+     * Asset is linked to a contract and cannot be deleted
+     */
+    400: ValidationError;
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdError = DeleteStudiesByStudyIdAssetsByAssetIdErrors[keyof DeleteStudiesByStudyIdAssetsByAssetIdErrors];
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdResponses = {
+    /**
+     * Asset deleted successfully
+     */
+    204: void;
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdResponse = DeleteStudiesByStudyIdAssetsByAssetIdResponses[keyof DeleteStudiesByStudyIdAssetsByAssetIdResponses];
+
+export type GetStudiesByStudyIdAssetsByAssetIdData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}';
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdErrors = {
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdResponses = {
+    200: Asset;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdResponse = GetStudiesByStudyIdAssetsByAssetIdResponses[keyof GetStudiesByStudyIdAssetsByAssetIdResponses];
+
+export type PutStudiesByStudyIdAssetsByAssetIdData = {
+    body: AssetBase;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}';
+};
+
+export type PutStudiesByStudyIdAssetsByAssetIdErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PutStudiesByStudyIdAssetsByAssetIdError = PutStudiesByStudyIdAssetsByAssetIdErrors[keyof PutStudiesByStudyIdAssetsByAssetIdErrors];
+
+export type PutStudiesByStudyIdAssetsByAssetIdResponses = {
+    /**
+     * Asset updated successfully
+     */
+    200: Asset;
+};
+
+export type PutStudiesByStudyIdAssetsByAssetIdResponse = PutStudiesByStudyIdAssetsByAssetIdResponses[keyof PutStudiesByStudyIdAssetsByAssetIdResponses];
+
+export type GetStudiesByStudyIdAssetsByAssetIdContractsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/contracts';
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdContractsErrors = {
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdContractsResponses = {
+    200: Array<Contract>;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdContractsResponse = GetStudiesByStudyIdAssetsByAssetIdContractsResponses[keyof GetStudiesByStudyIdAssetsByAssetIdContractsResponses];
+
+export type PostStudiesByStudyIdAssetsByAssetIdParentsData = {
+    body: AssetLinkRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/parents';
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdParentsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdParentsError = PostStudiesByStudyIdAssetsByAssetIdParentsErrors[keyof PostStudiesByStudyIdAssetsByAssetIdParentsErrors];
+
+export type PostStudiesByStudyIdAssetsByAssetIdParentsResponses = {
+    /**
+     * Asset linked successfully
+     */
+    201: AssetLink;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdParentsResponse = PostStudiesByStudyIdAssetsByAssetIdParentsResponses[keyof PostStudiesByStudyIdAssetsByAssetIdParentsResponses];
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+        /**
+         * Parent asset UUID
+         */
+        parentAssetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/parents/{parentAssetId}';
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdErrors = {
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study, asset or link not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses = {
+    /**
+     * Asset unlinked successfully
+     */
+    204: void;
+};
+
+export type DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponse = DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses[keyof DeleteStudiesByStudyIdAssetsByAssetIdParentsByParentAssetIdResponses];
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/destructions';
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsErrors = {
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses = {
+    200: Array<AssetDestruction>;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsResponse = GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses[keyof GetStudiesByStudyIdAssetsByAssetIdDestructionsResponses];
+
+export type PostStudiesByStudyIdAssetsByAssetIdDestructionsData = {
+    body: AssetDestructionRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/destructions';
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdDestructionsError = PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors[keyof PostStudiesByStudyIdAssetsByAssetIdDestructionsErrors];
+
+export type PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses = {
+    200: AssetDestruction;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdDestructionsResponse = PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses[keyof PostStudiesByStudyIdAssetsByAssetIdDestructionsResponses];
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+        /**
+         * Asset destruction request UUID
+         */
+        destructionId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/destructions/{destructionId}/certificate';
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Destruction request not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses = {
+    /**
+     * OK
+     */
+    200: Blob | File;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponse = GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses[keyof GetStudiesByStudyIdAssetsByAssetIdDestructionsByDestructionIdCertificateResponses];
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveData = {
+    body: AssetDestructionReview;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+        /**
+         * Asset destruction request UUID
+         */
+        destructionId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/approve';
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Destruction request not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveError = PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors[keyof PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveErrors];
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses = {
+    200: AssetDestruction;
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponse = PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses[keyof PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdApproveResponses];
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectData = {
+    body: AssetDestructionReview;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+        /**
+         * Asset destruction request UUID
+         */
+        destructionId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/assets/{assetId}/destructions/{destructionId}/reject';
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Destruction request not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectError = PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors[keyof PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectErrors];
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses = {
+    200: AssetDestruction;
+};
+
+export type PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponse = PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses[keyof PostStudiesAdminByStudyIdAssetsByAssetIdDestructionsByDestructionIdRejectResponses];
+
+export type GetStudiesAdminByStudyIdContractsByContractIdDownloadsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/contracts/{contractId}/downloads';
+};
+
+export type GetStudiesAdminByStudyIdContractsByContractIdDownloadsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Contract not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses = {
+    200: Array<ContractObjectDownload>;
+};
+
+export type GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponse = GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses[keyof GetStudiesAdminByStudyIdContractsByContractIdDownloadsResponses];
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveData = {
+    body: ContractAmendmentReview;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+        /**
+         * Contract version UUID
+         */
+        contractVersionId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/approve';
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Contract version not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveError = PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors[keyof PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveErrors];
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses = {
+    200: ContractVersion;
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponse = PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses[keyof PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdApproveResponses];
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectData = {
+    body: ContractAmendmentReview;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+        /**
+         * Contract version UUID
+         */
+        contractVersionId: string;
+    };
+    query?: never;
+    url: '/studies/admin/{studyId}/contracts/{contractId}/versions/{contractVersionId}/reject';
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Contract version not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectError = PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors[keyof PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectErrors];
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses = {
+    200: ContractVersion;
+};
+
+export type PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponse = PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses[keyof PostStudiesAdminByStudyIdContractsByContractIdVersionsByContractVersionIdRejectResponses];
+
+export type GetStudiesByStudyIdAssetsByAssetIdTransfersData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/transfers';
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdTransfersErrors = {
+    /**
+     * Forbidden - no access to study or asset
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdTransfersResponses = {
+    200: Array<AssetTransfer>;
+};
+
+export type GetStudiesByStudyIdAssetsByAssetIdTransfersResponse = GetStudiesByStudyIdAssetsByAssetIdTransfersResponses[keyof GetStudiesByStudyIdAssetsByAssetIdTransfersResponses];
+
+export type PostStudiesByStudyIdAssetsByAssetIdTransfersData = {
+    body: AssetTransferRequest;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset UUID
+         */
+        assetId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/assets/{assetId}/transfers';
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdTransfersErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdTransfersError = PostStudiesByStudyIdAssetsByAssetIdTransfersErrors[keyof PostStudiesByStudyIdAssetsByAssetIdTransfersErrors];
+
+export type PostStudiesByStudyIdAssetsByAssetIdTransfersResponses = {
+    200: AssetTransfer;
+};
+
+export type PostStudiesByStudyIdAssetsByAssetIdTransfersResponse = PostStudiesByStudyIdAssetsByAssetIdTransfersResponses[keyof PostStudiesByStudyIdAssetsByAssetIdTransfersResponses];
+
+export type GetStudiesByStudyIdTransfersData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/transfers';
+};
+
+export type GetStudiesByStudyIdTransfersErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdTransfersResponses = {
+    200: Array<AssetTransfer>;
+};
+
+export type GetStudiesByStudyIdTransfersResponse = GetStudiesByStudyIdTransfersResponses[keyof GetStudiesByStudyIdTransfersResponses];
+
+export type PostStudiesByStudyIdTransfersByTransferIdApproveData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset transfer UUID
+         */
+        transferId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/transfers/{transferId}/approve';
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdApproveErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Transfer not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdApproveError = PostStudiesByStudyIdTransfersByTransferIdApproveErrors[keyof PostStudiesByStudyIdTransfersByTransferIdApproveErrors];
+
+export type PostStudiesByStudyIdTransfersByTransferIdApproveResponses = {
+    200: AssetTransfer;
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdApproveResponse = PostStudiesByStudyIdTransfersByTransferIdApproveResponses[keyof PostStudiesByStudyIdTransfersByTransferIdApproveResponses];
+
+export type PostStudiesByStudyIdTransfersByTransferIdRejectData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset transfer UUID
+         */
+        transferId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/transfers/{transferId}/reject';
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdRejectErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Transfer not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdRejectError = PostStudiesByStudyIdTransfersByTransferIdRejectErrors[keyof PostStudiesByStudyIdTransfersByTransferIdRejectErrors];
+
+export type PostStudiesByStudyIdTransfersByTransferIdRejectResponses = {
+    200: AssetTransfer;
+};
+
+export type PostStudiesByStudyIdTransfersByTransferIdRejectResponse = PostStudiesByStudyIdTransfersByTransferIdRejectResponses[keyof PostStudiesByStudyIdTransfersByTransferIdRejectResponses];
+
+export type GetStudiesByStudyIdAssetExpiryReviewsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/asset-expiry-reviews';
+};
+
+export type GetStudiesByStudyIdAssetExpiryReviewsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAssetExpiryReviewsResponses = {
+    200: Array<AssetExpiryReview>;
+};
+
+export type GetStudiesByStudyIdAssetExpiryReviewsResponse = GetStudiesByStudyIdAssetExpiryReviewsResponses[keyof GetStudiesByStudyIdAssetExpiryReviewsResponses];
+
+export type PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveData = {
+    body: AssetExpiryResolution;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Asset expiry review UUID
+         */
+        expiryReviewId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/asset-expiry-reviews/{expiryReviewId}/resolve';
+};
+
+export type PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Expiry review not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveError = PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors[keyof PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveErrors];
+
+export type PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses = {
+    200: AssetExpiryReview;
+};
+
+export type PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponse = PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses[keyof PostStudiesByStudyIdAssetExpiryReviewsByExpiryReviewIdResolveResponses];
+
+export type GetStudiesByStudyIdLineageData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/lineage';
+};
+
+export type GetStudiesByStudyIdLineageErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdLineageResponses = {
+    200: AssetLineage;
+};
+
+export type GetStudiesByStudyIdLineageResponse = GetStudiesByStudyIdLineageResponses[keyof GetStudiesByStudyIdLineageResponses];
+
+export type GetLogoutData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/logout';
+};
+
+export type GetLogoutErrors = {
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetLogoutResponses = {
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAgreementsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/agreements';
+};
+
+export type GetStudiesByStudyIdAgreementsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdAgreementsResponses = {
+    200: StudyAgreements;
+};
+
+export type GetStudiesByStudyIdAgreementsResponse = GetStudiesByStudyIdAgreementsResponses[keyof GetStudiesByStudyIdAgreementsResponses];
+
+export type PostStudiesByStudyIdAgreementsData = {
+    body: AgreementConfirmation;
+    path: {
+        /**
+         * Study UUID
          */
-        projectId: string;
+        studyId: string;
     };
     query?: never;
-    url: '/projects/tre/admin/{projectId}/approve';
+    url: '/studies/{studyId}/agreements';
+};
+
+export type PostStudiesByStudyIdAgreementsErrors = {
+    /**
+     * Invalid request
+     */
+    400: unknown;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdAgreementsResponses = {
+    /**
+     * Agreement signed successfully
+     */
+    200: unknown;
+};
+
+export type GetStudiesByStudyIdContractsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts';
+};
+
+export type GetStudiesByStudyIdContractsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Asset not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdContractsResponses = {
+    /**
+     * List of contracts for the study
+     */
+    200: Array<Contract>;
+};
+
+export type GetStudiesByStudyIdContractsResponse = GetStudiesByStudyIdContractsResponses[keyof GetStudiesByStudyIdContractsResponses];
+
+export type PostStudiesByStudyIdContractsData = {
+    body: ContractBase;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts';
+};
+
+export type PostStudiesByStudyIdContractsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PostStudiesByStudyIdContractsError = PostStudiesByStudyIdContractsErrors[keyof PostStudiesByStudyIdContractsErrors];
+
+export type PostStudiesByStudyIdContractsResponses = {
+    /**
+     * OK
+     */
+    200: Contract;
+};
+
+export type PostStudiesByStudyIdContractsResponse = PostStudiesByStudyIdContractsResponses[keyof PostStudiesByStudyIdContractsResponses];
+
+export type DeleteStudiesByStudyIdContractsByContractIdData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts/{contractId}';
+};
+
+export type DeleteStudiesByStudyIdContractsByContractIdErrors = {
+    /**
+     * Validation error
+     */
+    400: unknown;
+    /**
+     * Contract not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type DeleteStudiesByStudyIdContractsByContractIdResponses = {
+    /**
+     * Contract deleted successfully
+     */
+    204: void;
+};
+
+export type DeleteStudiesByStudyIdContractsByContractIdResponse = DeleteStudiesByStudyIdContractsByContractIdResponses[keyof DeleteStudiesByStudyIdContractsByContractIdResponses];
+
+export type GetStudiesByStudyIdContractsByContractIdData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts/{contractId}';
+};
+
+export type GetStudiesByStudyIdContractsByContractIdResponses = {
+    /**
+     * OK
+     */
+    200: Contract;
+};
+
+export type GetStudiesByStudyIdContractsByContractIdResponse = GetStudiesByStudyIdContractsByContractIdResponses[keyof GetStudiesByStudyIdContractsByContractIdResponses];
+
+export type PutStudiesByStudyIdContractsByContractIdData = {
+    body: ContractBase;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts/{contractId}';
+};
+
+export type PutStudiesByStudyIdContractsByContractIdErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Contract not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type PutStudiesByStudyIdContractsByContractIdError = PutStudiesByStudyIdContractsByContractIdErrors[keyof PutStudiesByStudyIdContractsByContractIdErrors];
+
+export type PutStudiesByStudyIdContractsByContractIdResponses = {
+    /**
+     * Contract updated successfully
+     */
+    200: Contract;
+};
+
+export type PutStudiesByStudyIdContractsByContractIdResponse = PutStudiesByStudyIdContractsByContractIdResponses[keyof PutStudiesByStudyIdContractsByContractIdResponses];
+
+export type PostStudiesByStudyIdContractsByContractIdObjectsData = {
+    body: ContractObject;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/contracts/{contractId}/objects';
 };
 
-export type PostProjectsTreAdminByProjectIdApproveErrors = {
+export type PostStudiesByStudyIdContractsByContractIdObjectsErrors = {
     /**
      * Forbidden
      */
     403: unknown;
-    /**
-     * Project not found
-     */
-    404: unknown;
     /**
      * Internal server error
      */
@@ -2315,27 +6193,38 @@ export type PostProjectsTreAdminByProjectIdApproveErrors = {
     default: unknown;
 };
 
-export type PostProjectsTreAdminByProjectIdApproveResponses = {
+export type PostStudiesByStudyIdContractsByContractIdObjectsResponses = {
     /**
-     * Project approved successfully
+     * OK
      */
-    200: unknown;
+    200: ContractObjectMetadata;
 };
 
-export type PostProjectsTreAdminImportData = {
-    body: ProjectTreImport;
-    path?: never;
+export type PostStudiesByStudyIdContractsByContractIdObjectsResponse = PostStudiesByStudyIdContractsByContractIdObjectsResponses[keyof PostStudiesByStudyIdContractsByContractIdObjectsResponses];
+
+export type GetStudiesByStudyIdContractsByContractIdVersionsData = {
+    body?: never;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+    };
     query?: never;
-    url: '/projects/tre/admin/import';
+    url: '/studies/{studyId}/contracts/{contractId}/versions';
 };
 
-export type PostProjectsTreAdminImportErrors = {
+export type GetStudiesByStudyIdContractsByContractIdVersionsErrors = {
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Project not found
+     * Contract not found
      */
     404: unknown;
     /**
@@ -2348,34 +6237,39 @@ export type PostProjectsTreAdminImportErrors = {
     default: unknown;
 };
 
-export type PostProjectsTreAdminImportResponses = {
-    /**
-     * Project imported
-     */
-    204: void;
+export type GetStudiesByStudyIdContractsByContractIdVersionsResponses = {
+    200: Array<ContractVersion>;
 };
 
-export type PostProjectsTreAdminImportResponse = PostProjectsTreAdminImportResponses[keyof PostProjectsTreAdminImportResponses];
+export type GetStudiesByStudyIdContractsByContractIdVersionsResponse = GetStudiesByStudyIdContractsByContractIdVersionsResponses[keyof GetStudiesByStudyIdContractsByContractIdVersionsResponses];
 
-export type GetProjectsDshByProjectIdData = {
-    body?: never;
+export type PostStudiesByStudyIdContractsByContractIdVersionsData = {
+    body: ContractAmendmentRequest;
     path: {
         /**
-         * Project UUID
+         * Study UUID
          */
-        projectId: string;
+        studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
     };
     query?: never;
-    url: '/projects/dsh/{projectId}';
+    url: '/studies/{studyId}/contracts/{contractId}/versions';
 };
 
-export type GetProjectsDshByProjectIdErrors = {
+export type PostStudiesByStudyIdContractsByContractIdVersionsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Project not found
+     * Contract not found
      */
     404: unknown;
     /**
@@ -2388,31 +6282,45 @@ export type GetProjectsDshByProjectIdErrors = {
     default: unknown;
 };
 
-export type GetProjectsDshByProjectIdResponses = {
-    200: ProjectDsh;
+export type PostStudiesByStudyIdContractsByContractIdVersionsError = PostStudiesByStudyIdContractsByContractIdVersionsErrors[keyof PostStudiesByStudyIdContractsByContractIdVersionsErrors];
+
+export type PostStudiesByStudyIdContractsByContractIdVersionsResponses = {
+    200: ContractVersion;
 };
 
-export type GetProjectsDshByProjectIdResponse = GetProjectsDshByProjectIdResponses[keyof GetProjectsDshByProjectIdResponses];
+export type PostStudiesByStudyIdContractsByContractIdVersionsResponse = PostStudiesByStudyIdContractsByContractIdVersionsResponses[keyof PostStudiesByStudyIdContractsByContractIdVersionsResponses];
 
-export type GetStudiesByStudyIdAssetsData = {
-    body?: never;
+export type PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsData = {
+    body: ContractObject;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+        /**
+         * Contract version UUID
+         */
+        contractVersionId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets';
+    url: '/studies/{studyId}/contracts/{contractId}/versions/{contractVersionId}/objects';
 };
 
-export type GetStudiesByStudyIdAssetsErrors = {
+export type PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors = {
+    /**
+     * Validation error
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Study not found
+     * Contract version not found
      */
     404: unknown;
     /**
@@ -2425,29 +6333,38 @@ export type GetStudiesByStudyIdAssetsErrors = {
     default: unknown;
 };
 
-export type GetStudiesByStudyIdAssetsResponses = {
-    200: Array<Asset>;
+export type PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsError = PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors[keyof PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsErrors];
+
+export type PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses = {
+    /**
+     * OK
+     */
+    200: ContractObjectMetadata;
 };
 
-export type GetStudiesByStudyIdAssetsResponse = GetStudiesByStudyIdAssetsResponses[keyof GetStudiesByStudyIdAssetsResponses];
+export type PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponse = PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses[keyof PostStudiesByStudyIdContractsByContractIdVersionsByContractVersionIdObjectsResponses];
 
-export type PostStudiesByStudyIdAssetsData = {
-    body: AssetBase;
+export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData = {
+    body?: never;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
+        /**
+         * Contract UUID
+         */
+        contractId: string;
+        /**
+         * Contract object UUID
+         */
+        contractObjectId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets';
+    url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}';
 };
 
-export type PostStudiesByStudyIdAssetsErrors = {
-    /**
-     * Validation error
-     */
-    400: ValidationError;
+export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors = {
     /**
      * Forbidden
      */
@@ -2462,18 +6379,16 @@ export type PostStudiesByStudyIdAssetsErrors = {
     default: unknown;
 };
 
-export type PostStudiesByStudyIdAssetsError = PostStudiesByStudyIdAssetsErrors[keyof PostStudiesByStudyIdAssetsErrors];
-
-export type PostStudiesByStudyIdAssetsResponses = {
+export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses = {
     /**
-     * Asset created successfully
+     * OK
      */
-    201: Asset;
+    204: void;
 };
 
-export type PostStudiesByStudyIdAssetsResponse = PostStudiesByStudyIdAssetsResponses[keyof PostStudiesByStudyIdAssetsResponses];
+export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse = DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses[keyof DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses];
 
-export type DeleteStudiesByStudyIdAssetsByAssetIdData = {
+export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData = {
     body?: never;
     path: {
         /**
@@ -2481,27 +6396,27 @@ export type DeleteStudiesByStudyIdAssetsByAssetIdData = {
          */
         studyId: string;
         /**
-         * Asset UUID
+         * Contract UUID
          */
-        assetId: string;
+        contractId: string;
+        /**
+         * Contract object UUID
+         */
+        contractObjectId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets/{assetId}';
+    url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}';
 };
 
-export type DeleteStudiesByStudyIdAssetsByAssetIdErrors = {
+export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors = {
     /**
-     * Asset is linked to a contract and cannot be deleted
+     * Object is quarantined as it is not yet scanned or is infected
      */
     400: ValidationError;
     /**
-     * Forbidden - no access to study or asset
+     * Forbidden
      */
     403: unknown;
-    /**
-     * Study or asset not found
-     */
-    404: unknown;
     /**
      * Internal server error
      */
@@ -2512,18 +6427,18 @@ export type DeleteStudiesByStudyIdAssetsByAssetIdErrors = {
     default: unknown;
 };
 
-export type DeleteStudiesByStudyIdAssetsByAssetIdError = DeleteStudiesByStudyIdAssetsByAssetIdErrors[keyof DeleteStudiesByStudyIdAssetsByAssetIdErrors];
+export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdError = GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors[keyof GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors];
 
-export type DeleteStudiesByStudyIdAssetsByAssetIdResponses = {
+export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses = {
     /**
-     * Asset deleted successfully
+     * OK
      */
-    204: void;
+    200: Blob | File;
 };
 
-export type DeleteStudiesByStudyIdAssetsByAssetIdResponse = DeleteStudiesByStudyIdAssetsByAssetIdResponses[keyof DeleteStudiesByStudyIdAssetsByAssetIdResponses];
+export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse = GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses[keyof GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses];
 
-export type GetStudiesByStudyIdAssetsByAssetIdData = {
+export type PostStudiesByStudyIdContractsByContractIdRenewData = {
     body?: never;
     path: {
         /**
@@ -2531,21 +6446,25 @@ export type GetStudiesByStudyIdAssetsByAssetIdData = {
          */
         studyId: string;
         /**
-         * Asset UUID
+         * Contract UUID
          */
-        assetId: string;
+        contractId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets/{assetId}';
+    url: '/studies/{studyId}/contracts/{contractId}/renew';
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdErrors = {
+export type PostStudiesByStudyIdContractsByContractIdRenewErrors = {
     /**
-     * Forbidden - no access to study or asset
+     * Validation error
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
      */
     403: unknown;
     /**
-     * Study or asset not found
+     * Contract not found
      */
     404: unknown;
     /**
@@ -2558,41 +6477,68 @@ export type GetStudiesByStudyIdAssetsByAssetIdErrors = {
     default: unknown;
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdResponses = {
-    200: Asset;
+export type PostStudiesByStudyIdContractsByContractIdRenewError = PostStudiesByStudyIdContractsByContractIdRenewErrors[keyof PostStudiesByStudyIdContractsByContractIdRenewErrors];
+
+export type PostStudiesByStudyIdContractsByContractIdRenewResponses = {
+    200: Contract;
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdResponse = GetStudiesByStudyIdAssetsByAssetIdResponses[keyof GetStudiesByStudyIdAssetsByAssetIdResponses];
+export type PostStudiesByStudyIdContractsByContractIdRenewResponse = PostStudiesByStudyIdContractsByContractIdRenewResponses[keyof PostStudiesByStudyIdContractsByContractIdRenewResponses];
 
-export type PutStudiesByStudyIdAssetsByAssetIdData = {
-    body: AssetBase;
+export type GetStudiesByStudyIdApprovalsData = {
+    body?: never;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
+    };
+    query?: never;
+    url: '/studies/{studyId}/approvals';
+};
+
+export type GetStudiesByStudyIdApprovalsErrors = {
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+    /**
+     * Unexpected error
+     */
+    default: unknown;
+};
+
+export type GetStudiesByStudyIdApprovalsResponses = {
+    200: Array<RegulatoryApproval>;
+};
+
+export type GetStudiesByStudyIdApprovalsResponse = GetStudiesByStudyIdApprovalsResponses[keyof GetStudiesByStudyIdApprovalsResponses];
+
+export type PostStudiesByStudyIdApprovalsData = {
+    body: RegulatoryApprovalBase;
+    path: {
         /**
-         * Asset UUID
+         * Study UUID
          */
-        assetId: string;
+        studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets/{assetId}';
+    url: '/studies/{studyId}/approvals';
 };
 
-export type PutStudiesByStudyIdAssetsByAssetIdErrors = {
+export type PostStudiesByStudyIdApprovalsErrors = {
     /**
      * Validation error
      */
     400: ValidationError;
     /**
-     * Forbidden - no access to study or asset
+     * Forbidden
      */
     403: unknown;
-    /**
-     * Study or asset not found
-     */
-    404: unknown;
     /**
      * Internal server error
      */
@@ -2603,18 +6549,15 @@ export type PutStudiesByStudyIdAssetsByAssetIdErrors = {
     default: unknown;
 };
 
-export type PutStudiesByStudyIdAssetsByAssetIdError = PutStudiesByStudyIdAssetsByAssetIdErrors[keyof PutStudiesByStudyIdAssetsByAssetIdErrors];
+export type PostStudiesByStudyIdApprovalsError = PostStudiesByStudyIdApprovalsErrors[keyof PostStudiesByStudyIdApprovalsErrors];
 
-export type PutStudiesByStudyIdAssetsByAssetIdResponses = {
-    /**
-     * Asset updated successfully
-     */
-    200: Asset;
+export type PostStudiesByStudyIdApprovalsResponses = {
+    200: RegulatoryApproval;
 };
 
-export type PutStudiesByStudyIdAssetsByAssetIdResponse = PutStudiesByStudyIdAssetsByAssetIdResponses[keyof PutStudiesByStudyIdAssetsByAssetIdResponses];
+export type PostStudiesByStudyIdApprovalsResponse = PostStudiesByStudyIdApprovalsResponses[keyof PostStudiesByStudyIdApprovalsResponses];
 
-export type GetStudiesByStudyIdAssetsByAssetIdContractsData = {
+export type DeleteStudiesByStudyIdApprovalsByApprovalIdData = {
     body?: never;
     path: {
         /**
@@ -2622,140 +6565,165 @@ export type GetStudiesByStudyIdAssetsByAssetIdContractsData = {
          */
         studyId: string;
         /**
-         * Asset UUID
+         * Regulatory approval UUID
          */
-        assetId: string;
+        approvalId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/assets/{assetId}/contracts';
+    url: '/studies/{studyId}/approvals/{approvalId}';
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdContractsErrors = {
+export type DeleteStudiesByStudyIdApprovalsByApprovalIdErrors = {
     /**
-     * Forbidden - no access to study or asset
+     * Forbidden
      */
     403: unknown;
     /**
-     * Study or asset not found
+     * Approval not found
      */
     404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdContractsResponses = {
-    200: Array<Contract>;
+export type DeleteStudiesByStudyIdApprovalsByApprovalIdResponses = {
+    /**
+     * Approval deleted successfully
+     */
+    204: void;
 };
 
-export type GetStudiesByStudyIdAssetsByAssetIdContractsResponse = GetStudiesByStudyIdAssetsByAssetIdContractsResponses[keyof GetStudiesByStudyIdAssetsByAssetIdContractsResponses];
+export type DeleteStudiesByStudyIdApprovalsByApprovalIdResponse = DeleteStudiesByStudyIdApprovalsByApprovalIdResponses[keyof DeleteStudiesByStudyIdApprovalsByApprovalIdResponses];
 
-export type GetLogoutData = {
-    body?: never;
-    path?: never;
+export type PutStudiesByStudyIdApprovalsByApprovalIdData = {
+    body: RegulatoryApprovalBase;
+    path: {
+        /**
+         * Study UUID
+         */
+        studyId: string;
+        /**
+         * Regulatory approval UUID
+         */
+        approvalId: string;
+    };
     query?: never;
-    url: '/logout';
+    url: '/studies/{studyId}/approvals/{approvalId}';
 };
 
-export type GetLogoutErrors = {
+export type PutStudiesByStudyIdApprovalsByApprovalIdErrors = {
     /**
-     * Unexpected error
+     * Validation error
      */
-    default: unknown;
-};
-
-export type GetLogoutResponses = {
+    400: ValidationError;
     /**
-     * Unexpected error
+     * Forbidden
      */
-    default: unknown;
+    403: unknown;
+    /**
+     * Approval not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type GetStudiesByStudyIdAgreementsData = {
+export type PutStudiesByStudyIdApprovalsByApprovalIdError = PutStudiesByStudyIdApprovalsByApprovalIdErrors[keyof PutStudiesByStudyIdApprovalsByApprovalIdErrors];
+
+export type PutStudiesByStudyIdApprovalsByApprovalIdResponses = {
+    200: RegulatoryApproval;
+};
+
+export type PutStudiesByStudyIdApprovalsByApprovalIdResponse = PutStudiesByStudyIdApprovalsByApprovalIdResponses[keyof PutStudiesByStudyIdApprovalsByApprovalIdResponses];
+
+export type GetStudiesByStudyIdApprovalsByApprovalIdLetterData = {
     body?: never;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
+        /**
+         * Regulatory approval UUID
+         */
+        approvalId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/agreements';
+    url: '/studies/{studyId}/approvals/{approvalId}/letter';
 };
 
-export type GetStudiesByStudyIdAgreementsErrors = {
+export type GetStudiesByStudyIdApprovalsByApprovalIdLetterErrors = {
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Study not found
+     * Approval or letter not found
      */
     404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type GetStudiesByStudyIdAgreementsResponses = {
-    200: StudyAgreements;
+export type GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses = {
+    /**
+     * OK
+     */
+    200: Blob | File;
 };
 
-export type GetStudiesByStudyIdAgreementsResponse = GetStudiesByStudyIdAgreementsResponses[keyof GetStudiesByStudyIdAgreementsResponses];
+export type GetStudiesByStudyIdApprovalsByApprovalIdLetterResponse = GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses[keyof GetStudiesByStudyIdApprovalsByApprovalIdLetterResponses];
 
-export type PostStudiesByStudyIdAgreementsData = {
-    body: AgreementConfirmation;
+export type PostStudiesByStudyIdApprovalsByApprovalIdLetterData = {
+    body: RegulatoryApprovalLetter;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
+        /**
+         * Regulatory approval UUID
+         */
+        approvalId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/agreements';
+    url: '/studies/{studyId}/approvals/{approvalId}/letter';
 };
 
-export type PostStudiesByStudyIdAgreementsErrors = {
+export type PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors = {
     /**
-     * Invalid request
+     * Validation error
      */
-    400: unknown;
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Study not found
+     * Approval not found
      */
     404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type PostStudiesByStudyIdAgreementsResponses = {
-    /**
-     * Agreement signed successfully
-     */
-    200: unknown;
+export type PostStudiesByStudyIdApprovalsByApprovalIdLetterError = PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors[keyof PostStudiesByStudyIdApprovalsByApprovalIdLetterErrors];
+
+export type PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses = {
+    200: RegulatoryApproval;
 };
 
-export type GetStudiesByStudyIdContractsData = {
+export type PostStudiesByStudyIdApprovalsByApprovalIdLetterResponse = PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses[keyof PostStudiesByStudyIdApprovalsByApprovalIdLetterResponses];
+
+export type GetStudiesByStudyIdDocumentsData = {
     body?: never;
     path: {
         /**
@@ -2764,18 +6732,14 @@ export type GetStudiesByStudyIdContractsData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts';
+    url: '/studies/{studyId}/documents';
 };
 
-export type GetStudiesByStudyIdContractsErrors = {
+export type GetStudiesByStudyIdDocumentsErrors = {
     /**
      * Forbidden
      */
     403: unknown;
-    /**
-     * Asset not found
-     */
-    404: unknown;
     /**
      * Internal server error
      */
@@ -2786,17 +6750,14 @@ export type GetStudiesByStudyIdContractsErrors = {
     default: unknown;
 };
 
-export type GetStudiesByStudyIdContractsResponses = {
-    /**
-     * List of contracts for the study
-     */
-    200: Array<Contract>;
+export type GetStudiesByStudyIdDocumentsResponses = {
+    200: Array<StudyDocument>;
 };
 
-export type GetStudiesByStudyIdContractsResponse = GetStudiesByStudyIdContractsResponses[keyof GetStudiesByStudyIdContractsResponses];
+export type GetStudiesByStudyIdDocumentsResponse = GetStudiesByStudyIdDocumentsResponses[keyof GetStudiesByStudyIdDocumentsResponses];
 
-export type PostStudiesByStudyIdContractsData = {
-    body: ContractBase;
+export type PostStudiesByStudyIdDocumentsData = {
+    body: StudyDocumentUpload;
     path: {
         /**
          * Study UUID
@@ -2804,10 +6765,10 @@ export type PostStudiesByStudyIdContractsData = {
         studyId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts';
+    url: '/studies/{studyId}/documents';
 };
 
-export type PostStudiesByStudyIdContractsErrors = {
+export type PostStudiesByStudyIdDocumentsErrors = {
     /**
      * Validation error
      */
@@ -2820,24 +6781,17 @@ export type PostStudiesByStudyIdContractsErrors = {
      * Internal server error
      */
     500: unknown;
-    /**
-     * Unexpected error
-     */
-    default: unknown;
 };
 
-export type PostStudiesByStudyIdContractsError = PostStudiesByStudyIdContractsErrors[keyof PostStudiesByStudyIdContractsErrors];
+export type PostStudiesByStudyIdDocumentsError = PostStudiesByStudyIdDocumentsErrors[keyof PostStudiesByStudyIdDocumentsErrors];
 
-export type PostStudiesByStudyIdContractsResponses = {
-    /**
-     * OK
-     */
-    200: Contract;
+export type PostStudiesByStudyIdDocumentsResponses = {
+    200: StudyDocument;
 };
 
-export type PostStudiesByStudyIdContractsResponse = PostStudiesByStudyIdContractsResponses[keyof PostStudiesByStudyIdContractsResponses];
+export type PostStudiesByStudyIdDocumentsResponse = PostStudiesByStudyIdDocumentsResponses[keyof PostStudiesByStudyIdDocumentsResponses];
 
-export type DeleteStudiesByStudyIdContractsByContractIdData = {
+export type DeleteStudiesByStudyIdDocumentsByDocumentIdData = {
     body?: never;
     path: {
         /**
@@ -2845,21 +6799,21 @@ export type DeleteStudiesByStudyIdContractsByContractIdData = {
          */
         studyId: string;
         /**
-         * Contract UUID
+         * Study document UUID
          */
-        contractId: string;
+        documentId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}';
+    url: '/studies/{studyId}/documents/{documentId}';
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdErrors = {
+export type DeleteStudiesByStudyIdDocumentsByDocumentIdErrors = {
     /**
-     * Validation error
+     * Forbidden
      */
-    400: unknown;
+    403: unknown;
     /**
-     * Contract not found
+     * Document not found
      */
     404: unknown;
     /**
@@ -2868,214 +6822,267 @@ export type DeleteStudiesByStudyIdContractsByContractIdErrors = {
     500: unknown;
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdResponses = {
+export type DeleteStudiesByStudyIdDocumentsByDocumentIdResponses = {
     /**
-     * Contract deleted successfully
+     * Document deleted successfully
      */
     204: void;
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdResponse = DeleteStudiesByStudyIdContractsByContractIdResponses[keyof DeleteStudiesByStudyIdContractsByContractIdResponses];
+export type DeleteStudiesByStudyIdDocumentsByDocumentIdResponse = DeleteStudiesByStudyIdDocumentsByDocumentIdResponses[keyof DeleteStudiesByStudyIdDocumentsByDocumentIdResponses];
 
-export type GetStudiesByStudyIdContractsByContractIdData = {
-    body?: never;
+export type PostStudiesByStudyIdDocumentsByDocumentIdVersionsData = {
+    body: StudyDocumentVersionUpload;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
         /**
-         * Contract UUID
+         * Study document UUID
          */
-        contractId: string;
+        documentId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}';
+    url: '/studies/{studyId}/documents/{documentId}/versions';
 };
 
-export type GetStudiesByStudyIdContractsByContractIdResponses = {
+export type PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors = {
     /**
-     * OK
+     * Validation error
      */
-    200: Contract;
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Document not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type GetStudiesByStudyIdContractsByContractIdResponse = GetStudiesByStudyIdContractsByContractIdResponses[keyof GetStudiesByStudyIdContractsByContractIdResponses];
+export type PostStudiesByStudyIdDocumentsByDocumentIdVersionsError = PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors[keyof PostStudiesByStudyIdDocumentsByDocumentIdVersionsErrors];
 
-export type PutStudiesByStudyIdContractsByContractIdData = {
-    body: ContractBase;
+export type PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses = {
+    200: StudyDocument;
+};
+
+export type PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponse = PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses[keyof PostStudiesByStudyIdDocumentsByDocumentIdVersionsResponses];
+
+export type GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdData = {
+    body?: never;
     path: {
         /**
          * Study UUID
          */
         studyId: string;
         /**
-         * Contract UUID
+         * Study document UUID
          */
-        contractId: string;
+        documentId: string;
+        /**
+         * Study document version UUID
+         */
+        documentVersionId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}';
+    url: '/studies/{studyId}/documents/{documentId}/versions/{documentVersionId}';
 };
 
-export type PutStudiesByStudyIdContractsByContractIdErrors = {
-    /**
-     * Validation error
-     */
-    400: ValidationError;
+export type GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdErrors = {
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Contract not found
+     * Document version not found
      */
     404: unknown;
     /**
      * Internal server error
      */
     500: unknown;
+};
+
+export type GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses = {
     /**
-     * Unexpected error
+     * OK
      */
-    default: unknown;
+    200: Blob | File;
 };
 
-export type PutStudiesByStudyIdContractsByContractIdError = PutStudiesByStudyIdContractsByContractIdErrors[keyof PutStudiesByStudyIdContractsByContractIdErrors];
+export type GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponse = GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses[keyof GetStudiesByStudyIdDocumentsByDocumentIdVersionsByDocumentVersionIdResponses];
 
-export type PutStudiesByStudyIdContractsByContractIdResponses = {
+export type GetErasuresData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/erasures';
+};
+
+export type GetErasuresErrors = {
     /**
-     * Contract updated successfully
+     * Forbidden
      */
-    200: Contract;
+    403: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
 };
 
-export type PutStudiesByStudyIdContractsByContractIdResponse = PutStudiesByStudyIdContractsByContractIdResponses[keyof PutStudiesByStudyIdContractsByContractIdResponses];
+export type GetErasuresResponses = {
+    200: Array<Erasure>;
+};
 
-export type PostStudiesByStudyIdContractsByContractIdObjectsData = {
-    body: ContractObject;
+export type GetErasuresResponse = GetErasuresResponses[keyof GetErasuresResponses];
+
+export type PostErasuresData = {
+    body: ErasureRequest;
+    path?: never;
+    query?: never;
+    url: '/erasures';
+};
+
+export type PostErasuresErrors = {
+    /**
+     * Invalid erasure request
+     */
+    400: ValidationError;
+    /**
+     * Forbidden
+     */
+    403: unknown;
+    /**
+     * Study or user not found
+     */
+    404: unknown;
+    /**
+     * Internal server error
+     */
+    500: unknown;
+};
+
+export type PostErasuresError = PostErasuresErrors[keyof PostErasuresErrors];
+
+export type PostErasuresResponses = {
+    200: Erasure;
+};
+
+export type PostErasuresResponse = PostErasuresResponses[keyof PostErasuresResponses];
+
+export type GetErasuresByErasureIdData = {
+    body?: never;
     path: {
         /**
-         * Study UUID
-         */
-        studyId: string;
-        /**
-         * Contract UUID
+         * Erasure UUID
          */
-        contractId: string;
+        erasureId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}/objects';
+    url: '/erasures/{erasureId}';
 };
 
-export type PostStudiesByStudyIdContractsByContractIdObjectsErrors = {
+export type GetErasuresByErasureIdErrors = {
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Internal server error
+     * Erasure not found
      */
-    500: unknown;
+    404: unknown;
     /**
-     * Unexpected error
+     * Internal server error
      */
-    default: unknown;
+    500: unknown;
 };
 
-export type PostStudiesByStudyIdContractsByContractIdObjectsResponses = {
-    /**
-     * OK
-     */
-    200: ContractObjectMetadata;
+export type GetErasuresByErasureIdResponses = {
+    200: Erasure;
 };
 
-export type PostStudiesByStudyIdContractsByContractIdObjectsResponse = PostStudiesByStudyIdContractsByContractIdObjectsResponses[keyof PostStudiesByStudyIdContractsByContractIdObjectsResponses];
+export type GetErasuresByErasureIdResponse = GetErasuresByErasureIdResponses[keyof GetErasuresByErasureIdResponses];
 
-export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData = {
+export type PostErasuresByErasureIdApproveData = {
     body?: never;
     path: {
         /**
-         * Study UUID
-         */
-        studyId: string;
-        /**
-         * Contract UUID
-         */
-        contractId: string;
-        /**
-         * Contract object UUID
+         * Erasure UUID
          */
-        contractObjectId: string;
+        erasureId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}';
+    url: '/erasures/{erasureId}/approve';
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors = {
+export type PostErasuresByErasureIdApproveErrors = {
+    /**
+     * Invalid approval
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Internal server error
+     * Erasure not found
      */
-    500: unknown;
+    404: unknown;
     /**
-     * Unexpected error
+     * Internal server error
      */
-    default: unknown;
+    500: unknown;
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses = {
-    /**
-     * OK
-     */
-    204: void;
+export type PostErasuresByErasureIdApproveError = PostErasuresByErasureIdApproveErrors[keyof PostErasuresByErasureIdApproveErrors];
+
+export type PostErasuresByErasureIdApproveResponses = {
+    200: Erasure;
 };
 
-export type DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse = DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses[keyof DeleteStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses];
+export type PostErasuresByErasureIdApproveResponse = PostErasuresByErasureIdApproveResponses[keyof PostErasuresByErasureIdApproveResponses];
 
-export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdData = {
+export type PostErasuresByErasureIdRejectData = {
     body?: never;
     path: {
         /**
-         * Study UUID
-         */
-        studyId: string;
-        /**
-         * Contract UUID
-         */
-        contractId: string;
-        /**
-         * Contract object UUID
+         * Erasure UUID
          */
-        contractObjectId: string;
+        erasureId: string;
     };
     query?: never;
-    url: '/studies/{studyId}/contracts/{contractId}/objects/{contractObjectId}';
+    url: '/erasures/{erasureId}/reject';
 };
 
-export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdErrors = {
+export type PostErasuresByErasureIdRejectErrors = {
+    /**
+     * Invalid rejection
+     */
+    400: ValidationError;
     /**
      * Forbidden
      */
     403: unknown;
     /**
-     * Internal server error
+     * Erasure not found
      */
-    500: unknown;
+    404: unknown;
     /**
-     * Unexpected error
+     * Internal server error
      */
-    default: unknown;
+    500: unknown;
 };
 
-export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses = {
-    /**
-     * OK
-     */
-    200: Blob | File;
+export type PostErasuresByErasureIdRejectError = PostErasuresByErasureIdRejectErrors[keyof PostErasuresByErasureIdRejectErrors];
+
+export type PostErasuresByErasureIdRejectResponses = {
+    200: Erasure;
 };
 
-export type GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponse = GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses[keyof GetStudiesByStudyIdContractsByContractIdObjectsByContractObjectIdResponses];
+export type PostErasuresByErasureIdRejectResponse = PostErasuresByErasureIdRejectResponses[keyof PostErasuresByErasureIdRejectResponses];